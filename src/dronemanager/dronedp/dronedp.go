@@ -188,8 +188,8 @@ func ParseMsg(data []byte) (*Msg, error) {
     msg.Data = decoded
 
   case OP_MAVLINK_TEXT:
-    msg.Data = &mavlink.Packet{}
-    err = json.Unmarshal(decoded, msg.Data)
+    // {"name": "COMMAND_LONG", "fields": {...}}
+    msg.Data, err = mavlink.UnmarshalMessage(decoded)
 
   case OP_STATUS:
     msg.Data = &StatusMsg{}
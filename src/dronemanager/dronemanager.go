@@ -396,6 +396,26 @@ func (m *DroneManager) GetAllVehicleData() map[string]interface{} {
   return vehs
 }
 
+// Live vehicles, keyed like GetAllVehicleData.
+func (m *DroneManager) Vehicles() map[string]*vehicle.Vehicle {
+  m.sessionLock.Lock()
+  defer m.sessionLock.Unlock()
+
+  vehs := make(map[string]*vehicle.Vehicle)
+
+  for _, session := range m.sessions {
+    name := session.Drone["name"].(string)
+    if name != "" {
+      vehs[name] = session.veh
+    } else {
+      id := session.Drone["_id"].(string)
+      vehs[id] = session.veh
+    }
+  }
+
+  return vehs
+}
+
 func (m *DroneManager) GetOnlineVehicles() map[string]interface{} {
   m.sessionLock.Lock()
   defer m.sessionLock.Unlock()
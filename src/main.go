@@ -22,6 +22,7 @@ import (
   "cloud"
   "flag"
   "logger"
+  "params"
   // "vehicle"
   // "dronemanager"
   "rest"
@@ -35,6 +36,8 @@ func main() {
   httpAddr := flag.String("httpAddr", "localhost:8080", "Networking port to serve HTTP on")
   dscPort := flag.String("dscPort", "localhost:4002", "Networking port to listen for DS Links")
   cloudAddr := flag.String("cloud", "http://localhost:4000", "Connection to the cloud.")
+  paramsMeta := flag.String("paramsMeta", "assets/PX4ParameterFactMetaData.xml", "Param metadata, to describe and check params with.")
+  paramsCache := flag.String("paramsCache", "cache/params", "Where to keep params between connections, empty to always download them.")
 
   flag.Parse()
 
@@ -46,6 +49,16 @@ func main() {
 
   cloud.InitCloud(*cloudAddr)
 
+  if err := params.InitParams(*paramsMeta); err != nil {
+    logger.Warn("Param metadata not loaded, params won't be checked:", err)
+  }
+
+  if *paramsCache != "" {
+    if err := params.InitCache(*paramsCache); err != nil {
+      logger.Warn("Param cache unavailable, params will be downloaded on every connection:", err)
+    }
+  }
+
   apiServer := rest.NewRestServer(*httpAddr)
   apiServer.Listen(*dscPort)
 }
@@ -21,6 +21,8 @@ import (
 	"go/format"
 	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -35,10 +37,11 @@ import (
 type Dialect struct {
 	Name        string
 	StringSizes map[int]bool
+	Includes    []*Dialect // resolved from Include by ParseDialectFile
 
 	XMLName  xml.Name   `xml:"mavlink"`
 	Version  string     `xml:"version"`
-	// Include  string     `xml:"include"`
+	Include  []string   `xml:"include"`
 	Enums    []*Enum    `xml:"enums>enum"`
 	Messages []*Message `xml:"messages>message"`
 }
@@ -47,10 +50,12 @@ type Enum struct {
 	Name        string       `xml:"name,attr"`
 	Description string       `xml:"description"`
 	Entries     []*EnumEntry `xml:"entry"`
+	Values      []*EnumEntry // Entries with distinct values, for the lookup table
+	Extends     bool         // enum is defined by an included dialect, this one only adds entries
 }
 
 type EnumEntry struct {
-	Value       uint8             `xml:"value,attr"`
+	Value       uint32            `xml:"value,attr"`
 	Name        string            `xml:"name,attr"`
 	Description string            `xml:"description"`
 	Params      []*EnumEntryParam `xml:"param"`
@@ -61,8 +66,9 @@ type EnumEntryParam struct {
 	Description string `xml:",innerxml"`
 }
 
+// Decoded by UnmarshalXML, since the position of <extensions/> matters.
 type Message struct {
-	ID          uint8           `xml:"id,attr"`
+	ID          uint32          `xml:"id,attr"` // 24 bits for MAVLink v2
 	Name        string          `xml:"name,attr"`
 	Description string          `xml:"description"`
 	Fields      []*MessageField `xml:"field"`
@@ -76,11 +82,67 @@ type MessageField struct {
 	GoType      string
 	BitSize     int // Bit size. Used for generating the packing code, and for sorting the fields.
 	ArrayLen    int
-	ByteOffset  int // from beginning of payload
+	ByteOffset  int  // from beginning of payload
+	Extension   bool // declared after <extensions/>, not part of the v1 message
+}
+
+//
+// Fields after an <extensions/> marker are flagged as extensions, the
+// rest of the message decodes as usual.
+//
+func (m *Message) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			id, err := strconv.ParseUint(attr.Value, 10, 32)
+			if err != nil {
+				return err
+			}
+			m.ID = uint32(id)
+		case "name":
+			m.Name = attr.Value
+		}
+	}
+
+	extensions := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "description":
+				if err := d.DecodeElement(&m.Description, &t); err != nil {
+					return err
+				}
+			case "field":
+				f := &MessageField{Extension: extensions}
+				if err := d.DecodeElement(f, &t); err != nil {
+					return err
+				}
+				m.Fields = append(m.Fields, f)
+			case "extensions":
+				extensions = true
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
 }
 
 var funcMap = template.FuncMap{
 	"UpperCamelCase": UpperCamelCase,
+	"EnumTableName":  EnumTableName,
 }
 
 func (f *MessageField) SizeInBytes() int {
@@ -91,6 +153,7 @@ func (f *MessageField) SizeInBytes() int {
 	}
 }
 
+// payload size including extension fields
 func (m *Message) Size() int {
 	sz := 0
 	for _, f := range m.Fields {
@@ -99,6 +162,21 @@ func (m *Message) Size() int {
 	return sz
 }
 
+// payload size of the v1 message, without extension fields
+func (m *Message) BaseSize() int {
+	sz := 0
+	for _, f := range m.Fields {
+		if !f.Extension {
+			sz += f.SizeInBytes()
+		}
+	}
+	return sz
+}
+
+func (m *Message) HasExtensions() bool {
+	return m.BaseSize() != m.Size()
+}
+
 //
 // CRC extra calculation.
 //   http://www.mavlink.org/mavlink/crc_extra_calculation
@@ -108,6 +186,11 @@ func (m *Message) CRCExtra() uint8 {
 
 	fmt.Fprint(hash, m.Name+" ")
 	for _, f := range m.Fields {
+		// extension fields are not part of the crc extra
+		if f.Extension {
+			continue
+		}
+
 		cType := f.CType
 		if cType == "uint8_t_mavlink_version" {
 			cType = "uint8_t"
@@ -129,16 +212,37 @@ func (m *Message) CRCExtra() uint8 {
 //
 // Sort interface needed for packing payload chunks in the right order.
 //
-func (m *Message) Len() int {
-	return len(m.Fields)
+type fieldsBySize []*MessageField
+
+func (fs fieldsBySize) Len() int {
+	return len(fs)
+}
+
+func (fs fieldsBySize) Less(i, j int) bool {
+	return fs[i].BitSize < fs[j].BitSize
 }
 
-func (m *Message) Less(i, j int) bool {
-	return m.Fields[i].BitSize < m.Fields[j].BitSize
+func (fs fieldsBySize) Swap(i, j int) {
+	fs[i], fs[j] = fs[j], fs[i]
 }
 
-func (m *Message) Swap(i, j int) {
-	m.Fields[i], m.Fields[j] = m.Fields[j], m.Fields[i]
+//
+// Base fields are sorted according to their size, extension fields
+// stay in declaration order after them.
+// http://www.mavlink.org/mavlink/crc_extra_calculation
+//
+func (m *Message) SortFields() {
+	var base, ext []*MessageField
+	for _, f := range m.Fields {
+		if f.Extension {
+			ext = append(ext, f)
+		} else {
+			base = append(base, f)
+		}
+	}
+
+	sort.Stable(sort.Reverse(fieldsBySize(base)))
+	m.Fields = append(base, ext...)
 }
 
 func UpperCamelCase(s string) string {
@@ -152,6 +256,18 @@ func UpperCamelCase(s string) string {
 	return b.String()
 }
 
+//
+// Name of the unexported lookup table for an enum.
+// MAV_TYPE -> mavTypeEntries
+//
+func EnumTableName(s string) string {
+	name := UpperCamelCase(s)
+	if name == "" {
+		return ""
+	}
+	return strings.ToLower(name[:1]) + name[1:] + "Entries"
+}
+
 //
 // Generate Go code to pack fields into a payload.
 //
@@ -202,18 +318,18 @@ func (f *MessageField) PayloadPackSequence() string {
 //
 func (f *MessageField) payloadUnpackPrimitive(offset string) string {
 	if f.BitSize == 8 {
-		return fmt.Sprintf("%s(p.Payload[%s])", goArrayType(f.GoType), offset)
+		return fmt.Sprintf("%s(payload[%s])", goArrayType(f.GoType), offset)
 	}
 
 	if f.IsFloat() {
 		switch f.BitSize {
 		case 32, 64:
-			return fmt.Sprintf("math.Float%dfrombits(binary.LittleEndian.Uint%d(p.Payload[%s:]))", f.BitSize, f.BitSize, offset)
+			return fmt.Sprintf("math.Float%dfrombits(binary.LittleEndian.Uint%d(payload[%s:]))", f.BitSize, f.BitSize, offset)
 		}
 	} else {
 		switch f.BitSize {
 		case 16, 32, 64:
-			return fmt.Sprintf("%s(binary.LittleEndian.Uint%d(p.Payload[%s:]))", goArrayType(f.GoType), f.BitSize, offset)
+			return fmt.Sprintf("%s(binary.LittleEndian.Uint%d(payload[%s:]))", goArrayType(f.GoType), f.BitSize, offset)
 		}
 	}
 
@@ -229,7 +345,7 @@ func (f *MessageField) PayloadUnpackSequence() string {
 	if f.ArrayLen > 0 {
 		// optimize to copy() if possible
 		if strings.HasSuffix(f.GoType, "byte") || strings.HasSuffix(f.GoType, "uint8") {
-			return fmt.Sprintf("copy(self.%s[:], p.Payload[%d:%d])", name, f.ByteOffset, f.ByteOffset+f.ArrayLen)
+			return fmt.Sprintf("copy(self.%s[:], payload[%d:%d])", name, f.ByteOffset, f.ByteOffset+f.ArrayLen)
 		}
 
 		// unpack each element in the array
@@ -265,13 +381,127 @@ func ParseDialect(in io.Reader, name string) (*Dialect, error) {
 		return nil, err
 	}
 
-	// if dialect.Include != "" {
-  //   generate(protocol.IncludeName())
-	// }
-
 	return dialect, nil
 }
 
+//
+// Read the XML file at path, along with every dialect it includes (recursively).
+// Include paths are relative to the file that includes them.
+//
+func ParseDialectFile(path string) (*Dialect, error) {
+	d, err := parseDialectFile(path, make(map[string]*Dialect), make(map[string]bool))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.checkConflicts(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func parseDialectFile(path string, parsed map[string]*Dialect, parsing map[string]bool) (*Dialect, error) {
+
+	path = filepath.Clean(path)
+
+	// a dialect included from several places is only parsed once
+	if d, ok := parsed[path]; ok {
+		return d, nil
+	}
+	if parsing[path] {
+		return nil, fmt.Errorf("include cycle at %s", path)
+	}
+	parsing[path] = true
+	defer delete(parsing, path)
+
+	fin, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fin.Close()
+
+	d, err := ParseDialect(fin, baseName(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	for _, inc := range d.Include {
+		incPath := strings.TrimSpace(inc)
+		if !filepath.IsAbs(incPath) {
+			incPath = filepath.Join(filepath.Dir(path), incPath)
+		}
+
+		incDialect, err := parseDialectFile(incPath, parsed, parsing)
+		if err != nil {
+			return nil, err
+		}
+		d.Includes = append(d.Includes, incDialect)
+	}
+
+	parsed[path] = d
+	return d, nil
+}
+
+//
+// All dialects reachable from d, each listed once and after everything it includes.
+//
+func (d *Dialect) Tree() []*Dialect {
+	var tree []*Dialect
+	seen := make(map[*Dialect]bool)
+
+	var walk func(*Dialect)
+	walk = func(dd *Dialect) {
+		if seen[dd] {
+			return
+		}
+		seen[dd] = true
+		for _, inc := range dd.Includes {
+			walk(inc)
+		}
+		tree = append(tree, dd)
+	}
+
+	walk(d)
+	return tree
+}
+
+//
+// Dialects end up in the same Go package, so message ids, message names
+// and enum entries have to be unique across the whole include tree.
+//
+func (d *Dialect) checkConflicts() error {
+	ids := make(map[uint32]string)
+	names := make(map[string]string)
+	entries := make(map[string]string)
+
+	for _, dd := range d.Tree() {
+		for _, m := range dd.Messages {
+			where := dd.Name + ":" + m.Name
+			if prev, ok := ids[m.ID]; ok {
+				return fmt.Errorf("message id %d defined by both %s and %s", m.ID, prev, where)
+			}
+			if prev, ok := names[m.Name]; ok {
+				return fmt.Errorf("message %s defined by both %s and %s", m.Name, prev, where)
+			}
+			ids[m.ID] = where
+			names[m.Name] = where
+		}
+
+		for _, e := range dd.Enums {
+			for _, ee := range e.Entries {
+				where := dd.Name + ":" + e.Name
+				if prev, ok := entries[ee.Name]; ok {
+					return fmt.Errorf("enum entry %s defined by both %s and %s", ee.Name, prev, where)
+				}
+				entries[ee.Name] = where
+			}
+		}
+	}
+
+	return nil
+}
+
 //
 // Necessary for Go's static typing.
 //
@@ -306,6 +536,20 @@ func (f *MessageField) IsFloat() bool {
 	return strings.HasPrefix(goArrayType(f.GoType), "float")
 }
 
+// char arrays are text, and are treated as strings in JSON
+func (f *MessageField) IsChar() bool {
+	return strings.HasPrefix(f.CType, "char[")
+}
+
+// struct tag carrying the dialect name of the field, used for JSON
+func (f *MessageField) Tag() string {
+	tag := f.Name
+	if f.IsChar() {
+		tag += ",char"
+	}
+	return fmt.Sprintf("`mavlink:%q`", tag)
+}
+
 func GoTypeInfo(s string) (string, int, int, error) {
 
 	var name string
@@ -347,6 +591,7 @@ func (d *Dialect) GenerateGo(w io.Writer) error {
 
 	var bb bytes.Buffer
 
+	bb.WriteString(licenseHeader)
 	bb.WriteString("package mavlink\n\n")
 
 	bb.WriteString("import (\n")
@@ -384,31 +629,111 @@ func (d *Dialect) GenerateGo(w io.Writer) error {
 	return err
 }
 
+const licenseHeader = `/**
+ * Dronesmith API
+ *
+ * This is an autogenerated file.
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+`
+
 //
 // Generate Enums
 //
 func (d *Dialect) generateEnums(w io.Writer) error {
 	enumTmpl := `
 {{range .Enums}}
-// {{.Name}}: {{.Description}}
+{{$name := .Name | UpperCamelCase}}{{$table := .Name | EnumTableName}}
+{{if .Extends}}// {{$name}}: entries added by this dialect{{else}}// {{$name}}: {{.Description}}
+type {{$name}} uint32
+{{end}}
 const ({{range .Entries}}
 	{{.Name}} = {{.Value}} // {{.Description}}{{end}}
 )
-{{end}}
+{{if .Extends}}
+func init() {
+	for e, entry := range map[{{$name}}]enumEntry{ {{range .Values}}
+		{{.Name}}: { {{printf "%q" .Name}}, {{printf "%q" .Description}} },{{end}}
+	} {
+		if _, ok := {{$table}}[e]; !ok {
+			{{$table}}[e] = entry
+		}
+	}
+}
+{{else}}
+var {{$table}} = map[{{$name}}]enumEntry{ {{range .Values}}
+	{{.Name}}: { {{printf "%q" .Name}}, {{printf "%q" .Description}} },{{end}}
+}
+
+func (e {{$name}}) String() string {
+	if entry, ok := {{$table}}[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("{{$name}}(%d)", uint32(e))
+}
+
+func (e {{$name}}) Description() string {
+	return {{$table}}[e].description
+}
+
+// Valid reports whether e is a {{.Name}} entry
+func (e {{$name}}) Valid() bool {
+	_, ok := {{$table}}[e]
+	return ok
+}
+
+// Parse{{$name}} returns the {{.Name}} entry called name
+func Parse{{$name}}(name string) ({{$name}}, error) {
+	for e, entry := range {{$table}} {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown {{.Name}} %q", name)
+}
+{{end}}{{end}}
 `
+	// enums can be extended by including dialects, which must not redeclare them
+	defined := make(map[string]bool)
+	for _, inc := range d.Tree() {
+		if inc != d {
+			for _, e := range inc.Enums {
+				defined[e.Name] = true
+			}
+		}
+	}
+
 	// fill in missing enum values if necessary, and ensure description strings are valid.
 	for _, e := range d.Enums {
 		e.Description = strings.Replace(e.Description, "\n", " ", -1)
-		e.Name = UpperCamelCase(e.Name)
+		e.Extends = defined[e.Name]
+
+		e.Values = nil
+		seen := make(map[uint32]bool)
 		for i, ee := range e.Entries {
 			if ee.Value == 0 {
-				ee.Value = uint8(i)
+				ee.Value = uint32(i)
+			}
+			ee.Description = strings.TrimSpace(strings.Replace(ee.Description, "\n", " ", -1))
+
+			// first name wins for the lookup table
+			if !seen[ee.Value] {
+				seen[ee.Value] = true
+				e.Values = append(e.Values, ee)
 			}
-			ee.Description = strings.Replace(ee.Description, "\n", " ", -1)
 		}
 	}
 
-	return template.Must(template.New("enums").Parse(enumTmpl)).Execute(w, d)
+	return template.Must(template.New("enums").Funcs(funcMap).Parse(enumTmpl)).Execute(w, d)
 }
 
 //
@@ -424,10 +749,14 @@ const ({{range .Messages}}
 // Dialect{{.Name | UpperCamelCase}} is the dialect represented by {{.Name}}.xml
 var Dialect{{.Name | UpperCamelCase}} *Dialect = &Dialect{
 	Name: "{{.Name}}",
-	crcExtras: map[uint8]uint8{ {{range .Messages}}
-		{{.ID}}: {{.CRCExtra}}, // MSG_ID_{{.Name}}{{end}}
+	messages: map[uint32]msgInfo{ {{range .Messages}}
+		{{.ID}}: { "{{.Name}}", {{.CRCExtra}}, {{.Size}}, {{.BaseSize}}, func() Message { return new({{.Name | UpperCamelCase}}) } }, // MSG_ID_{{.Name}}{{end}}
 	},
 }
+
+func init() {
+	registerDialect(Dialect{{.Name | UpperCamelCase}})
+}
 `
 	return template.Must(template.New("msgIds").Funcs(funcMap).Parse(msgIdTmpl)).Execute(w, d)
 }
@@ -442,10 +771,10 @@ func (d *Dialect) generateClasses(w io.Writer) error {
 {{$name := .Name | UpperCamelCase}}
 // {{.Description}}
 type {{$name}} struct { {{range .Fields}}
-  {{.Name | UpperCamelCase}} {{.GoType}} // {{.Description}}{{end}}
+  {{.Name | UpperCamelCase}} {{.GoType}} {{.Tag}} // {{.Description}}{{end}}
 }
 
-func (self *{{$name}}) MsgID() uint8 {
+func (self *{{$name}}) MsgID() uint32 {
 	return {{.ID}}
 }
 
@@ -463,12 +792,26 @@ func (self *{{$name}}) Pack(p *Packet) error {
 }
 
 func (self *{{$name}}) Unpack(p *Packet) error {
-	if len(p.Payload) < {{ .Size }} {
+	if len(p.Payload) < {{ .BaseSize }} {
 		return fmt.Errorf("payload too small")
-	}{{range .Fields}}
+	}
+	payload := p.Payload{{if .HasExtensions}}
+	if len(payload) < {{ .Size }} {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, {{ .Size }})
+		copy(payload, p.Payload)
+	}{{end}}{{range .Fields}}
 	{{.PayloadUnpackSequence}}{{end}}
 	return nil
 }
+
+func (self *{{$name}}) MarshalJSON() ([]byte, error) {
+	return marshalMessage("{{.Name}}", self)
+}
+
+func (self *{{$name}}) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("{{.Name}}", self, data)
+}
 {{end}}
 `
 	for _, m := range d.Messages {
@@ -483,9 +826,8 @@ func (self *{{$name}}) Unpack(p *Packet) error {
 			f.GoType, f.BitSize, f.ArrayLen = goname, gosz, golen
 		}
 
-		// ensure fields are sorted according to their size,
-		// http://www.mavlink.org/mavlink/crc_extra_calculation
-		sort.Stable(sort.Reverse(m))
+		// ensure fields are sorted according to their size
+		m.SortFields()
 
 		// once sorted, calculate offsets for use in payload packing/unpacking
 		offset := 0
@@ -14,6 +14,11 @@
 package main
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -45,10 +50,10 @@ func TestTypeConversions(t *testing.T) {
 			t.Errorf("Type Conversion for %q, got name %q, want %q", c.in, name, c.name)
 		}
 		if bitsz != c.bitsz {
-			t.Errorf("Type Conversion for %q, got bitsz %q, want %q", c.in, bitsz, c.bitsz)
+			t.Errorf("Type Conversion for %q, got bitsz %d, want %d", c.in, bitsz, c.bitsz)
 		}
 		if arraylen != c.arraylen {
-			t.Errorf("Type Conversion for %q, got arraylen %q, want %q", c.in, arraylen, c.arraylen)
+			t.Errorf("Type Conversion for %q, got arraylen %d, want %d", c.in, arraylen, c.arraylen)
 		}
 	}
 }
@@ -75,3 +80,172 @@ func TestNameConversion(t *testing.T) {
 		}
 	}
 }
+
+func writeDialects(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "generator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, body := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestIncludes(t *testing.T) {
+
+	dir := writeDialects(t, map[string]string{
+		"base.xml": `<mavlink><messages>
+			<message id="1" name="BASE_MSG"><field type="uint8_t" name="a">a</field></message>
+		</messages></mavlink>`,
+		"mid.xml": `<mavlink><include>base.xml</include><messages>
+			<message id="2" name="MID_MSG"><field type="uint8_t" name="b">b</field></message>
+		</messages></mavlink>`,
+		"top.xml": `<mavlink><include>mid.xml</include><include>base.xml</include><messages>
+			<message id="300" name="TOP_MSG"><field type="uint8_t" name="c">c</field></message>
+		</messages></mavlink>`,
+	})
+	defer os.RemoveAll(dir)
+
+	d, err := ParseDialectFile(filepath.Join(dir, "top.xml"))
+	if err != nil {
+		t.Fatal("Parse fail:", err)
+	}
+
+	var names []string
+	for _, dd := range d.Tree() {
+		names = append(names, dd.Name)
+	}
+	if got := strings.Join(names, ","); got != "base,mid,top" {
+		t.Errorf("Include order, got %q, want %q", got, "base,mid,top")
+	}
+
+	if d.Messages[0].ID != 300 {
+		t.Errorf("Message id, got %d, want 300", d.Messages[0].ID)
+	}
+}
+
+func TestIncludeConflicts(t *testing.T) {
+
+	cases := []struct {
+		files map[string]string
+		want  string
+	}{
+		{map[string]string{
+			"a.xml": `<mavlink><messages><message id="5" name="A_MSG"></message></messages></mavlink>`,
+			"top.xml": `<mavlink><include>a.xml</include>
+				<messages><message id="5" name="B_MSG"></message></messages></mavlink>`,
+		}, "message id 5"},
+		{map[string]string{
+			"a.xml": `<mavlink><messages><message id="5" name="A_MSG"></message></messages></mavlink>`,
+			"top.xml": `<mavlink><include>a.xml</include>
+				<messages><message id="6" name="A_MSG"></message></messages></mavlink>`,
+		}, "message A_MSG"},
+		{map[string]string{
+			"a.xml":   `<mavlink><include>top.xml</include></mavlink>`,
+			"top.xml": `<mavlink><include>a.xml</include></mavlink>`,
+		}, "include cycle"},
+	}
+
+	for _, c := range cases {
+		dir := writeDialects(t, c.files)
+
+		_, err := ParseDialectFile(filepath.Join(dir, "top.xml"))
+		if err == nil || !strings.Contains(err.Error(), c.want) {
+			t.Errorf("Conflict check, got %v, want %q", err, c.want)
+		}
+
+		os.RemoveAll(dir)
+	}
+}
+
+func TestExtensions(t *testing.T) {
+
+	dir := writeDialects(t, map[string]string{
+		"ext.xml": `<mavlink><messages>
+			<message id="36" name="SERVO_OUTPUT_RAW">
+				<description>servos</description>
+				<field type="uint32_t" name="time_usec">t</field>
+				<field type="uint8_t" name="port">port</field>
+				<field type="uint16_t" name="servo1_raw">s1</field>
+				<extensions/>
+				<field type="uint8_t" name="ext_b">b</field>
+				<field type="uint16_t" name="ext_a">a</field>
+			</message>
+		</messages></mavlink>`,
+	})
+	defer os.RemoveAll(dir)
+
+	d, err := ParseDialectFile(filepath.Join(dir, "ext.xml"))
+	if err != nil {
+		t.Fatal("Parse fail:", err)
+	}
+
+	// fills in types, sorts fields and computes offsets
+	if err := d.GenerateGo(ioutil.Discard); err != nil {
+		t.Fatal("Generate fail:", err)
+	}
+
+	m := d.Messages[0]
+
+	var names []string
+	for _, f := range m.Fields {
+		names = append(names, f.Name)
+	}
+	// base fields sorted by size, extensions kept in declaration order
+	if got, want := strings.Join(names, ","), "time_usec,servo1_raw,port,ext_b,ext_a"; got != want {
+		t.Errorf("Field order, got %q, want %q", got, want)
+	}
+
+	if !m.Fields[3].Extension || m.Fields[2].Extension {
+		t.Errorf("Extension flags, got %v", m.Fields)
+	}
+	if m.Size() != 10 || m.BaseSize() != 7 || m.Fields[4].ByteOffset != 8 {
+		t.Errorf("Sizes, got %d/%d, want 10/7", m.Size(), m.BaseSize())
+	}
+
+	// crc extra only covers the base fields
+	base := &Message{Name: m.Name, Fields: m.Fields[:3]}
+	if m.CRCExtra() != base.CRCExtra() {
+		t.Errorf("CRCExtra, got %d, want %d", m.CRCExtra(), base.CRCExtra())
+	}
+}
+
+func TestExtendedEnums(t *testing.T) {
+
+	dir := writeDialects(t, map[string]string{
+		"base.xml": `<mavlink><enums>
+			<enum name="MY_ENUM"><entry name="MY_ENUM_A" value="1"><description>a</description></entry></enum>
+		</enums></mavlink>`,
+		"top.xml": `<mavlink><include>base.xml</include><enums>
+			<enum name="MY_ENUM"><entry name="MY_ENUM_B" value="2"><description>b</description></entry></enum>
+		</enums></mavlink>`,
+	})
+	defer os.RemoveAll(dir)
+
+	d, err := ParseDialectFile(filepath.Join(dir, "top.xml"))
+	if err != nil {
+		t.Fatal("Parse fail:", err)
+	}
+
+	var out []string
+	for _, dd := range d.Tree() {
+		var bb bytes.Buffer
+		if err := dd.GenerateGo(&bb); err != nil {
+			t.Fatal("Generate fail:", err)
+		}
+		out = append(out, bb.String())
+	}
+
+	// only the defining dialect declares the type
+	if !strings.Contains(out[0], "type MyEnum uint32") || strings.Contains(out[1], "type MyEnum") {
+		t.Error("MyEnum should only be declared by base")
+	}
+	if !strings.Contains(out[1], "myEnumEntries[e] = entry") {
+		t.Error("top should add its entries to the MyEnum table")
+	}
+}
@@ -32,27 +32,39 @@ func main() {
 	log.SetPrefix("generator: ")
 	flag.Parse()
 
-	fin, err := os.Open(*infile)
-	if err != nil {
+	if _, err := os.Stat(*infile); err != nil {
 		usage()
 		log.Fatal("Input: ", err)
 	}
-	defer fin.Close()
 
-	d, err := ParseDialect(fin, baseName(*infile))
+	d, err := ParseDialectFile(*infile)
 	if err != nil {
 		log.Fatal("Parse: ", err)
 	}
 
-	fout, err := os.Create(findOutFile())
+	// one Go file per dialect, included dialects go next to the main output
+	out := findOutFile()
+	for _, dd := range d.Tree() {
+		path := out
+		if dd != d {
+			path = filepath.Join(filepath.Dir(out), strings.ToLower(dd.Name)+".go")
+		}
+
+		if err := generateFile(dd, path); err != nil {
+			log.Fatal("Generate: ", err)
+		}
+	}
+}
+
+func generateFile(d *Dialect, path string) error {
+	fout, err := os.Create(path)
 	if err != nil {
-		log.Fatal("Output: ", err)
+		return err
 	}
 	defer fout.Close()
 
-	if err := d.GenerateGo(fout); err != nil {
-		log.Fatal("Generate: ", err)
-	}
+	log.Println("Writing", d.Name, "to", path)
+	return d.GenerateGo(fout)
 }
 
 // helper to remove the extension from the base name
@@ -76,5 +88,5 @@ func findOutFile() string {
 func usage() {
 	log.Println("Generator - Parse MAVLink XML and create Go file.")
 	log.Println("\t-f\tInput File Path")
-	log.Println("\t-o\tOutput File Path")
+	log.Println("\t-o\tOutput File Path (included dialects are written alongside)")
 }
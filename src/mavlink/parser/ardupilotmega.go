@@ -0,0 +1,410 @@
+/**
+ * Dronesmith API
+ *
+ * This is an autogenerated file.
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+////////////////////////////////////////////////////////////////////////
+//
+// !! DO NOT EDIT !!
+// This file was created automatically by the MAVLink Generator utility.
+//
+////////////////////////////////////////////////////////////////////////
+
+// LimitsState:
+type LimitsState uint32
+
+const (
+	LIMITS_INIT       = 0 // pre-initialization
+	LIMITS_DISABLED   = 1 // disabled
+	LIMITS_ENABLED    = 2 // checking limits
+	LIMITS_TRIGGERED  = 3 // a limit has been breached
+	LIMITS_RECOVERING = 4 // taking action eg. RTL
+	LIMITS_RECOVERED  = 5 // we're no longer in breach of a limit
+)
+
+var limitsStateEntries = map[LimitsState]enumEntry{
+	LIMITS_INIT:       {"LIMITS_INIT", "pre-initialization"},
+	LIMITS_DISABLED:   {"LIMITS_DISABLED", "disabled"},
+	LIMITS_ENABLED:    {"LIMITS_ENABLED", "checking limits"},
+	LIMITS_TRIGGERED:  {"LIMITS_TRIGGERED", "a limit has been breached"},
+	LIMITS_RECOVERING: {"LIMITS_RECOVERING", "taking action eg. RTL"},
+	LIMITS_RECOVERED:  {"LIMITS_RECOVERED", "we're no longer in breach of a limit"},
+}
+
+func (e LimitsState) String() string {
+	if entry, ok := limitsStateEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("LimitsState(%d)", uint32(e))
+}
+
+func (e LimitsState) Description() string {
+	return limitsStateEntries[e].description
+}
+
+// Valid reports whether e is a LIMITS_STATE entry
+func (e LimitsState) Valid() bool {
+	_, ok := limitsStateEntries[e]
+	return ok
+}
+
+// ParseLimitsState returns the LIMITS_STATE entry called name
+func ParseLimitsState(name string) (LimitsState, error) {
+	for e, entry := range limitsStateEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown LIMITS_STATE %q", name)
+}
+
+// Offsets and calibrations values for hardware sensors. This makes it easier to debug the calibration process.
+type SensorOffsets struct {
+	MagDeclination float32 `mavlink:"mag_declination"` // magnetic declination (radians)
+	RawPress       int32   `mavlink:"raw_press"`       // raw pressure from barometer
+	RawTemp        int32   `mavlink:"raw_temp"`        // raw temperature from barometer
+	GyroCalX       float32 `mavlink:"gyro_cal_x"`      // gyro X calibration
+	GyroCalY       float32 `mavlink:"gyro_cal_y"`      // gyro Y calibration
+	GyroCalZ       float32 `mavlink:"gyro_cal_z"`      // gyro Z calibration
+	AccelCalX      float32 `mavlink:"accel_cal_x"`     // accel X calibration
+	AccelCalY      float32 `mavlink:"accel_cal_y"`     // accel Y calibration
+	AccelCalZ      float32 `mavlink:"accel_cal_z"`     // accel Z calibration
+	MagOfsX        int16   `mavlink:"mag_ofs_x"`       // magnetometer X offset
+	MagOfsY        int16   `mavlink:"mag_ofs_y"`       // magnetometer Y offset
+	MagOfsZ        int16   `mavlink:"mag_ofs_z"`       // magnetometer Z offset
+}
+
+func (self *SensorOffsets) MsgID() uint32 {
+	return 150
+}
+
+func (self *SensorOffsets) MsgName() string {
+	return "SensorOffsets"
+}
+
+func (self *SensorOffsets) Pack(p *Packet) error {
+	payload := make([]byte, 42)
+	binary.LittleEndian.PutUint32(payload[0:], math.Float32bits(self.MagDeclination))
+	binary.LittleEndian.PutUint32(payload[4:], uint32(self.RawPress))
+	binary.LittleEndian.PutUint32(payload[8:], uint32(self.RawTemp))
+	binary.LittleEndian.PutUint32(payload[12:], math.Float32bits(self.GyroCalX))
+	binary.LittleEndian.PutUint32(payload[16:], math.Float32bits(self.GyroCalY))
+	binary.LittleEndian.PutUint32(payload[20:], math.Float32bits(self.GyroCalZ))
+	binary.LittleEndian.PutUint32(payload[24:], math.Float32bits(self.AccelCalX))
+	binary.LittleEndian.PutUint32(payload[28:], math.Float32bits(self.AccelCalY))
+	binary.LittleEndian.PutUint32(payload[32:], math.Float32bits(self.AccelCalZ))
+	binary.LittleEndian.PutUint16(payload[36:], uint16(self.MagOfsX))
+	binary.LittleEndian.PutUint16(payload[38:], uint16(self.MagOfsY))
+	binary.LittleEndian.PutUint16(payload[40:], uint16(self.MagOfsZ))
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *SensorOffsets) Unpack(p *Packet) error {
+	if len(p.Payload) < 42 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.MagDeclination = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.RawPress = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.RawTemp = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.GyroCalX = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.GyroCalY = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.GyroCalZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.AccelCalX = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.AccelCalY = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.AccelCalZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.MagOfsX = int16(binary.LittleEndian.Uint16(payload[36:]))
+	self.MagOfsY = int16(binary.LittleEndian.Uint16(payload[38:]))
+	self.MagOfsZ = int16(binary.LittleEndian.Uint16(payload[40:]))
+	return nil
+}
+
+func (self *SensorOffsets) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SENSOR_OFFSETS", self)
+}
+
+func (self *SensorOffsets) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SENSOR_OFFSETS", self, data)
+}
+
+// Deprecated. Use MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS instead. Set the magnetometer offsets
+type SetMagOffsets struct {
+	MagOfsX         int16 `mavlink:"mag_ofs_x"`        // magnetometer X offset
+	MagOfsY         int16 `mavlink:"mag_ofs_y"`        // magnetometer Y offset
+	MagOfsZ         int16 `mavlink:"mag_ofs_z"`        // magnetometer Z offset
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+}
+
+func (self *SetMagOffsets) MsgID() uint32 {
+	return 151
+}
+
+func (self *SetMagOffsets) MsgName() string {
+	return "SetMagOffsets"
+}
+
+func (self *SetMagOffsets) Pack(p *Packet) error {
+	payload := make([]byte, 8)
+	binary.LittleEndian.PutUint16(payload[0:], uint16(self.MagOfsX))
+	binary.LittleEndian.PutUint16(payload[2:], uint16(self.MagOfsY))
+	binary.LittleEndian.PutUint16(payload[4:], uint16(self.MagOfsZ))
+	payload[6] = byte(self.TargetSystem)
+	payload[7] = byte(self.TargetComponent)
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *SetMagOffsets) Unpack(p *Packet) error {
+	if len(p.Payload) < 8 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.MagOfsX = int16(binary.LittleEndian.Uint16(payload[0:]))
+	self.MagOfsY = int16(binary.LittleEndian.Uint16(payload[2:]))
+	self.MagOfsZ = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.TargetSystem = uint8(payload[6])
+	self.TargetComponent = uint8(payload[7])
+	return nil
+}
+
+func (self *SetMagOffsets) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_MAG_OFFSETS", self)
+}
+
+func (self *SetMagOffsets) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_MAG_OFFSETS", self, data)
+}
+
+// state of APM memory
+type Meminfo struct {
+	Brkval  uint16 `mavlink:"brkval"`  // heap top
+	Freemem uint16 `mavlink:"freemem"` // free memory
+}
+
+func (self *Meminfo) MsgID() uint32 {
+	return 152
+}
+
+func (self *Meminfo) MsgName() string {
+	return "Meminfo"
+}
+
+func (self *Meminfo) Pack(p *Packet) error {
+	payload := make([]byte, 4)
+	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Brkval))
+	binary.LittleEndian.PutUint16(payload[2:], uint16(self.Freemem))
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *Meminfo) Unpack(p *Packet) error {
+	if len(p.Payload) < 4 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.Brkval = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Freemem = uint16(binary.LittleEndian.Uint16(payload[2:]))
+	return nil
+}
+
+func (self *Meminfo) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MEMINFO", self)
+}
+
+func (self *Meminfo) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MEMINFO", self, data)
+}
+
+// Status of DCM attitude estimator
+type Ahrs struct {
+	Omegaix     float32 `mavlink:"omegaIx"`      // X gyro drift estimate rad/s
+	Omegaiy     float32 `mavlink:"omegaIy"`      // Y gyro drift estimate rad/s
+	Omegaiz     float32 `mavlink:"omegaIz"`      // Z gyro drift estimate rad/s
+	AccelWeight float32 `mavlink:"accel_weight"` // average accel_weight
+	RenormVal   float32 `mavlink:"renorm_val"`   // average renormalisation value
+	ErrorRp     float32 `mavlink:"error_rp"`     // average error_roll_pitch value
+	ErrorYaw    float32 `mavlink:"error_yaw"`    // average error_yaw value
+}
+
+func (self *Ahrs) MsgID() uint32 {
+	return 163
+}
+
+func (self *Ahrs) MsgName() string {
+	return "Ahrs"
+}
+
+func (self *Ahrs) Pack(p *Packet) error {
+	payload := make([]byte, 28)
+	binary.LittleEndian.PutUint32(payload[0:], math.Float32bits(self.Omegaix))
+	binary.LittleEndian.PutUint32(payload[4:], math.Float32bits(self.Omegaiy))
+	binary.LittleEndian.PutUint32(payload[8:], math.Float32bits(self.Omegaiz))
+	binary.LittleEndian.PutUint32(payload[12:], math.Float32bits(self.AccelWeight))
+	binary.LittleEndian.PutUint32(payload[16:], math.Float32bits(self.RenormVal))
+	binary.LittleEndian.PutUint32(payload[20:], math.Float32bits(self.ErrorRp))
+	binary.LittleEndian.PutUint32(payload[24:], math.Float32bits(self.ErrorYaw))
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *Ahrs) Unpack(p *Packet) error {
+	if len(p.Payload) < 28 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.Omegaix = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Omegaiy = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Omegaiz = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.AccelWeight = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.RenormVal = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.ErrorRp = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.ErrorYaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	return nil
+}
+
+func (self *Ahrs) MarshalJSON() ([]byte, error) {
+	return marshalMessage("AHRS", self)
+}
+
+func (self *Ahrs) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("AHRS", self, data)
+}
+
+// Status of key hardware
+type Hwstatus struct {
+	Vcc    uint16 `mavlink:"Vcc"`    // board voltage (mV)
+	I2cerr uint8  `mavlink:"I2Cerr"` // I2C error count
+}
+
+func (self *Hwstatus) MsgID() uint32 {
+	return 165
+}
+
+func (self *Hwstatus) MsgName() string {
+	return "Hwstatus"
+}
+
+func (self *Hwstatus) Pack(p *Packet) error {
+	payload := make([]byte, 3)
+	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Vcc))
+	payload[2] = byte(self.I2cerr)
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *Hwstatus) Unpack(p *Packet) error {
+	if len(p.Payload) < 3 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.Vcc = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.I2cerr = uint8(payload[2])
+	return nil
+}
+
+func (self *Hwstatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HWSTATUS", self)
+}
+
+func (self *Hwstatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HWSTATUS", self, data)
+}
+
+// Rangefinder reporting
+type Rangefinder struct {
+	Distance float32 `mavlink:"distance"` // distance in meters
+	Voltage  float32 `mavlink:"voltage"`  // raw voltage if available, zero otherwise
+}
+
+func (self *Rangefinder) MsgID() uint32 {
+	return 173
+}
+
+func (self *Rangefinder) MsgName() string {
+	return "Rangefinder"
+}
+
+func (self *Rangefinder) Pack(p *Packet) error {
+	payload := make([]byte, 8)
+	binary.LittleEndian.PutUint32(payload[0:], math.Float32bits(self.Distance))
+	binary.LittleEndian.PutUint32(payload[4:], math.Float32bits(self.Voltage))
+
+	p.MsgID = self.MsgID()
+	p.Payload = payload
+	return nil
+}
+
+func (self *Rangefinder) Unpack(p *Packet) error {
+	if len(p.Payload) < 8 {
+		return fmt.Errorf("payload too small")
+	}
+	payload := p.Payload
+	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Voltage = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	return nil
+}
+
+func (self *Rangefinder) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RANGEFINDER", self)
+}
+
+func (self *Rangefinder) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RANGEFINDER", self, data)
+}
+
+// Message IDs
+const (
+	MSG_ID_SENSOR_OFFSETS  = 150
+	MSG_ID_SET_MAG_OFFSETS = 151
+	MSG_ID_MEMINFO         = 152
+	MSG_ID_AHRS            = 163
+	MSG_ID_HWSTATUS        = 165
+	MSG_ID_RANGEFINDER     = 173
+)
+
+// DialectArdupilotmega is the dialect represented by ardupilotmega.xml
+var DialectArdupilotmega *Dialect = &Dialect{
+	Name: "ardupilotmega",
+	messages: map[uint32]msgInfo{
+		150: {"SENSOR_OFFSETS", 134, 42, 42, func() Message { return new(SensorOffsets) }}, // MSG_ID_SENSOR_OFFSETS
+		151: {"SET_MAG_OFFSETS", 219, 8, 8, func() Message { return new(SetMagOffsets) }},  // MSG_ID_SET_MAG_OFFSETS
+		152: {"MEMINFO", 208, 4, 4, func() Message { return new(Meminfo) }},                // MSG_ID_MEMINFO
+		163: {"AHRS", 127, 28, 28, func() Message { return new(Ahrs) }},                    // MSG_ID_AHRS
+		165: {"HWSTATUS", 21, 3, 3, func() Message { return new(Hwstatus) }},               // MSG_ID_HWSTATUS
+		173: {"RANGEFINDER", 83, 8, 8, func() Message { return new(Rangefinder) }},         // MSG_ID_RANGEFINDER
+	},
+}
+
+func init() {
+	registerDialect(DialectArdupilotmega)
+}
@@ -1,7 +1,7 @@
 /**
  * Dronesmith API
  *
- * This is an autogenerated file, with modifications.
+ * This is an autogenerated file.
  *
  * Authors
  *  Geoff Gardner <geoff@dronesmith.io>
@@ -29,6 +29,8 @@ import (
 ////////////////////////////////////////////////////////////////////////
 
 // MavAutopilot: Micro air vehicle / autopilot classes. This identifies the individual model.
+type MavAutopilot uint32
+
 const (
 	MAV_AUTOPILOT_GENERIC                                      = 0  // Generic autopilot, full support for everything
 	MAV_AUTOPILOT_RESERVED                                     = 1  // Reserved for future use.
@@ -50,7 +52,57 @@ const (
 	MAV_AUTOPILOT_ASLUAV                                       = 17 // ASLUAV autopilot -- http://www.asl.ethz.ch
 )
 
+var mavAutopilotEntries = map[MavAutopilot]enumEntry{
+	MAV_AUTOPILOT_GENERIC:                                      {"MAV_AUTOPILOT_GENERIC", "Generic autopilot, full support for everything"},
+	MAV_AUTOPILOT_RESERVED:                                     {"MAV_AUTOPILOT_RESERVED", "Reserved for future use."},
+	MAV_AUTOPILOT_SLUGS:                                        {"MAV_AUTOPILOT_SLUGS", "SLUGS autopilot, http://slugsuav.soe.ucsc.edu"},
+	MAV_AUTOPILOT_ARDUPILOTMEGA:                                {"MAV_AUTOPILOT_ARDUPILOTMEGA", "ArduPilotMega / ArduCopter, http://diydrones.com"},
+	MAV_AUTOPILOT_OPENPILOT:                                    {"MAV_AUTOPILOT_OPENPILOT", "OpenPilot, http://openpilot.org"},
+	MAV_AUTOPILOT_GENERIC_WAYPOINTS_ONLY:                       {"MAV_AUTOPILOT_GENERIC_WAYPOINTS_ONLY", "Generic autopilot only supporting simple waypoints"},
+	MAV_AUTOPILOT_GENERIC_WAYPOINTS_AND_SIMPLE_NAVIGATION_ONLY: {"MAV_AUTOPILOT_GENERIC_WAYPOINTS_AND_SIMPLE_NAVIGATION_ONLY", "Generic autopilot supporting waypoints and other simple navigation commands"},
+	MAV_AUTOPILOT_GENERIC_MISSION_FULL:                         {"MAV_AUTOPILOT_GENERIC_MISSION_FULL", "Generic autopilot supporting the full mission command set"},
+	MAV_AUTOPILOT_INVALID:                                      {"MAV_AUTOPILOT_INVALID", "No valid autopilot, e.g. a GCS or other MAVLink component"},
+	MAV_AUTOPILOT_PPZ:                                          {"MAV_AUTOPILOT_PPZ", "PPZ UAV - http://nongnu.org/paparazzi"},
+	MAV_AUTOPILOT_UDB:                                          {"MAV_AUTOPILOT_UDB", "UAV Dev Board"},
+	MAV_AUTOPILOT_FP:                                           {"MAV_AUTOPILOT_FP", "FlexiPilot"},
+	MAV_AUTOPILOT_PX4:                                          {"MAV_AUTOPILOT_PX4", "PX4 Autopilot - http://pixhawk.ethz.ch/px4/"},
+	MAV_AUTOPILOT_SMACCMPILOT:                                  {"MAV_AUTOPILOT_SMACCMPILOT", "SMACCMPilot - http://smaccmpilot.org"},
+	MAV_AUTOPILOT_AUTOQUAD:                                     {"MAV_AUTOPILOT_AUTOQUAD", "AutoQuad -- http://autoquad.org"},
+	MAV_AUTOPILOT_ARMAZILA:                                     {"MAV_AUTOPILOT_ARMAZILA", "Armazila -- http://armazila.com"},
+	MAV_AUTOPILOT_AEROB:                                        {"MAV_AUTOPILOT_AEROB", "Aerob -- http://aerob.ru"},
+	MAV_AUTOPILOT_ASLUAV:                                       {"MAV_AUTOPILOT_ASLUAV", "ASLUAV autopilot -- http://www.asl.ethz.ch"},
+}
+
+func (e MavAutopilot) String() string {
+	if entry, ok := mavAutopilotEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavAutopilot(%d)", uint32(e))
+}
+
+func (e MavAutopilot) Description() string {
+	return mavAutopilotEntries[e].description
+}
+
+// Valid reports whether e is a MAV_AUTOPILOT entry
+func (e MavAutopilot) Valid() bool {
+	_, ok := mavAutopilotEntries[e]
+	return ok
+}
+
+// ParseMavAutopilot returns the MAV_AUTOPILOT entry called name
+func ParseMavAutopilot(name string) (MavAutopilot, error) {
+	for e, entry := range mavAutopilotEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_AUTOPILOT %q", name)
+}
+
 // MavType:
+type MavType uint32
+
 const (
 	MAV_TYPE_GENERIC            = 0  // Generic micro air vehicle.
 	MAV_TYPE_FIXED_WING         = 1  // Fixed wing aircraft.
@@ -82,7 +134,67 @@ const (
 	MAV_TYPE_ADSB               = 27 // Onboard ADSB peripheral
 )
 
+var mavTypeEntries = map[MavType]enumEntry{
+	MAV_TYPE_GENERIC:            {"MAV_TYPE_GENERIC", "Generic micro air vehicle."},
+	MAV_TYPE_FIXED_WING:         {"MAV_TYPE_FIXED_WING", "Fixed wing aircraft."},
+	MAV_TYPE_QUADROTOR:          {"MAV_TYPE_QUADROTOR", "Quadrotor"},
+	MAV_TYPE_COAXIAL:            {"MAV_TYPE_COAXIAL", "Coaxial helicopter"},
+	MAV_TYPE_HELICOPTER:         {"MAV_TYPE_HELICOPTER", "Normal helicopter with tail rotor."},
+	MAV_TYPE_ANTENNA_TRACKER:    {"MAV_TYPE_ANTENNA_TRACKER", "Ground installation"},
+	MAV_TYPE_GCS:                {"MAV_TYPE_GCS", "Operator control unit / ground control station"},
+	MAV_TYPE_AIRSHIP:            {"MAV_TYPE_AIRSHIP", "Airship, controlled"},
+	MAV_TYPE_FREE_BALLOON:       {"MAV_TYPE_FREE_BALLOON", "Free balloon, uncontrolled"},
+	MAV_TYPE_ROCKET:             {"MAV_TYPE_ROCKET", "Rocket"},
+	MAV_TYPE_GROUND_ROVER:       {"MAV_TYPE_GROUND_ROVER", "Ground rover"},
+	MAV_TYPE_SURFACE_BOAT:       {"MAV_TYPE_SURFACE_BOAT", "Surface vessel, boat, ship"},
+	MAV_TYPE_SUBMARINE:          {"MAV_TYPE_SUBMARINE", "Submarine"},
+	MAV_TYPE_HEXAROTOR:          {"MAV_TYPE_HEXAROTOR", "Hexarotor"},
+	MAV_TYPE_OCTOROTOR:          {"MAV_TYPE_OCTOROTOR", "Octorotor"},
+	MAV_TYPE_TRICOPTER:          {"MAV_TYPE_TRICOPTER", "Octorotor"},
+	MAV_TYPE_FLAPPING_WING:      {"MAV_TYPE_FLAPPING_WING", "Flapping wing"},
+	MAV_TYPE_KITE:               {"MAV_TYPE_KITE", "Flapping wing"},
+	MAV_TYPE_ONBOARD_CONTROLLER: {"MAV_TYPE_ONBOARD_CONTROLLER", "Onboard companion controller"},
+	MAV_TYPE_VTOL_DUOROTOR:      {"MAV_TYPE_VTOL_DUOROTOR", "Two-rotor VTOL using control surfaces in vertical operation in addition. Tailsitter."},
+	MAV_TYPE_VTOL_QUADROTOR:     {"MAV_TYPE_VTOL_QUADROTOR", "Quad-rotor VTOL using a V-shaped quad config in vertical operation. Tailsitter."},
+	MAV_TYPE_VTOL_TILTROTOR:     {"MAV_TYPE_VTOL_TILTROTOR", "Tiltrotor VTOL"},
+	MAV_TYPE_VTOL_RESERVED2:     {"MAV_TYPE_VTOL_RESERVED2", "VTOL reserved 2"},
+	MAV_TYPE_VTOL_RESERVED3:     {"MAV_TYPE_VTOL_RESERVED3", "VTOL reserved 3"},
+	MAV_TYPE_VTOL_RESERVED4:     {"MAV_TYPE_VTOL_RESERVED4", "VTOL reserved 4"},
+	MAV_TYPE_VTOL_RESERVED5:     {"MAV_TYPE_VTOL_RESERVED5", "VTOL reserved 5"},
+	MAV_TYPE_GIMBAL:             {"MAV_TYPE_GIMBAL", "Onboard gimbal"},
+	MAV_TYPE_ADSB:               {"MAV_TYPE_ADSB", "Onboard ADSB peripheral"},
+}
+
+func (e MavType) String() string {
+	if entry, ok := mavTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavType(%d)", uint32(e))
+}
+
+func (e MavType) Description() string {
+	return mavTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_TYPE entry
+func (e MavType) Valid() bool {
+	_, ok := mavTypeEntries[e]
+	return ok
+}
+
+// ParseMavType returns the MAV_TYPE entry called name
+func ParseMavType(name string) (MavType, error) {
+	for e, entry := range mavTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_TYPE %q", name)
+}
+
 // FirmwareVersionType: These values define the type of firmware release.  These values indicate the first version or release of this type.  For example the first alpha release would be 64, the second would be 65.
+type FirmwareVersionType uint32
+
 const (
 	FIRMWARE_VERSION_TYPE_DEV      = 0   // development release
 	FIRMWARE_VERSION_TYPE_ALPHA    = 64  // alpha release
@@ -91,7 +203,44 @@ const (
 	FIRMWARE_VERSION_TYPE_OFFICIAL = 255 // official stable release
 )
 
+var firmwareVersionTypeEntries = map[FirmwareVersionType]enumEntry{
+	FIRMWARE_VERSION_TYPE_DEV:      {"FIRMWARE_VERSION_TYPE_DEV", "development release"},
+	FIRMWARE_VERSION_TYPE_ALPHA:    {"FIRMWARE_VERSION_TYPE_ALPHA", "alpha release"},
+	FIRMWARE_VERSION_TYPE_BETA:     {"FIRMWARE_VERSION_TYPE_BETA", "beta release"},
+	FIRMWARE_VERSION_TYPE_RC:       {"FIRMWARE_VERSION_TYPE_RC", "release candidate"},
+	FIRMWARE_VERSION_TYPE_OFFICIAL: {"FIRMWARE_VERSION_TYPE_OFFICIAL", "official stable release"},
+}
+
+func (e FirmwareVersionType) String() string {
+	if entry, ok := firmwareVersionTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("FirmwareVersionType(%d)", uint32(e))
+}
+
+func (e FirmwareVersionType) Description() string {
+	return firmwareVersionTypeEntries[e].description
+}
+
+// Valid reports whether e is a FIRMWARE_VERSION_TYPE entry
+func (e FirmwareVersionType) Valid() bool {
+	_, ok := firmwareVersionTypeEntries[e]
+	return ok
+}
+
+// ParseFirmwareVersionType returns the FIRMWARE_VERSION_TYPE entry called name
+func ParseFirmwareVersionType(name string) (FirmwareVersionType, error) {
+	for e, entry := range firmwareVersionTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown FIRMWARE_VERSION_TYPE %q", name)
+}
+
 // MavModeFlag: These flags encode the MAV mode.
+type MavModeFlag uint32
+
 const (
 	MAV_MODE_FLAG_SAFETY_ARMED         = 128 // 0b10000000 MAV safety set to armed. Motors are enabled / running / can start. Ready to fly.
 	MAV_MODE_FLAG_MANUAL_INPUT_ENABLED = 64  // 0b01000000 remote control input is enabled.
@@ -103,7 +252,47 @@ const (
 	MAV_MODE_FLAG_CUSTOM_MODE_ENABLED  = 1   // 0b00000001 Reserved for future use.
 )
 
+var mavModeFlagEntries = map[MavModeFlag]enumEntry{
+	MAV_MODE_FLAG_SAFETY_ARMED:         {"MAV_MODE_FLAG_SAFETY_ARMED", "0b10000000 MAV safety set to armed. Motors are enabled / running / can start. Ready to fly."},
+	MAV_MODE_FLAG_MANUAL_INPUT_ENABLED: {"MAV_MODE_FLAG_MANUAL_INPUT_ENABLED", "0b01000000 remote control input is enabled."},
+	MAV_MODE_FLAG_HIL_ENABLED:          {"MAV_MODE_FLAG_HIL_ENABLED", "0b00100000 hardware in the loop simulation. All motors / actuators are blocked, but internal software is full operational."},
+	MAV_MODE_FLAG_STABILIZE_ENABLED:    {"MAV_MODE_FLAG_STABILIZE_ENABLED", "0b00010000 system stabilizes electronically its attitude (and optionally position). It needs however further control inputs to move around."},
+	MAV_MODE_FLAG_GUIDED_ENABLED:       {"MAV_MODE_FLAG_GUIDED_ENABLED", "0b00001000 guided mode enabled, system flies MISSIONs / mission items."},
+	MAV_MODE_FLAG_AUTO_ENABLED:         {"MAV_MODE_FLAG_AUTO_ENABLED", "0b00000100 autonomous mode enabled, system finds its own goal positions. Guided flag can be set or not, depends on the actual implementation."},
+	MAV_MODE_FLAG_TEST_ENABLED:         {"MAV_MODE_FLAG_TEST_ENABLED", "0b00000010 system has a test mode enabled. This flag is intended for temporary system tests and should not be used for stable implementations."},
+	MAV_MODE_FLAG_CUSTOM_MODE_ENABLED:  {"MAV_MODE_FLAG_CUSTOM_MODE_ENABLED", "0b00000001 Reserved for future use."},
+}
+
+func (e MavModeFlag) String() string {
+	if entry, ok := mavModeFlagEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavModeFlag(%d)", uint32(e))
+}
+
+func (e MavModeFlag) Description() string {
+	return mavModeFlagEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MODE_FLAG entry
+func (e MavModeFlag) Valid() bool {
+	_, ok := mavModeFlagEntries[e]
+	return ok
+}
+
+// ParseMavModeFlag returns the MAV_MODE_FLAG entry called name
+func ParseMavModeFlag(name string) (MavModeFlag, error) {
+	for e, entry := range mavModeFlagEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MODE_FLAG %q", name)
+}
+
 // MavModeFlagDecodePosition: These values encode the bit positions of the decode position. These values can be used to read the value of a flag bit by combining the base_mode variable with AND with the flag position value. The result will be either 0 or 1, depending on if the flag is set or not.
+type MavModeFlagDecodePosition uint32
+
 const (
 	MAV_MODE_FLAG_DECODE_POSITION_SAFETY      = 128 // First bit:  10000000
 	MAV_MODE_FLAG_DECODE_POSITION_MANUAL      = 64  // Second bit: 01000000
@@ -115,7 +304,47 @@ const (
 	MAV_MODE_FLAG_DECODE_POSITION_CUSTOM_MODE = 1   // Eighth bit: 00000001
 )
 
+var mavModeFlagDecodePositionEntries = map[MavModeFlagDecodePosition]enumEntry{
+	MAV_MODE_FLAG_DECODE_POSITION_SAFETY:      {"MAV_MODE_FLAG_DECODE_POSITION_SAFETY", "First bit:  10000000"},
+	MAV_MODE_FLAG_DECODE_POSITION_MANUAL:      {"MAV_MODE_FLAG_DECODE_POSITION_MANUAL", "Second bit: 01000000"},
+	MAV_MODE_FLAG_DECODE_POSITION_HIL:         {"MAV_MODE_FLAG_DECODE_POSITION_HIL", "Third bit:  00100000"},
+	MAV_MODE_FLAG_DECODE_POSITION_STABILIZE:   {"MAV_MODE_FLAG_DECODE_POSITION_STABILIZE", "Fourth bit: 00010000"},
+	MAV_MODE_FLAG_DECODE_POSITION_GUIDED:      {"MAV_MODE_FLAG_DECODE_POSITION_GUIDED", "Fifth bit:  00001000"},
+	MAV_MODE_FLAG_DECODE_POSITION_AUTO:        {"MAV_MODE_FLAG_DECODE_POSITION_AUTO", "Sixt bit:   00000100"},
+	MAV_MODE_FLAG_DECODE_POSITION_TEST:        {"MAV_MODE_FLAG_DECODE_POSITION_TEST", "Seventh bit: 00000010"},
+	MAV_MODE_FLAG_DECODE_POSITION_CUSTOM_MODE: {"MAV_MODE_FLAG_DECODE_POSITION_CUSTOM_MODE", "Eighth bit: 00000001"},
+}
+
+func (e MavModeFlagDecodePosition) String() string {
+	if entry, ok := mavModeFlagDecodePositionEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavModeFlagDecodePosition(%d)", uint32(e))
+}
+
+func (e MavModeFlagDecodePosition) Description() string {
+	return mavModeFlagDecodePositionEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MODE_FLAG_DECODE_POSITION entry
+func (e MavModeFlagDecodePosition) Valid() bool {
+	_, ok := mavModeFlagDecodePositionEntries[e]
+	return ok
+}
+
+// ParseMavModeFlagDecodePosition returns the MAV_MODE_FLAG_DECODE_POSITION entry called name
+func ParseMavModeFlagDecodePosition(name string) (MavModeFlagDecodePosition, error) {
+	for e, entry := range mavModeFlagDecodePositionEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MODE_FLAG_DECODE_POSITION %q", name)
+}
+
 // MavGoto: Override command, pauses current mission execution and moves immediately to a position
+type MavGoto uint32
+
 const (
 	MAV_GOTO_DO_HOLD                    = 0 // Hold at the current position.
 	MAV_GOTO_DO_CONTINUE                = 1 // Continue with the next item in mission execution.
@@ -123,7 +352,43 @@ const (
 	MAV_GOTO_HOLD_AT_SPECIFIED_POSITION = 3 // Hold at the position specified in the parameters of the DO_HOLD action
 )
 
+var mavGotoEntries = map[MavGoto]enumEntry{
+	MAV_GOTO_DO_HOLD:                    {"MAV_GOTO_DO_HOLD", "Hold at the current position."},
+	MAV_GOTO_DO_CONTINUE:                {"MAV_GOTO_DO_CONTINUE", "Continue with the next item in mission execution."},
+	MAV_GOTO_HOLD_AT_CURRENT_POSITION:   {"MAV_GOTO_HOLD_AT_CURRENT_POSITION", "Hold at the current position of the system"},
+	MAV_GOTO_HOLD_AT_SPECIFIED_POSITION: {"MAV_GOTO_HOLD_AT_SPECIFIED_POSITION", "Hold at the position specified in the parameters of the DO_HOLD action"},
+}
+
+func (e MavGoto) String() string {
+	if entry, ok := mavGotoEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavGoto(%d)", uint32(e))
+}
+
+func (e MavGoto) Description() string {
+	return mavGotoEntries[e].description
+}
+
+// Valid reports whether e is a MAV_GOTO entry
+func (e MavGoto) Valid() bool {
+	_, ok := mavGotoEntries[e]
+	return ok
+}
+
+// ParseMavGoto returns the MAV_GOTO entry called name
+func ParseMavGoto(name string) (MavGoto, error) {
+	for e, entry := range mavGotoEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_GOTO %q", name)
+}
+
 // MavMode: These defines are predefined OR-combined mode flags. There is no need to use values from this enum, but it                simplifies the use of the mode flags. Note that manual input is enabled in all modes as a safety override.
+type MavMode uint32
+
 const (
 	MAV_MODE_PREFLIGHT          = 0   // System is not ready to fly, booting, calibrating, etc. No flag is set.
 	MAV_MODE_STABILIZE_DISARMED = 80  // System is allowed to be active, under assisted RC control.
@@ -138,7 +403,50 @@ const (
 	MAV_MODE_TEST_ARMED         = 194 // UNDEFINED mode. This solely depends on the autopilot - use with caution, intended for developers only.
 )
 
+var mavModeEntries = map[MavMode]enumEntry{
+	MAV_MODE_PREFLIGHT:          {"MAV_MODE_PREFLIGHT", "System is not ready to fly, booting, calibrating, etc. No flag is set."},
+	MAV_MODE_STABILIZE_DISARMED: {"MAV_MODE_STABILIZE_DISARMED", "System is allowed to be active, under assisted RC control."},
+	MAV_MODE_STABILIZE_ARMED:    {"MAV_MODE_STABILIZE_ARMED", "System is allowed to be active, under assisted RC control."},
+	MAV_MODE_MANUAL_DISARMED:    {"MAV_MODE_MANUAL_DISARMED", "System is allowed to be active, under manual (RC) control, no stabilization"},
+	MAV_MODE_MANUAL_ARMED:       {"MAV_MODE_MANUAL_ARMED", "System is allowed to be active, under manual (RC) control, no stabilization"},
+	MAV_MODE_GUIDED_DISARMED:    {"MAV_MODE_GUIDED_DISARMED", "System is allowed to be active, under autonomous control, manual setpoint"},
+	MAV_MODE_GUIDED_ARMED:       {"MAV_MODE_GUIDED_ARMED", "System is allowed to be active, under autonomous control, manual setpoint"},
+	MAV_MODE_AUTO_DISARMED:      {"MAV_MODE_AUTO_DISARMED", "System is allowed to be active, under autonomous control and navigation (the trajectory is decided onboard and not pre-programmed by MISSIONs)"},
+	MAV_MODE_AUTO_ARMED:         {"MAV_MODE_AUTO_ARMED", "System is allowed to be active, under autonomous control and navigation (the trajectory is decided onboard and not pre-programmed by MISSIONs)"},
+	MAV_MODE_TEST_DISARMED:      {"MAV_MODE_TEST_DISARMED", "UNDEFINED mode. This solely depends on the autopilot - use with caution, intended for developers only."},
+	MAV_MODE_TEST_ARMED:         {"MAV_MODE_TEST_ARMED", "UNDEFINED mode. This solely depends on the autopilot - use with caution, intended for developers only."},
+}
+
+func (e MavMode) String() string {
+	if entry, ok := mavModeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavMode(%d)", uint32(e))
+}
+
+func (e MavMode) Description() string {
+	return mavModeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MODE entry
+func (e MavMode) Valid() bool {
+	_, ok := mavModeEntries[e]
+	return ok
+}
+
+// ParseMavMode returns the MAV_MODE entry called name
+func ParseMavMode(name string) (MavMode, error) {
+	for e, entry := range mavModeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MODE %q", name)
+}
+
 // MavState:
+type MavState uint32
+
 const (
 	MAV_STATE_UNINIT      = 0 // Uninitialized system, state is unknown.
 	MAV_STATE_BOOT        = 1 // System is booting up.
@@ -150,7 +458,47 @@ const (
 	MAV_STATE_POWEROFF    = 7 // System just initialized its power-down sequence, will shut down now.
 )
 
+var mavStateEntries = map[MavState]enumEntry{
+	MAV_STATE_UNINIT:      {"MAV_STATE_UNINIT", "Uninitialized system, state is unknown."},
+	MAV_STATE_BOOT:        {"MAV_STATE_BOOT", "System is booting up."},
+	MAV_STATE_CALIBRATING: {"MAV_STATE_CALIBRATING", "System is calibrating and not flight-ready."},
+	MAV_STATE_STANDBY:     {"MAV_STATE_STANDBY", "System is grounded and on standby. It can be launched any time."},
+	MAV_STATE_ACTIVE:      {"MAV_STATE_ACTIVE", "System is active and might be already airborne. Motors are engaged."},
+	MAV_STATE_CRITICAL:    {"MAV_STATE_CRITICAL", "System is in a non-normal flight mode. It can however still navigate."},
+	MAV_STATE_EMERGENCY:   {"MAV_STATE_EMERGENCY", "System is in a non-normal flight mode. It lost control over parts or over the whole airframe. It is in mayday and going down."},
+	MAV_STATE_POWEROFF:    {"MAV_STATE_POWEROFF", "System just initialized its power-down sequence, will shut down now."},
+}
+
+func (e MavState) String() string {
+	if entry, ok := mavStateEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavState(%d)", uint32(e))
+}
+
+func (e MavState) Description() string {
+	return mavStateEntries[e].description
+}
+
+// Valid reports whether e is a MAV_STATE entry
+func (e MavState) Valid() bool {
+	_, ok := mavStateEntries[e]
+	return ok
+}
+
+// ParseMavState returns the MAV_STATE entry called name
+func ParseMavState(name string) (MavState, error) {
+	for e, entry := range mavStateEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_STATE %q", name)
+}
+
 // MavComponent:
+type MavComponent uint32
+
 const (
 	MAV_COMP_ID_ALL            = 0   //
 	MAV_COMP_ID_GPS            = 220 //
@@ -186,35 +534,155 @@ const (
 	MAV_COMP_ID_QX1_GIMBAL     = 159 //
 )
 
+var mavComponentEntries = map[MavComponent]enumEntry{
+	MAV_COMP_ID_ALL:            {"MAV_COMP_ID_ALL", ""},
+	MAV_COMP_ID_GPS:            {"MAV_COMP_ID_GPS", ""},
+	MAV_COMP_ID_MISSIONPLANNER: {"MAV_COMP_ID_MISSIONPLANNER", ""},
+	MAV_COMP_ID_PATHPLANNER:    {"MAV_COMP_ID_PATHPLANNER", ""},
+	MAV_COMP_ID_MAPPER:         {"MAV_COMP_ID_MAPPER", ""},
+	MAV_COMP_ID_CAMERA:         {"MAV_COMP_ID_CAMERA", ""},
+	MAV_COMP_ID_IMU:            {"MAV_COMP_ID_IMU", ""},
+	MAV_COMP_ID_IMU_2:          {"MAV_COMP_ID_IMU_2", ""},
+	MAV_COMP_ID_IMU_3:          {"MAV_COMP_ID_IMU_3", ""},
+	MAV_COMP_ID_UDP_BRIDGE:     {"MAV_COMP_ID_UDP_BRIDGE", ""},
+	MAV_COMP_ID_UART_BRIDGE:    {"MAV_COMP_ID_UART_BRIDGE", ""},
+	MAV_COMP_ID_SYSTEM_CONTROL: {"MAV_COMP_ID_SYSTEM_CONTROL", ""},
+	MAV_COMP_ID_SERVO1:         {"MAV_COMP_ID_SERVO1", ""},
+	MAV_COMP_ID_SERVO2:         {"MAV_COMP_ID_SERVO2", ""},
+	MAV_COMP_ID_SERVO3:         {"MAV_COMP_ID_SERVO3", ""},
+	MAV_COMP_ID_SERVO4:         {"MAV_COMP_ID_SERVO4", ""},
+	MAV_COMP_ID_SERVO5:         {"MAV_COMP_ID_SERVO5", ""},
+	MAV_COMP_ID_SERVO6:         {"MAV_COMP_ID_SERVO6", ""},
+	MAV_COMP_ID_SERVO7:         {"MAV_COMP_ID_SERVO7", ""},
+	MAV_COMP_ID_SERVO8:         {"MAV_COMP_ID_SERVO8", ""},
+	MAV_COMP_ID_SERVO9:         {"MAV_COMP_ID_SERVO9", ""},
+	MAV_COMP_ID_SERVO10:        {"MAV_COMP_ID_SERVO10", ""},
+	MAV_COMP_ID_SERVO11:        {"MAV_COMP_ID_SERVO11", ""},
+	MAV_COMP_ID_SERVO12:        {"MAV_COMP_ID_SERVO12", ""},
+	MAV_COMP_ID_SERVO13:        {"MAV_COMP_ID_SERVO13", ""},
+	MAV_COMP_ID_SERVO14:        {"MAV_COMP_ID_SERVO14", ""},
+	MAV_COMP_ID_GIMBAL:         {"MAV_COMP_ID_GIMBAL", ""},
+	MAV_COMP_ID_LOG:            {"MAV_COMP_ID_LOG", ""},
+	MAV_COMP_ID_ADSB:           {"MAV_COMP_ID_ADSB", ""},
+	MAV_COMP_ID_OSD:            {"MAV_COMP_ID_OSD", "On Screen Display (OSD) devices for video links"},
+	MAV_COMP_ID_PERIPHERAL:     {"MAV_COMP_ID_PERIPHERAL", "Generic autopilot peripheral component ID. Meant for devices that do not implement the parameter sub-protocol"},
+	MAV_COMP_ID_QX1_GIMBAL:     {"MAV_COMP_ID_QX1_GIMBAL", ""},
+}
+
+func (e MavComponent) String() string {
+	if entry, ok := mavComponentEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavComponent(%d)", uint32(e))
+}
+
+func (e MavComponent) Description() string {
+	return mavComponentEntries[e].description
+}
+
+// Valid reports whether e is a MAV_COMPONENT entry
+func (e MavComponent) Valid() bool {
+	_, ok := mavComponentEntries[e]
+	return ok
+}
+
+// ParseMavComponent returns the MAV_COMPONENT entry called name
+func ParseMavComponent(name string) (MavComponent, error) {
+	for e, entry := range mavComponentEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_COMPONENT %q", name)
+}
+
 // MavSysStatusSensor: These encode the sensors whose status is sent as part of the SYS_STATUS message.
+type MavSysStatusSensor uint32
+
 const (
-	MAV_SYS_STATUS_SENSOR_3D_GYRO                = 1   // 0x01 3D gyro
-	MAV_SYS_STATUS_SENSOR_3D_ACCEL               = 2   // 0x02 3D accelerometer
-	MAV_SYS_STATUS_SENSOR_3D_MAG                 = 4   // 0x04 3D magnetometer
-	MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE      = 8   // 0x08 absolute pressure
-	MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE  = 16  // 0x10 differential pressure
-	MAV_SYS_STATUS_SENSOR_GPS                    = 32  // 0x20 GPS
-	MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW           = 64  // 0x40 optical flow
-	MAV_SYS_STATUS_SENSOR_VISION_POSITION        = 128 // 0x80 computer vision position
-	MAV_SYS_STATUS_SENSOR_LASER_POSITION         = 8   // 0x100 laser based position
-	MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH  = 9   // 0x200 external ground truth (Vicon or Leica)
-	MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL   = 10  // 0x400 3D angular rate control
-	MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION = 11  // 0x800 attitude stabilization
-	MAV_SYS_STATUS_SENSOR_YAW_POSITION           = 12  // 0x1000 yaw position
-	MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL     = 13  // 0x2000 z/altitude control
-	MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL    = 14  // 0x4000 x/y position control
-	MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS          = 15  // 0x8000 motor outputs / control
-	MAV_SYS_STATUS_SENSOR_RC_RECEIVER            = 16  // 0x10000 rc receiver
-	MAV_SYS_STATUS_SENSOR_3D_GYRO2               = 17  // 0x20000 2nd 3D gyro
-	MAV_SYS_STATUS_SENSOR_3D_ACCEL2              = 18  // 0x40000 2nd 3D accelerometer
-	MAV_SYS_STATUS_SENSOR_3D_MAG2                = 19  // 0x80000 2nd 3D magnetometer
-	MAV_SYS_STATUS_GEOFENCE                      = 20  // 0x100000 geofence
-	MAV_SYS_STATUS_AHRS                          = 21  // 0x200000 AHRS subsystem health
-	MAV_SYS_STATUS_TERRAIN                       = 22  // 0x400000 Terrain subsystem health
-	MAV_SYS_STATUS_REVERSE_MOTOR                 = 23  // 0x800000 Motors are reversed
+	MAV_SYS_STATUS_SENSOR_3D_GYRO                = 1       // 0x01 3D gyro
+	MAV_SYS_STATUS_SENSOR_3D_ACCEL               = 2       // 0x02 3D accelerometer
+	MAV_SYS_STATUS_SENSOR_3D_MAG                 = 4       // 0x04 3D magnetometer
+	MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE      = 8       // 0x08 absolute pressure
+	MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE  = 16      // 0x10 differential pressure
+	MAV_SYS_STATUS_SENSOR_GPS                    = 32      // 0x20 GPS
+	MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW           = 64      // 0x40 optical flow
+	MAV_SYS_STATUS_SENSOR_VISION_POSITION        = 128     // 0x80 computer vision position
+	MAV_SYS_STATUS_SENSOR_LASER_POSITION         = 256     // 0x100 laser based position
+	MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH  = 512     // 0x200 external ground truth (Vicon or Leica)
+	MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL   = 1024    // 0x400 3D angular rate control
+	MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION = 2048    // 0x800 attitude stabilization
+	MAV_SYS_STATUS_SENSOR_YAW_POSITION           = 4096    // 0x1000 yaw position
+	MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL     = 8192    // 0x2000 z/altitude control
+	MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL    = 16384   // 0x4000 x/y position control
+	MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS          = 32768   // 0x8000 motor outputs / control
+	MAV_SYS_STATUS_SENSOR_RC_RECEIVER            = 65536   // 0x10000 rc receiver
+	MAV_SYS_STATUS_SENSOR_3D_GYRO2               = 131072  // 0x20000 2nd 3D gyro
+	MAV_SYS_STATUS_SENSOR_3D_ACCEL2              = 262144  // 0x40000 2nd 3D accelerometer
+	MAV_SYS_STATUS_SENSOR_3D_MAG2                = 524288  // 0x80000 2nd 3D magnetometer
+	MAV_SYS_STATUS_GEOFENCE                      = 1048576 // 0x100000 geofence
+	MAV_SYS_STATUS_AHRS                          = 2097152 // 0x200000 AHRS subsystem health
+	MAV_SYS_STATUS_TERRAIN                       = 4194304 // 0x400000 Terrain subsystem health
+	MAV_SYS_STATUS_REVERSE_MOTOR                 = 8388608 // 0x800000 Motors are reversed
 )
 
+var mavSysStatusSensorEntries = map[MavSysStatusSensor]enumEntry{
+	MAV_SYS_STATUS_SENSOR_3D_GYRO:                {"MAV_SYS_STATUS_SENSOR_3D_GYRO", "0x01 3D gyro"},
+	MAV_SYS_STATUS_SENSOR_3D_ACCEL:               {"MAV_SYS_STATUS_SENSOR_3D_ACCEL", "0x02 3D accelerometer"},
+	MAV_SYS_STATUS_SENSOR_3D_MAG:                 {"MAV_SYS_STATUS_SENSOR_3D_MAG", "0x04 3D magnetometer"},
+	MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE:      {"MAV_SYS_STATUS_SENSOR_ABSOLUTE_PRESSURE", "0x08 absolute pressure"},
+	MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE:  {"MAV_SYS_STATUS_SENSOR_DIFFERENTIAL_PRESSURE", "0x10 differential pressure"},
+	MAV_SYS_STATUS_SENSOR_GPS:                    {"MAV_SYS_STATUS_SENSOR_GPS", "0x20 GPS"},
+	MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW:           {"MAV_SYS_STATUS_SENSOR_OPTICAL_FLOW", "0x40 optical flow"},
+	MAV_SYS_STATUS_SENSOR_VISION_POSITION:        {"MAV_SYS_STATUS_SENSOR_VISION_POSITION", "0x80 computer vision position"},
+	MAV_SYS_STATUS_SENSOR_LASER_POSITION:         {"MAV_SYS_STATUS_SENSOR_LASER_POSITION", "0x100 laser based position"},
+	MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH:  {"MAV_SYS_STATUS_SENSOR_EXTERNAL_GROUND_TRUTH", "0x200 external ground truth (Vicon or Leica)"},
+	MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL:   {"MAV_SYS_STATUS_SENSOR_ANGULAR_RATE_CONTROL", "0x400 3D angular rate control"},
+	MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION: {"MAV_SYS_STATUS_SENSOR_ATTITUDE_STABILIZATION", "0x800 attitude stabilization"},
+	MAV_SYS_STATUS_SENSOR_YAW_POSITION:           {"MAV_SYS_STATUS_SENSOR_YAW_POSITION", "0x1000 yaw position"},
+	MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL:     {"MAV_SYS_STATUS_SENSOR_Z_ALTITUDE_CONTROL", "0x2000 z/altitude control"},
+	MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL:    {"MAV_SYS_STATUS_SENSOR_XY_POSITION_CONTROL", "0x4000 x/y position control"},
+	MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS:          {"MAV_SYS_STATUS_SENSOR_MOTOR_OUTPUTS", "0x8000 motor outputs / control"},
+	MAV_SYS_STATUS_SENSOR_RC_RECEIVER:            {"MAV_SYS_STATUS_SENSOR_RC_RECEIVER", "0x10000 rc receiver"},
+	MAV_SYS_STATUS_SENSOR_3D_GYRO2:               {"MAV_SYS_STATUS_SENSOR_3D_GYRO2", "0x20000 2nd 3D gyro"},
+	MAV_SYS_STATUS_SENSOR_3D_ACCEL2:              {"MAV_SYS_STATUS_SENSOR_3D_ACCEL2", "0x40000 2nd 3D accelerometer"},
+	MAV_SYS_STATUS_SENSOR_3D_MAG2:                {"MAV_SYS_STATUS_SENSOR_3D_MAG2", "0x80000 2nd 3D magnetometer"},
+	MAV_SYS_STATUS_GEOFENCE:                      {"MAV_SYS_STATUS_GEOFENCE", "0x100000 geofence"},
+	MAV_SYS_STATUS_AHRS:                          {"MAV_SYS_STATUS_AHRS", "0x200000 AHRS subsystem health"},
+	MAV_SYS_STATUS_TERRAIN:                       {"MAV_SYS_STATUS_TERRAIN", "0x400000 Terrain subsystem health"},
+	MAV_SYS_STATUS_REVERSE_MOTOR:                 {"MAV_SYS_STATUS_REVERSE_MOTOR", "0x800000 Motors are reversed"},
+}
+
+func (e MavSysStatusSensor) String() string {
+	if entry, ok := mavSysStatusSensorEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavSysStatusSensor(%d)", uint32(e))
+}
+
+func (e MavSysStatusSensor) Description() string {
+	return mavSysStatusSensorEntries[e].description
+}
+
+// Valid reports whether e is a MAV_SYS_STATUS_SENSOR entry
+func (e MavSysStatusSensor) Valid() bool {
+	_, ok := mavSysStatusSensorEntries[e]
+	return ok
+}
+
+// ParseMavSysStatusSensor returns the MAV_SYS_STATUS_SENSOR entry called name
+func ParseMavSysStatusSensor(name string) (MavSysStatusSensor, error) {
+	for e, entry := range mavSysStatusSensorEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_SYS_STATUS_SENSOR %q", name)
+}
+
 // MavFrame:
+type MavFrame uint32
+
 const (
 	MAV_FRAME_GLOBAL                  = 0  // Global coordinate frame, WGS84 coordinate system. First value / x: latitude, second value / y: longitude, third value / z: positive altitude over mean sea level (MSL)
 	MAV_FRAME_LOCAL_NED               = 1  // Local coordinate frame, Z-up (x: north, y: east, z: down).
@@ -230,7 +698,51 @@ const (
 	MAV_FRAME_GLOBAL_TERRAIN_ALT_INT  = 11 // Global coordinate frame with above terrain level altitude. WGS84 coordinate system, relative altitude over terrain with respect to the waypoint coordinate. First value / x: latitude in degrees*10e-7, second value / y: longitude in degrees*10e-7, third value / z: positive altitude in meters with 0 being at ground level in terrain model.
 )
 
+var mavFrameEntries = map[MavFrame]enumEntry{
+	MAV_FRAME_GLOBAL:                  {"MAV_FRAME_GLOBAL", "Global coordinate frame, WGS84 coordinate system. First value / x: latitude, second value / y: longitude, third value / z: positive altitude over mean sea level (MSL)"},
+	MAV_FRAME_LOCAL_NED:               {"MAV_FRAME_LOCAL_NED", "Local coordinate frame, Z-up (x: north, y: east, z: down)."},
+	MAV_FRAME_MISSION:                 {"MAV_FRAME_MISSION", "NOT a coordinate frame, indicates a mission command."},
+	MAV_FRAME_GLOBAL_RELATIVE_ALT:     {"MAV_FRAME_GLOBAL_RELATIVE_ALT", "Global coordinate frame, WGS84 coordinate system, relative altitude over ground with respect to the home position. First value / x: latitude, second value / y: longitude, third value / z: positive altitude with 0 being at the altitude of the home location."},
+	MAV_FRAME_LOCAL_ENU:               {"MAV_FRAME_LOCAL_ENU", "Local coordinate frame, Z-down (x: east, y: north, z: up)"},
+	MAV_FRAME_GLOBAL_INT:              {"MAV_FRAME_GLOBAL_INT", "Global coordinate frame, WGS84 coordinate system. First value / x: latitude in degrees*1.0e-7, second value / y: longitude in degrees*1.0e-7, third value / z: positive altitude over mean sea level (MSL)"},
+	MAV_FRAME_GLOBAL_RELATIVE_ALT_INT: {"MAV_FRAME_GLOBAL_RELATIVE_ALT_INT", "Global coordinate frame, WGS84 coordinate system, relative altitude over ground with respect to the home position. First value / x: latitude in degrees*10e-7, second value / y: longitude in degrees*10e-7, third value / z: positive altitude with 0 being at the altitude of the home location."},
+	MAV_FRAME_LOCAL_OFFSET_NED:        {"MAV_FRAME_LOCAL_OFFSET_NED", "Offset to the current local frame. Anything expressed in this frame should be added to the current local frame position."},
+	MAV_FRAME_BODY_NED:                {"MAV_FRAME_BODY_NED", "Setpoint in body NED frame. This makes sense if all position control is externalized - e.g. useful to command 2 m/s^2 acceleration to the right."},
+	MAV_FRAME_BODY_OFFSET_NED:         {"MAV_FRAME_BODY_OFFSET_NED", "Offset in body NED frame. This makes sense if adding setpoints to the current flight path, to avoid an obstacle - e.g. useful to command 2 m/s^2 acceleration to the east."},
+	MAV_FRAME_GLOBAL_TERRAIN_ALT:      {"MAV_FRAME_GLOBAL_TERRAIN_ALT", "Global coordinate frame with above terrain level altitude. WGS84 coordinate system, relative altitude over terrain with respect to the waypoint coordinate. First value / x: latitude in degrees, second value / y: longitude in degrees, third value / z: positive altitude in meters with 0 being at ground level in terrain model."},
+	MAV_FRAME_GLOBAL_TERRAIN_ALT_INT:  {"MAV_FRAME_GLOBAL_TERRAIN_ALT_INT", "Global coordinate frame with above terrain level altitude. WGS84 coordinate system, relative altitude over terrain with respect to the waypoint coordinate. First value / x: latitude in degrees*10e-7, second value / y: longitude in degrees*10e-7, third value / z: positive altitude in meters with 0 being at ground level in terrain model."},
+}
+
+func (e MavFrame) String() string {
+	if entry, ok := mavFrameEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavFrame(%d)", uint32(e))
+}
+
+func (e MavFrame) Description() string {
+	return mavFrameEntries[e].description
+}
+
+// Valid reports whether e is a MAV_FRAME entry
+func (e MavFrame) Valid() bool {
+	_, ok := mavFrameEntries[e]
+	return ok
+}
+
+// ParseMavFrame returns the MAV_FRAME entry called name
+func ParseMavFrame(name string) (MavFrame, error) {
+	for e, entry := range mavFrameEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_FRAME %q", name)
+}
+
 // MavlinkDataStreamType:
+type MavlinkDataStreamType uint32
+
 const (
 	MAVLINK_DATA_STREAM_IMG_JPEG   = 0 //
 	MAVLINK_DATA_STREAM_IMG_BMP    = 1 //
@@ -240,7 +752,45 @@ const (
 	MAVLINK_DATA_STREAM_IMG_PNG    = 5 //
 )
 
+var mavlinkDataStreamTypeEntries = map[MavlinkDataStreamType]enumEntry{
+	MAVLINK_DATA_STREAM_IMG_JPEG:   {"MAVLINK_DATA_STREAM_IMG_JPEG", ""},
+	MAVLINK_DATA_STREAM_IMG_BMP:    {"MAVLINK_DATA_STREAM_IMG_BMP", ""},
+	MAVLINK_DATA_STREAM_IMG_RAW8U:  {"MAVLINK_DATA_STREAM_IMG_RAW8U", ""},
+	MAVLINK_DATA_STREAM_IMG_RAW32U: {"MAVLINK_DATA_STREAM_IMG_RAW32U", ""},
+	MAVLINK_DATA_STREAM_IMG_PGM:    {"MAVLINK_DATA_STREAM_IMG_PGM", ""},
+	MAVLINK_DATA_STREAM_IMG_PNG:    {"MAVLINK_DATA_STREAM_IMG_PNG", ""},
+}
+
+func (e MavlinkDataStreamType) String() string {
+	if entry, ok := mavlinkDataStreamTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavlinkDataStreamType(%d)", uint32(e))
+}
+
+func (e MavlinkDataStreamType) Description() string {
+	return mavlinkDataStreamTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAVLINK_DATA_STREAM_TYPE entry
+func (e MavlinkDataStreamType) Valid() bool {
+	_, ok := mavlinkDataStreamTypeEntries[e]
+	return ok
+}
+
+// ParseMavlinkDataStreamType returns the MAVLINK_DATA_STREAM_TYPE entry called name
+func ParseMavlinkDataStreamType(name string) (MavlinkDataStreamType, error) {
+	for e, entry := range mavlinkDataStreamTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAVLINK_DATA_STREAM_TYPE %q", name)
+}
+
 // FenceAction:
+type FenceAction uint32
+
 const (
 	FENCE_ACTION_NONE            = 0 // Disable fenced mode
 	FENCE_ACTION_GUIDED          = 1 // Switched to guided mode to return point (fence point 0)
@@ -249,7 +799,44 @@ const (
 	FENCE_ACTION_RTL             = 4 // Switch to RTL (return to launch) mode and head for the return point.
 )
 
+var fenceActionEntries = map[FenceAction]enumEntry{
+	FENCE_ACTION_NONE:            {"FENCE_ACTION_NONE", "Disable fenced mode"},
+	FENCE_ACTION_GUIDED:          {"FENCE_ACTION_GUIDED", "Switched to guided mode to return point (fence point 0)"},
+	FENCE_ACTION_REPORT:          {"FENCE_ACTION_REPORT", "Report fence breach, but don't take action"},
+	FENCE_ACTION_GUIDED_THR_PASS: {"FENCE_ACTION_GUIDED_THR_PASS", "Switched to guided mode to return point (fence point 0) with manual throttle control"},
+	FENCE_ACTION_RTL:             {"FENCE_ACTION_RTL", "Switch to RTL (return to launch) mode and head for the return point."},
+}
+
+func (e FenceAction) String() string {
+	if entry, ok := fenceActionEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("FenceAction(%d)", uint32(e))
+}
+
+func (e FenceAction) Description() string {
+	return fenceActionEntries[e].description
+}
+
+// Valid reports whether e is a FENCE_ACTION entry
+func (e FenceAction) Valid() bool {
+	_, ok := fenceActionEntries[e]
+	return ok
+}
+
+// ParseFenceAction returns the FENCE_ACTION entry called name
+func ParseFenceAction(name string) (FenceAction, error) {
+	for e, entry := range fenceActionEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown FENCE_ACTION %q", name)
+}
+
 // FenceBreach:
+type FenceBreach uint32
+
 const (
 	FENCE_BREACH_NONE     = 0 // No last fence breach
 	FENCE_BREACH_MINALT   = 1 // Breached minimum altitude
@@ -257,7 +844,43 @@ const (
 	FENCE_BREACH_BOUNDARY = 3 // Breached fence boundary
 )
 
+var fenceBreachEntries = map[FenceBreach]enumEntry{
+	FENCE_BREACH_NONE:     {"FENCE_BREACH_NONE", "No last fence breach"},
+	FENCE_BREACH_MINALT:   {"FENCE_BREACH_MINALT", "Breached minimum altitude"},
+	FENCE_BREACH_MAXALT:   {"FENCE_BREACH_MAXALT", "Breached maximum altitude"},
+	FENCE_BREACH_BOUNDARY: {"FENCE_BREACH_BOUNDARY", "Breached fence boundary"},
+}
+
+func (e FenceBreach) String() string {
+	if entry, ok := fenceBreachEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("FenceBreach(%d)", uint32(e))
+}
+
+func (e FenceBreach) Description() string {
+	return fenceBreachEntries[e].description
+}
+
+// Valid reports whether e is a FENCE_BREACH entry
+func (e FenceBreach) Valid() bool {
+	_, ok := fenceBreachEntries[e]
+	return ok
+}
+
+// ParseFenceBreach returns the FENCE_BREACH entry called name
+func ParseFenceBreach(name string) (FenceBreach, error) {
+	for e, entry := range fenceBreachEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown FENCE_BREACH %q", name)
+}
+
 // MavMountMode: Enumeration of possible mount operation modes
+type MavMountMode uint32
+
 const (
 	MAV_MOUNT_MODE_RETRACT           = 0 // Load and keep safe position (Roll,Pitch,Yaw) from permant memory and stop stabilization
 	MAV_MOUNT_MODE_NEUTRAL           = 1 // Load and keep neutral position (Roll,Pitch,Yaw) from permanent memory.
@@ -266,110 +889,290 @@ const (
 	MAV_MOUNT_MODE_GPS_POINT         = 4 // Load neutral position and start to point to Lat,Lon,Alt
 )
 
+var mavMountModeEntries = map[MavMountMode]enumEntry{
+	MAV_MOUNT_MODE_RETRACT:           {"MAV_MOUNT_MODE_RETRACT", "Load and keep safe position (Roll,Pitch,Yaw) from permant memory and stop stabilization"},
+	MAV_MOUNT_MODE_NEUTRAL:           {"MAV_MOUNT_MODE_NEUTRAL", "Load and keep neutral position (Roll,Pitch,Yaw) from permanent memory."},
+	MAV_MOUNT_MODE_MAVLINK_TARGETING: {"MAV_MOUNT_MODE_MAVLINK_TARGETING", "Load neutral position and start MAVLink Roll,Pitch,Yaw control with stabilization"},
+	MAV_MOUNT_MODE_RC_TARGETING:      {"MAV_MOUNT_MODE_RC_TARGETING", "Load neutral position and start RC Roll,Pitch,Yaw control with stabilization"},
+	MAV_MOUNT_MODE_GPS_POINT:         {"MAV_MOUNT_MODE_GPS_POINT", "Load neutral position and start to point to Lat,Lon,Alt"},
+}
+
+func (e MavMountMode) String() string {
+	if entry, ok := mavMountModeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavMountMode(%d)", uint32(e))
+}
+
+func (e MavMountMode) Description() string {
+	return mavMountModeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MOUNT_MODE entry
+func (e MavMountMode) Valid() bool {
+	_, ok := mavMountModeEntries[e]
+	return ok
+}
+
+// ParseMavMountMode returns the MAV_MOUNT_MODE entry called name
+func ParseMavMountMode(name string) (MavMountMode, error) {
+	for e, entry := range mavMountModeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MOUNT_MODE %q", name)
+}
+
 // MavCmd: Commands to be executed by the MAV. They can be executed on user request, or as part of a mission script. If the action is used in a mission, the parameter mapping to the waypoint/mission message is as follows: Param 1, Param 2, Param 3, Param 4, X: Param 5, Y:Param 6, Z:Param 7. This command list is similar what ARINC 424 is for commercial aircraft: A data format how to interpret waypoint/mission data.
+type MavCmd uint32
+
 const (
-	MAV_CMD_NAV_WAYPOINT                   = 16  // Navigate to MISSION.
-	MAV_CMD_NAV_LOITER_UNLIM               = 17  // Loiter around this MISSION an unlimited amount of time
-	MAV_CMD_NAV_LOITER_TURNS               = 18  // Loiter around this MISSION for X turns
-	MAV_CMD_NAV_LOITER_TIME                = 19  // Loiter around this MISSION for X seconds
-	MAV_CMD_NAV_RETURN_TO_LAUNCH           = 20  // Return to launch location
-	MAV_CMD_NAV_LAND                       = 21  // Land at location
-	MAV_CMD_NAV_TAKEOFF                    = 22  // Takeoff from ground / hand
-	MAV_CMD_NAV_LAND_LOCAL                 = 23  // Land at local position (local frame only)
-	MAV_CMD_NAV_TAKEOFF_LOCAL              = 24  // Takeoff from local position (local frame only)
-	MAV_CMD_NAV_FOLLOW                     = 25  // Vehicle following, i.e. this waypoint represents the position of a moving vehicle
-	MAV_CMD_NAV_CONTINUE_AND_CHANGE_ALT    = 30  // Continue on the current course and climb/descend to specified altitude.  When the altitude is reached continue to the next command (i.e., don't proceed to the next command until the desired altitude is reached.
-	MAV_CMD_NAV_LOITER_TO_ALT              = 31  // Begin loiter at the specified Latitude and Longitude.  If Lat=Lon=0, then loiter at the current position.  Don't consider the navigation command complete (don't leave loiter) until the altitude has been reached.  Additionally, if the Heading Required parameter is non-zero the  aircraft will not leave the loiter until heading toward the next waypoint.
-	MAV_CMD_DO_FOLLOW                      = 32  // Being following a target
-	MAV_CMD_DO_FOLLOW_REPOSITION           = 33  // Reposition the MAV after a follow target command has been sent
-	MAV_CMD_NAV_ROI                        = 80  // Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras.
-	MAV_CMD_NAV_PATHPLANNING               = 81  // Control autonomous path planning on the MAV.
-	MAV_CMD_NAV_SPLINE_WAYPOINT            = 82  // Navigate to MISSION using a spline path.
-	MAV_CMD_NAV_VTOL_TAKEOFF               = 84  // Takeoff from ground using VTOL mode
-	MAV_CMD_NAV_VTOL_LAND                  = 85  // Land using VTOL mode
-	MAV_CMD_NAV_GUIDED_ENABLE              = 92  // hand control over to an external controller
-	MAV_CMD_NAV_DELAY                      = 93  // Delay the next navigation command a number of seconds or until a specified time
-	MAV_CMD_NAV_LAST                       = 95  // NOP - This command is only used to mark the upper limit of the NAV/ACTION commands in the enumeration
-	MAV_CMD_CONDITION_DELAY                = 112 // Delay mission state machine.
-	MAV_CMD_CONDITION_CHANGE_ALT           = 113 // Ascend/descend at rate.  Delay mission state machine until desired altitude reached.
-	MAV_CMD_CONDITION_DISTANCE             = 114 // Delay mission state machine until within desired distance of next NAV point.
-	MAV_CMD_CONDITION_YAW                  = 115 // Reach a certain target angle.
-	MAV_CMD_CONDITION_LAST                 = 159 // NOP - This command is only used to mark the upper limit of the CONDITION commands in the enumeration
-	MAV_CMD_DO_SET_MODE                    = 176 // Set system mode.
-	MAV_CMD_DO_JUMP                        = 177 // Jump to the desired command in the mission list.  Repeat this action only the specified number of times
-	MAV_CMD_DO_CHANGE_SPEED                = 178 // Change speed and/or throttle set points.
-	MAV_CMD_DO_SET_HOME                    = 179 // Changes the home location either to the current location or a specified location.
-	MAV_CMD_DO_SET_PARAMETER               = 180 // Set a system parameter.  Caution!  Use of this command requires knowledge of the numeric enumeration value of the parameter.
-	MAV_CMD_DO_SET_RELAY                   = 181 // Set a relay to a condition.
-	MAV_CMD_DO_REPEAT_RELAY                = 182 // Cycle a relay on and off for a desired number of cyles with a desired period.
-	MAV_CMD_DO_SET_SERVO                   = 183 // Set a servo to a desired PWM value.
-	MAV_CMD_DO_REPEAT_SERVO                = 184 // Cycle a between its nominal setting and a desired PWM for a desired number of cycles with a desired period.
-	MAV_CMD_DO_FLIGHTTERMINATION           = 185 // Terminate flight immediately
-	MAV_CMD_DO_CHANGE_ALTITUDE             = 186 // Change altitude set point.
-	MAV_CMD_DO_LAND_START                  = 189 // Mission command to perform a landing. This is used as a marker in a mission to tell the autopilot where a sequence of mission items that represents a landing starts. It may also be sent via a COMMAND_LONG to trigger a landing, in which case the nearest (geographically) landing sequence in the mission will be used. The Latitude/Longitude is optional, and may be set to 0/0 if not needed. If specified then it will be used to help find the closest landing sequence.
-	MAV_CMD_DO_RALLY_LAND                  = 190 // Mission command to perform a landing from a rally point.
-	MAV_CMD_DO_GO_AROUND                   = 191 // Mission command to safely abort an autonmous landing.
-	MAV_CMD_DO_REPOSITION                  = 192 // Reposition the vehicle to a specific WGS84 global position.
-	MAV_CMD_DO_PAUSE_CONTINUE              = 193 // If in a GPS controlled position mode, hold the current position or continue.
-	MAV_CMD_DO_SET_REVERSE                 = 194 // Set moving direction to forward or reverse.
-	MAV_CMD_DO_CONTROL_VIDEO               = 200 // Control onboard camera system.
-	MAV_CMD_DO_SET_ROI                     = 201 // Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras.
-	MAV_CMD_DO_DIGICAM_CONFIGURE           = 202 // Mission command to configure an on-board camera controller system.
-	MAV_CMD_DO_DIGICAM_CONTROL             = 203 // Mission command to control an on-board camera controller system.
-	MAV_CMD_DO_MOUNT_CONFIGURE             = 204 // Mission command to configure a camera or antenna mount
-	MAV_CMD_DO_MOUNT_CONTROL               = 205 // Mission command to control a camera or antenna mount
-	MAV_CMD_DO_SET_CAM_TRIGG_DIST          = 206 // Mission command to set CAM_TRIGG_DIST for this flight
-	MAV_CMD_DO_FENCE_ENABLE                = 207 // Mission command to enable the geofence
-	MAV_CMD_DO_PARACHUTE                   = 208 // Mission command to trigger a parachute
-	MAV_CMD_DO_MOTOR_TEST                  = 209 // Mission command to perform motor test
-	MAV_CMD_DO_INVERTED_FLIGHT             = 210 // Change to/from inverted flight
-	MAV_CMD_DO_MOUNT_CONTROL_QUAT          = 220 // Mission command to control a camera or antenna mount, using a quaternion as reference.
-	MAV_CMD_DO_GUIDED_MASTER               = 221 // set id of master controller
-	MAV_CMD_DO_GUIDED_LIMITS               = 222 // set limits for external control
-	MAV_CMD_DO_ENGINE_CONTROL              = 223 // Control vehicle engine. This is interpreted by the vehicles engine controller to change the target engine state. It is intended for vehicles with internal combustion engines
-	MAV_CMD_DO_LAST                        = 240 // NOP - This command is only used to mark the upper limit of the DO commands in the enumeration
-	MAV_CMD_PREFLIGHT_CALIBRATION          = 241 // Trigger calibration. This command will be only accepted if in pre-flight mode.
-	MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS   = 242 // Set sensor offsets. This command will be only accepted if in pre-flight mode.
-	MAV_CMD_PREFLIGHT_UAVCAN               = 243 // Trigger UAVCAN config. This command will be only accepted if in pre-flight mode.
-	MAV_CMD_PREFLIGHT_STORAGE              = 245 // Request storage of different parameter values and logs. This command will be only accepted if in pre-flight mode.
-	MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN      = 246 // Request the reboot or shutdown of system components.
-	MAV_CMD_OVERRIDE_GOTO                  = 252 // Hold / continue the current action
-	MAV_CMD_MISSION_START                  = 66  // start running a mission
-	MAV_CMD_COMPONENT_ARM_DISARM           = 67  // Arms / Disarms a component
-	MAV_CMD_GET_HOME_POSITION              = 68  // Request the home position from the vehicle.
-	MAV_CMD_START_RX_PAIR                  = 69  // Starts receiver pairing
-	MAV_CMD_GET_MESSAGE_INTERVAL           = 70  // Request the interval between messages for a particular MAVLink message ID
-	MAV_CMD_SET_MESSAGE_INTERVAL           = 71  // Request the interval between messages for a particular MAVLink message ID. This interface replaces REQUEST_DATA_STREAM
-	MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES = 520  // Request autopilot capabilities
-	MAV_CMD_IMAGE_START_CAPTURE            = 73  // Start image capture sequence
-	MAV_CMD_IMAGE_STOP_CAPTURE             = 74  // Stop image capture sequence
-	MAV_CMD_DO_TRIGGER_CONTROL             = 75  // Enable or disable on-board camera triggering system.
-	MAV_CMD_VIDEO_START_CAPTURE            = 76  // Starts video capture
-	MAV_CMD_VIDEO_STOP_CAPTURE             = 77  // Stop the current video capture
-	MAV_CMD_PANORAMA_CREATE                = 78  // Create a panorama at the current position
-	MAV_CMD_DO_VTOL_TRANSITION             = 79  // Request VTOL transition
-	MAV_CMD_SET_GUIDED_SUBMODE_STANDARD    = 80  // This command sets the submode to standard guided when vehicle is in guided mode. The vehicle holds position and altitude and the user can input the desired velocites along all three axes.
-	MAV_CMD_SET_GUIDED_SUBMODE_CIRCLE      = 81  // This command sets submode circle when vehicle is in guided mode. Vehicle flies along a circle facing the center of the circle. The user can input the velocity along the circle and change the radius. If no input is given the vehicle will hold position.
-	MAV_CMD_PAYLOAD_PREPARE_DEPLOY         = 82  // Deploy payload on a Lat / Lon / Alt position. This includes the navigation to reach the required release position and velocity.
-	MAV_CMD_PAYLOAD_CONTROL_DEPLOY         = 83  // Control the payload deployment.
-	MAV_CMD_WAYPOINT_USER_1                = 84  // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
-	MAV_CMD_WAYPOINT_USER_2                = 85  // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
-	MAV_CMD_WAYPOINT_USER_3                = 86  // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
-	MAV_CMD_WAYPOINT_USER_4                = 87  // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
-	MAV_CMD_WAYPOINT_USER_5                = 88  // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
-	MAV_CMD_SPATIAL_USER_1                 = 89  // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
-	MAV_CMD_SPATIAL_USER_2                 = 90  // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
-	MAV_CMD_SPATIAL_USER_3                 = 91  // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
-	MAV_CMD_SPATIAL_USER_4                 = 92  // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
-	MAV_CMD_SPATIAL_USER_5                 = 93  // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
-	MAV_CMD_USER_1                         = 94  // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
-	MAV_CMD_USER_2                         = 95  // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
-	MAV_CMD_USER_3                         = 96  // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
-	MAV_CMD_USER_4                         = 97  // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
-	MAV_CMD_USER_5                         = 98  // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
+	MAV_CMD_NAV_WAYPOINT                       = 16    // Navigate to MISSION.
+	MAV_CMD_NAV_LOITER_UNLIM                   = 17    // Loiter around this MISSION an unlimited amount of time
+	MAV_CMD_NAV_LOITER_TURNS                   = 18    // Loiter around this MISSION for X turns
+	MAV_CMD_NAV_LOITER_TIME                    = 19    // Loiter around this MISSION for X seconds
+	MAV_CMD_NAV_RETURN_TO_LAUNCH               = 20    // Return to launch location
+	MAV_CMD_NAV_LAND                           = 21    // Land at location
+	MAV_CMD_NAV_TAKEOFF                        = 22    // Takeoff from ground / hand
+	MAV_CMD_NAV_LAND_LOCAL                     = 23    // Land at local position (local frame only)
+	MAV_CMD_NAV_TAKEOFF_LOCAL                  = 24    // Takeoff from local position (local frame only)
+	MAV_CMD_NAV_FOLLOW                         = 25    // Vehicle following, i.e. this waypoint represents the position of a moving vehicle
+	MAV_CMD_NAV_CONTINUE_AND_CHANGE_ALT        = 30    // Continue on the current course and climb/descend to specified altitude.  When the altitude is reached continue to the next command (i.e., don't proceed to the next command until the desired altitude is reached.
+	MAV_CMD_NAV_LOITER_TO_ALT                  = 31    // Begin loiter at the specified Latitude and Longitude.  If Lat=Lon=0, then loiter at the current position.  Don't consider the navigation command complete (don't leave loiter) until the altitude has been reached.  Additionally, if the Heading Required parameter is non-zero the  aircraft will not leave the loiter until heading toward the next waypoint.
+	MAV_CMD_DO_FOLLOW                          = 32    // Being following a target
+	MAV_CMD_DO_FOLLOW_REPOSITION               = 33    // Reposition the MAV after a follow target command has been sent
+	MAV_CMD_NAV_ROI                            = 80    // Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras.
+	MAV_CMD_NAV_PATHPLANNING                   = 81    // Control autonomous path planning on the MAV.
+	MAV_CMD_NAV_SPLINE_WAYPOINT                = 82    // Navigate to MISSION using a spline path.
+	MAV_CMD_NAV_VTOL_TAKEOFF                   = 84    // Takeoff from ground using VTOL mode
+	MAV_CMD_NAV_VTOL_LAND                      = 85    // Land using VTOL mode
+	MAV_CMD_NAV_GUIDED_ENABLE                  = 92    // hand control over to an external controller
+	MAV_CMD_NAV_DELAY                          = 93    // Delay the next navigation command a number of seconds or until a specified time
+	MAV_CMD_NAV_LAST                           = 95    // NOP - This command is only used to mark the upper limit of the NAV/ACTION commands in the enumeration
+	MAV_CMD_CONDITION_DELAY                    = 112   // Delay mission state machine.
+	MAV_CMD_CONDITION_CHANGE_ALT               = 113   // Ascend/descend at rate.  Delay mission state machine until desired altitude reached.
+	MAV_CMD_CONDITION_DISTANCE                 = 114   // Delay mission state machine until within desired distance of next NAV point.
+	MAV_CMD_CONDITION_YAW                      = 115   // Reach a certain target angle.
+	MAV_CMD_CONDITION_LAST                     = 159   // NOP - This command is only used to mark the upper limit of the CONDITION commands in the enumeration
+	MAV_CMD_DO_SET_MODE                        = 176   // Set system mode.
+	MAV_CMD_DO_JUMP                            = 177   // Jump to the desired command in the mission list.  Repeat this action only the specified number of times
+	MAV_CMD_DO_CHANGE_SPEED                    = 178   // Change speed and/or throttle set points.
+	MAV_CMD_DO_SET_HOME                        = 179   // Changes the home location either to the current location or a specified location.
+	MAV_CMD_DO_SET_PARAMETER                   = 180   // Set a system parameter.  Caution!  Use of this command requires knowledge of the numeric enumeration value of the parameter.
+	MAV_CMD_DO_SET_RELAY                       = 181   // Set a relay to a condition.
+	MAV_CMD_DO_REPEAT_RELAY                    = 182   // Cycle a relay on and off for a desired number of cyles with a desired period.
+	MAV_CMD_DO_SET_SERVO                       = 183   // Set a servo to a desired PWM value.
+	MAV_CMD_DO_REPEAT_SERVO                    = 184   // Cycle a between its nominal setting and a desired PWM for a desired number of cycles with a desired period.
+	MAV_CMD_DO_FLIGHTTERMINATION               = 185   // Terminate flight immediately
+	MAV_CMD_DO_CHANGE_ALTITUDE                 = 186   // Change altitude set point.
+	MAV_CMD_DO_LAND_START                      = 189   // Mission command to perform a landing. This is used as a marker in a mission to tell the autopilot where a sequence of mission items that represents a landing starts. It may also be sent via a COMMAND_LONG to trigger a landing, in which case the nearest (geographically) landing sequence in the mission will be used. The Latitude/Longitude is optional, and may be set to 0/0 if not needed. If specified then it will be used to help find the closest landing sequence.
+	MAV_CMD_DO_RALLY_LAND                      = 190   // Mission command to perform a landing from a rally point.
+	MAV_CMD_DO_GO_AROUND                       = 191   // Mission command to safely abort an autonmous landing.
+	MAV_CMD_DO_REPOSITION                      = 192   // Reposition the vehicle to a specific WGS84 global position.
+	MAV_CMD_DO_PAUSE_CONTINUE                  = 193   // If in a GPS controlled position mode, hold the current position or continue.
+	MAV_CMD_DO_SET_REVERSE                     = 194   // Set moving direction to forward or reverse.
+	MAV_CMD_DO_CONTROL_VIDEO                   = 200   // Control onboard camera system.
+	MAV_CMD_DO_SET_ROI                         = 201   // Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras.
+	MAV_CMD_DO_DIGICAM_CONFIGURE               = 202   // Mission command to configure an on-board camera controller system.
+	MAV_CMD_DO_DIGICAM_CONTROL                 = 203   // Mission command to control an on-board camera controller system.
+	MAV_CMD_DO_MOUNT_CONFIGURE                 = 204   // Mission command to configure a camera or antenna mount
+	MAV_CMD_DO_MOUNT_CONTROL                   = 205   // Mission command to control a camera or antenna mount
+	MAV_CMD_DO_SET_CAM_TRIGG_DIST              = 206   // Mission command to set CAM_TRIGG_DIST for this flight
+	MAV_CMD_DO_FENCE_ENABLE                    = 207   // Mission command to enable the geofence
+	MAV_CMD_DO_PARACHUTE                       = 208   // Mission command to trigger a parachute
+	MAV_CMD_DO_MOTOR_TEST                      = 209   // Mission command to perform motor test
+	MAV_CMD_DO_INVERTED_FLIGHT                 = 210   // Change to/from inverted flight
+	MAV_CMD_DO_MOUNT_CONTROL_QUAT              = 220   // Mission command to control a camera or antenna mount, using a quaternion as reference.
+	MAV_CMD_DO_GUIDED_MASTER                   = 221   // set id of master controller
+	MAV_CMD_DO_GUIDED_LIMITS                   = 222   // set limits for external control
+	MAV_CMD_DO_ENGINE_CONTROL                  = 223   // Control vehicle engine. This is interpreted by the vehicles engine controller to change the target engine state. It is intended for vehicles with internal combustion engines
+	MAV_CMD_DO_LAST                            = 240   // NOP - This command is only used to mark the upper limit of the DO commands in the enumeration
+	MAV_CMD_PREFLIGHT_CALIBRATION              = 241   // Trigger calibration. This command will be only accepted if in pre-flight mode.
+	MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS       = 242   // Set sensor offsets. This command will be only accepted if in pre-flight mode.
+	MAV_CMD_PREFLIGHT_UAVCAN                   = 243   // Trigger UAVCAN config. This command will be only accepted if in pre-flight mode.
+	MAV_CMD_PREFLIGHT_STORAGE                  = 245   // Request storage of different parameter values and logs. This command will be only accepted if in pre-flight mode.
+	MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN          = 246   // Request the reboot or shutdown of system components.
+	MAV_CMD_OVERRIDE_GOTO                      = 252   // Hold / continue the current action
+	MAV_CMD_MISSION_START                      = 300   // start running a mission
+	MAV_CMD_COMPONENT_ARM_DISARM               = 400   // Arms / Disarms a component
+	MAV_CMD_GET_HOME_POSITION                  = 410   // Request the home position from the vehicle.
+	MAV_CMD_START_RX_PAIR                      = 500   // Starts receiver pairing
+	MAV_CMD_GET_MESSAGE_INTERVAL               = 510   // Request the interval between messages for a particular MAVLink message ID
+	MAV_CMD_SET_MESSAGE_INTERVAL               = 511   // Request the interval between messages for a particular MAVLink message ID. This interface replaces REQUEST_DATA_STREAM
+	MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES     = 520   // Request autopilot capabilities
+	MAV_CMD_IMAGE_START_CAPTURE                = 2000  // Start image capture sequence
+	MAV_CMD_IMAGE_STOP_CAPTURE                 = 2001  // Stop image capture sequence
+	MAV_CMD_DO_TRIGGER_CONTROL                 = 2003  // Enable or disable on-board camera triggering system.
+	MAV_CMD_VIDEO_START_CAPTURE                = 2500  // Starts video capture
+	MAV_CMD_VIDEO_STOP_CAPTURE                 = 2501  // Stop the current video capture
+	MAV_CMD_PANORAMA_CREATE                    = 2800  // Create a panorama at the current position
+	MAV_CMD_DO_VTOL_TRANSITION                 = 3000  // Request VTOL transition
+	MAV_CMD_SET_GUIDED_SUBMODE_STANDARD        = 4000  // This command sets the submode to standard guided when vehicle is in guided mode. The vehicle holds position and altitude and the user can input the desired velocites along all three axes.
+	MAV_CMD_SET_GUIDED_SUBMODE_CIRCLE          = 4001  // This command sets submode circle when vehicle is in guided mode. Vehicle flies along a circle facing the center of the circle. The user can input the velocity along the circle and change the radius. If no input is given the vehicle will hold position.
+	MAV_CMD_NAV_FENCE_RETURN_POINT             = 5000  // Fence return point. There can only be one fence return point.
+	MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION = 5001  // Fence vertex for an inclusion polygon (the polygon must not be self-intersecting). The vehicle must stay within this area. Minimum of 3 vertices required.
+	MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION = 5002  // Fence vertex for an exclusion polygon (the polygon must not be self-intersecting). The vehicle must stay outside this area. Minimum of 3 vertices required.
+	MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION         = 5003  // Circular fence area. The vehicle must stay inside this area.
+	MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION         = 5004  // Circular fence area. The vehicle must stay outside this area.
+	MAV_CMD_NAV_RALLY_POINT                    = 5100  // Rally point. You can have multiple rally points defined.
+	MAV_CMD_PAYLOAD_PREPARE_DEPLOY             = 30001 // Deploy payload on a Lat / Lon / Alt position. This includes the navigation to reach the required release position and velocity.
+	MAV_CMD_PAYLOAD_CONTROL_DEPLOY             = 30002 // Control the payload deployment.
+	MAV_CMD_WAYPOINT_USER_1                    = 31000 // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
+	MAV_CMD_WAYPOINT_USER_2                    = 31001 // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
+	MAV_CMD_WAYPOINT_USER_3                    = 31002 // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
+	MAV_CMD_WAYPOINT_USER_4                    = 31003 // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
+	MAV_CMD_WAYPOINT_USER_5                    = 31004 // User defined waypoint item. Ground Station will show the Vehicle as flying through this item.
+	MAV_CMD_SPATIAL_USER_1                     = 31005 // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
+	MAV_CMD_SPATIAL_USER_2                     = 31006 // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
+	MAV_CMD_SPATIAL_USER_3                     = 31007 // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
+	MAV_CMD_SPATIAL_USER_4                     = 31008 // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
+	MAV_CMD_SPATIAL_USER_5                     = 31009 // User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item.
+	MAV_CMD_USER_1                             = 31010 // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
+	MAV_CMD_USER_2                             = 31011 // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
+	MAV_CMD_USER_3                             = 31012 // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
+	MAV_CMD_USER_4                             = 31013 // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
+	MAV_CMD_USER_5                             = 31014 // User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item.
 )
 
+var mavCmdEntries = map[MavCmd]enumEntry{
+	MAV_CMD_NAV_WAYPOINT:                       {"MAV_CMD_NAV_WAYPOINT", "Navigate to MISSION."},
+	MAV_CMD_NAV_LOITER_UNLIM:                   {"MAV_CMD_NAV_LOITER_UNLIM", "Loiter around this MISSION an unlimited amount of time"},
+	MAV_CMD_NAV_LOITER_TURNS:                   {"MAV_CMD_NAV_LOITER_TURNS", "Loiter around this MISSION for X turns"},
+	MAV_CMD_NAV_LOITER_TIME:                    {"MAV_CMD_NAV_LOITER_TIME", "Loiter around this MISSION for X seconds"},
+	MAV_CMD_NAV_RETURN_TO_LAUNCH:               {"MAV_CMD_NAV_RETURN_TO_LAUNCH", "Return to launch location"},
+	MAV_CMD_NAV_LAND:                           {"MAV_CMD_NAV_LAND", "Land at location"},
+	MAV_CMD_NAV_TAKEOFF:                        {"MAV_CMD_NAV_TAKEOFF", "Takeoff from ground / hand"},
+	MAV_CMD_NAV_LAND_LOCAL:                     {"MAV_CMD_NAV_LAND_LOCAL", "Land at local position (local frame only)"},
+	MAV_CMD_NAV_TAKEOFF_LOCAL:                  {"MAV_CMD_NAV_TAKEOFF_LOCAL", "Takeoff from local position (local frame only)"},
+	MAV_CMD_NAV_FOLLOW:                         {"MAV_CMD_NAV_FOLLOW", "Vehicle following, i.e. this waypoint represents the position of a moving vehicle"},
+	MAV_CMD_NAV_CONTINUE_AND_CHANGE_ALT:        {"MAV_CMD_NAV_CONTINUE_AND_CHANGE_ALT", "Continue on the current course and climb/descend to specified altitude.  When the altitude is reached continue to the next command (i.e., don't proceed to the next command until the desired altitude is reached."},
+	MAV_CMD_NAV_LOITER_TO_ALT:                  {"MAV_CMD_NAV_LOITER_TO_ALT", "Begin loiter at the specified Latitude and Longitude.  If Lat=Lon=0, then loiter at the current position.  Don't consider the navigation command complete (don't leave loiter) until the altitude has been reached.  Additionally, if the Heading Required parameter is non-zero the  aircraft will not leave the loiter until heading toward the next waypoint."},
+	MAV_CMD_DO_FOLLOW:                          {"MAV_CMD_DO_FOLLOW", "Being following a target"},
+	MAV_CMD_DO_FOLLOW_REPOSITION:               {"MAV_CMD_DO_FOLLOW_REPOSITION", "Reposition the MAV after a follow target command has been sent"},
+	MAV_CMD_NAV_ROI:                            {"MAV_CMD_NAV_ROI", "Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras."},
+	MAV_CMD_NAV_PATHPLANNING:                   {"MAV_CMD_NAV_PATHPLANNING", "Control autonomous path planning on the MAV."},
+	MAV_CMD_NAV_SPLINE_WAYPOINT:                {"MAV_CMD_NAV_SPLINE_WAYPOINT", "Navigate to MISSION using a spline path."},
+	MAV_CMD_NAV_VTOL_TAKEOFF:                   {"MAV_CMD_NAV_VTOL_TAKEOFF", "Takeoff from ground using VTOL mode"},
+	MAV_CMD_NAV_VTOL_LAND:                      {"MAV_CMD_NAV_VTOL_LAND", "Land using VTOL mode"},
+	MAV_CMD_NAV_GUIDED_ENABLE:                  {"MAV_CMD_NAV_GUIDED_ENABLE", "hand control over to an external controller"},
+	MAV_CMD_NAV_DELAY:                          {"MAV_CMD_NAV_DELAY", "Delay the next navigation command a number of seconds or until a specified time"},
+	MAV_CMD_NAV_LAST:                           {"MAV_CMD_NAV_LAST", "NOP - This command is only used to mark the upper limit of the NAV/ACTION commands in the enumeration"},
+	MAV_CMD_CONDITION_DELAY:                    {"MAV_CMD_CONDITION_DELAY", "Delay mission state machine."},
+	MAV_CMD_CONDITION_CHANGE_ALT:               {"MAV_CMD_CONDITION_CHANGE_ALT", "Ascend/descend at rate.  Delay mission state machine until desired altitude reached."},
+	MAV_CMD_CONDITION_DISTANCE:                 {"MAV_CMD_CONDITION_DISTANCE", "Delay mission state machine until within desired distance of next NAV point."},
+	MAV_CMD_CONDITION_YAW:                      {"MAV_CMD_CONDITION_YAW", "Reach a certain target angle."},
+	MAV_CMD_CONDITION_LAST:                     {"MAV_CMD_CONDITION_LAST", "NOP - This command is only used to mark the upper limit of the CONDITION commands in the enumeration"},
+	MAV_CMD_DO_SET_MODE:                        {"MAV_CMD_DO_SET_MODE", "Set system mode."},
+	MAV_CMD_DO_JUMP:                            {"MAV_CMD_DO_JUMP", "Jump to the desired command in the mission list.  Repeat this action only the specified number of times"},
+	MAV_CMD_DO_CHANGE_SPEED:                    {"MAV_CMD_DO_CHANGE_SPEED", "Change speed and/or throttle set points."},
+	MAV_CMD_DO_SET_HOME:                        {"MAV_CMD_DO_SET_HOME", "Changes the home location either to the current location or a specified location."},
+	MAV_CMD_DO_SET_PARAMETER:                   {"MAV_CMD_DO_SET_PARAMETER", "Set a system parameter.  Caution!  Use of this command requires knowledge of the numeric enumeration value of the parameter."},
+	MAV_CMD_DO_SET_RELAY:                       {"MAV_CMD_DO_SET_RELAY", "Set a relay to a condition."},
+	MAV_CMD_DO_REPEAT_RELAY:                    {"MAV_CMD_DO_REPEAT_RELAY", "Cycle a relay on and off for a desired number of cyles with a desired period."},
+	MAV_CMD_DO_SET_SERVO:                       {"MAV_CMD_DO_SET_SERVO", "Set a servo to a desired PWM value."},
+	MAV_CMD_DO_REPEAT_SERVO:                    {"MAV_CMD_DO_REPEAT_SERVO", "Cycle a between its nominal setting and a desired PWM for a desired number of cycles with a desired period."},
+	MAV_CMD_DO_FLIGHTTERMINATION:               {"MAV_CMD_DO_FLIGHTTERMINATION", "Terminate flight immediately"},
+	MAV_CMD_DO_CHANGE_ALTITUDE:                 {"MAV_CMD_DO_CHANGE_ALTITUDE", "Change altitude set point."},
+	MAV_CMD_DO_LAND_START:                      {"MAV_CMD_DO_LAND_START", "Mission command to perform a landing. This is used as a marker in a mission to tell the autopilot where a sequence of mission items that represents a landing starts. It may also be sent via a COMMAND_LONG to trigger a landing, in which case the nearest (geographically) landing sequence in the mission will be used. The Latitude/Longitude is optional, and may be set to 0/0 if not needed. If specified then it will be used to help find the closest landing sequence."},
+	MAV_CMD_DO_RALLY_LAND:                      {"MAV_CMD_DO_RALLY_LAND", "Mission command to perform a landing from a rally point."},
+	MAV_CMD_DO_GO_AROUND:                       {"MAV_CMD_DO_GO_AROUND", "Mission command to safely abort an autonmous landing."},
+	MAV_CMD_DO_REPOSITION:                      {"MAV_CMD_DO_REPOSITION", "Reposition the vehicle to a specific WGS84 global position."},
+	MAV_CMD_DO_PAUSE_CONTINUE:                  {"MAV_CMD_DO_PAUSE_CONTINUE", "If in a GPS controlled position mode, hold the current position or continue."},
+	MAV_CMD_DO_SET_REVERSE:                     {"MAV_CMD_DO_SET_REVERSE", "Set moving direction to forward or reverse."},
+	MAV_CMD_DO_CONTROL_VIDEO:                   {"MAV_CMD_DO_CONTROL_VIDEO", "Control onboard camera system."},
+	MAV_CMD_DO_SET_ROI:                         {"MAV_CMD_DO_SET_ROI", "Sets the region of interest (ROI) for a sensor set or the vehicle itself. This can then be used by the vehicles control system to control the vehicle attitude and the attitude of various sensors such as cameras."},
+	MAV_CMD_DO_DIGICAM_CONFIGURE:               {"MAV_CMD_DO_DIGICAM_CONFIGURE", "Mission command to configure an on-board camera controller system."},
+	MAV_CMD_DO_DIGICAM_CONTROL:                 {"MAV_CMD_DO_DIGICAM_CONTROL", "Mission command to control an on-board camera controller system."},
+	MAV_CMD_DO_MOUNT_CONFIGURE:                 {"MAV_CMD_DO_MOUNT_CONFIGURE", "Mission command to configure a camera or antenna mount"},
+	MAV_CMD_DO_MOUNT_CONTROL:                   {"MAV_CMD_DO_MOUNT_CONTROL", "Mission command to control a camera or antenna mount"},
+	MAV_CMD_DO_SET_CAM_TRIGG_DIST:              {"MAV_CMD_DO_SET_CAM_TRIGG_DIST", "Mission command to set CAM_TRIGG_DIST for this flight"},
+	MAV_CMD_DO_FENCE_ENABLE:                    {"MAV_CMD_DO_FENCE_ENABLE", "Mission command to enable the geofence"},
+	MAV_CMD_DO_PARACHUTE:                       {"MAV_CMD_DO_PARACHUTE", "Mission command to trigger a parachute"},
+	MAV_CMD_DO_MOTOR_TEST:                      {"MAV_CMD_DO_MOTOR_TEST", "Mission command to perform motor test"},
+	MAV_CMD_DO_INVERTED_FLIGHT:                 {"MAV_CMD_DO_INVERTED_FLIGHT", "Change to/from inverted flight"},
+	MAV_CMD_DO_MOUNT_CONTROL_QUAT:              {"MAV_CMD_DO_MOUNT_CONTROL_QUAT", "Mission command to control a camera or antenna mount, using a quaternion as reference."},
+	MAV_CMD_DO_GUIDED_MASTER:                   {"MAV_CMD_DO_GUIDED_MASTER", "set id of master controller"},
+	MAV_CMD_DO_GUIDED_LIMITS:                   {"MAV_CMD_DO_GUIDED_LIMITS", "set limits for external control"},
+	MAV_CMD_DO_ENGINE_CONTROL:                  {"MAV_CMD_DO_ENGINE_CONTROL", "Control vehicle engine. This is interpreted by the vehicles engine controller to change the target engine state. It is intended for vehicles with internal combustion engines"},
+	MAV_CMD_DO_LAST:                            {"MAV_CMD_DO_LAST", "NOP - This command is only used to mark the upper limit of the DO commands in the enumeration"},
+	MAV_CMD_PREFLIGHT_CALIBRATION:              {"MAV_CMD_PREFLIGHT_CALIBRATION", "Trigger calibration. This command will be only accepted if in pre-flight mode."},
+	MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS:       {"MAV_CMD_PREFLIGHT_SET_SENSOR_OFFSETS", "Set sensor offsets. This command will be only accepted if in pre-flight mode."},
+	MAV_CMD_PREFLIGHT_UAVCAN:                   {"MAV_CMD_PREFLIGHT_UAVCAN", "Trigger UAVCAN config. This command will be only accepted if in pre-flight mode."},
+	MAV_CMD_PREFLIGHT_STORAGE:                  {"MAV_CMD_PREFLIGHT_STORAGE", "Request storage of different parameter values and logs. This command will be only accepted if in pre-flight mode."},
+	MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN:          {"MAV_CMD_PREFLIGHT_REBOOT_SHUTDOWN", "Request the reboot or shutdown of system components."},
+	MAV_CMD_OVERRIDE_GOTO:                      {"MAV_CMD_OVERRIDE_GOTO", "Hold / continue the current action"},
+	MAV_CMD_MISSION_START:                      {"MAV_CMD_MISSION_START", "start running a mission"},
+	MAV_CMD_COMPONENT_ARM_DISARM:               {"MAV_CMD_COMPONENT_ARM_DISARM", "Arms / Disarms a component"},
+	MAV_CMD_GET_HOME_POSITION:                  {"MAV_CMD_GET_HOME_POSITION", "Request the home position from the vehicle."},
+	MAV_CMD_START_RX_PAIR:                      {"MAV_CMD_START_RX_PAIR", "Starts receiver pairing"},
+	MAV_CMD_GET_MESSAGE_INTERVAL:               {"MAV_CMD_GET_MESSAGE_INTERVAL", "Request the interval between messages for a particular MAVLink message ID"},
+	MAV_CMD_SET_MESSAGE_INTERVAL:               {"MAV_CMD_SET_MESSAGE_INTERVAL", "Request the interval between messages for a particular MAVLink message ID. This interface replaces REQUEST_DATA_STREAM"},
+	MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES:     {"MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES", "Request autopilot capabilities"},
+	MAV_CMD_IMAGE_START_CAPTURE:                {"MAV_CMD_IMAGE_START_CAPTURE", "Start image capture sequence"},
+	MAV_CMD_IMAGE_STOP_CAPTURE:                 {"MAV_CMD_IMAGE_STOP_CAPTURE", "Stop image capture sequence"},
+	MAV_CMD_DO_TRIGGER_CONTROL:                 {"MAV_CMD_DO_TRIGGER_CONTROL", "Enable or disable on-board camera triggering system."},
+	MAV_CMD_VIDEO_START_CAPTURE:                {"MAV_CMD_VIDEO_START_CAPTURE", "Starts video capture"},
+	MAV_CMD_VIDEO_STOP_CAPTURE:                 {"MAV_CMD_VIDEO_STOP_CAPTURE", "Stop the current video capture"},
+	MAV_CMD_PANORAMA_CREATE:                    {"MAV_CMD_PANORAMA_CREATE", "Create a panorama at the current position"},
+	MAV_CMD_DO_VTOL_TRANSITION:                 {"MAV_CMD_DO_VTOL_TRANSITION", "Request VTOL transition"},
+	MAV_CMD_SET_GUIDED_SUBMODE_STANDARD:        {"MAV_CMD_SET_GUIDED_SUBMODE_STANDARD", "This command sets the submode to standard guided when vehicle is in guided mode. The vehicle holds position and altitude and the user can input the desired velocites along all three axes."},
+	MAV_CMD_SET_GUIDED_SUBMODE_CIRCLE:          {"MAV_CMD_SET_GUIDED_SUBMODE_CIRCLE", "This command sets submode circle when vehicle is in guided mode. Vehicle flies along a circle facing the center of the circle. The user can input the velocity along the circle and change the radius. If no input is given the vehicle will hold position."},
+	MAV_CMD_NAV_FENCE_RETURN_POINT:             {"MAV_CMD_NAV_FENCE_RETURN_POINT", "Fence return point. There can only be one fence return point."},
+	MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION: {"MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION", "Fence vertex for an inclusion polygon (the polygon must not be self-intersecting). The vehicle must stay within this area. Minimum of 3 vertices required."},
+	MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION: {"MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION", "Fence vertex for an exclusion polygon (the polygon must not be self-intersecting). The vehicle must stay outside this area. Minimum of 3 vertices required."},
+	MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION:         {"MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION", "Circular fence area. The vehicle must stay inside this area."},
+	MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION:         {"MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION", "Circular fence area. The vehicle must stay outside this area."},
+	MAV_CMD_NAV_RALLY_POINT:                    {"MAV_CMD_NAV_RALLY_POINT", "Rally point. You can have multiple rally points defined."},
+	MAV_CMD_PAYLOAD_PREPARE_DEPLOY:             {"MAV_CMD_PAYLOAD_PREPARE_DEPLOY", "Deploy payload on a Lat / Lon / Alt position. This includes the navigation to reach the required release position and velocity."},
+	MAV_CMD_PAYLOAD_CONTROL_DEPLOY:             {"MAV_CMD_PAYLOAD_CONTROL_DEPLOY", "Control the payload deployment."},
+	MAV_CMD_WAYPOINT_USER_1:                    {"MAV_CMD_WAYPOINT_USER_1", "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+	MAV_CMD_WAYPOINT_USER_2:                    {"MAV_CMD_WAYPOINT_USER_2", "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+	MAV_CMD_WAYPOINT_USER_3:                    {"MAV_CMD_WAYPOINT_USER_3", "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+	MAV_CMD_WAYPOINT_USER_4:                    {"MAV_CMD_WAYPOINT_USER_4", "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+	MAV_CMD_WAYPOINT_USER_5:                    {"MAV_CMD_WAYPOINT_USER_5", "User defined waypoint item. Ground Station will show the Vehicle as flying through this item."},
+	MAV_CMD_SPATIAL_USER_1:                     {"MAV_CMD_SPATIAL_USER_1", "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+	MAV_CMD_SPATIAL_USER_2:                     {"MAV_CMD_SPATIAL_USER_2", "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+	MAV_CMD_SPATIAL_USER_3:                     {"MAV_CMD_SPATIAL_USER_3", "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+	MAV_CMD_SPATIAL_USER_4:                     {"MAV_CMD_SPATIAL_USER_4", "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+	MAV_CMD_SPATIAL_USER_5:                     {"MAV_CMD_SPATIAL_USER_5", "User defined spatial item. Ground Station will not show the Vehicle as flying through this item. Example: ROI item."},
+	MAV_CMD_USER_1:                             {"MAV_CMD_USER_1", "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+	MAV_CMD_USER_2:                             {"MAV_CMD_USER_2", "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+	MAV_CMD_USER_3:                             {"MAV_CMD_USER_3", "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+	MAV_CMD_USER_4:                             {"MAV_CMD_USER_4", "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+	MAV_CMD_USER_5:                             {"MAV_CMD_USER_5", "User defined command. Ground Station will not show the Vehicle as flying through this item. Example: MAV_CMD_DO_SET_PARAMETER item."},
+}
+
+func (e MavCmd) String() string {
+	if entry, ok := mavCmdEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavCmd(%d)", uint32(e))
+}
+
+func (e MavCmd) Description() string {
+	return mavCmdEntries[e].description
+}
+
+// Valid reports whether e is a MAV_CMD entry
+func (e MavCmd) Valid() bool {
+	_, ok := mavCmdEntries[e]
+	return ok
+}
+
+// ParseMavCmd returns the MAV_CMD entry called name
+func ParseMavCmd(name string) (MavCmd, error) {
+	for e, entry := range mavCmdEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_CMD %q", name)
+}
+
 // MavDataStream: THIS INTERFACE IS DEPRECATED AS OF JULY 2015. Please use MESSAGE_INTERVAL instead. A data stream is not a fixed set of messages, but rather a      recommendation to the autopilot software. Individual autopilots may or may not obey      the recommended messages.
+type MavDataStream uint32
+
 const (
 	MAV_DATA_STREAM_ALL             = 0  // Enable all data streams
 	MAV_DATA_STREAM_RAW_SENSORS     = 1  // Enable IMU_RAW, GPS_RAW, GPS_STATUS packets.
@@ -382,7 +1185,48 @@ const (
 	MAV_DATA_STREAM_EXTRA3          = 12 // Dependent on the autopilot
 )
 
+var mavDataStreamEntries = map[MavDataStream]enumEntry{
+	MAV_DATA_STREAM_ALL:             {"MAV_DATA_STREAM_ALL", "Enable all data streams"},
+	MAV_DATA_STREAM_RAW_SENSORS:     {"MAV_DATA_STREAM_RAW_SENSORS", "Enable IMU_RAW, GPS_RAW, GPS_STATUS packets."},
+	MAV_DATA_STREAM_EXTENDED_STATUS: {"MAV_DATA_STREAM_EXTENDED_STATUS", "Enable GPS_STATUS, CONTROL_STATUS, AUX_STATUS"},
+	MAV_DATA_STREAM_RC_CHANNELS:     {"MAV_DATA_STREAM_RC_CHANNELS", "Enable RC_CHANNELS_SCALED, RC_CHANNELS_RAW, SERVO_OUTPUT_RAW"},
+	MAV_DATA_STREAM_RAW_CONTROLLER:  {"MAV_DATA_STREAM_RAW_CONTROLLER", "Enable ATTITUDE_CONTROLLER_OUTPUT, POSITION_CONTROLLER_OUTPUT, NAV_CONTROLLER_OUTPUT."},
+	MAV_DATA_STREAM_POSITION:        {"MAV_DATA_STREAM_POSITION", "Enable LOCAL_POSITION, GLOBAL_POSITION/GLOBAL_POSITION_INT messages."},
+	MAV_DATA_STREAM_EXTRA1:          {"MAV_DATA_STREAM_EXTRA1", "Dependent on the autopilot"},
+	MAV_DATA_STREAM_EXTRA2:          {"MAV_DATA_STREAM_EXTRA2", "Dependent on the autopilot"},
+	MAV_DATA_STREAM_EXTRA3:          {"MAV_DATA_STREAM_EXTRA3", "Dependent on the autopilot"},
+}
+
+func (e MavDataStream) String() string {
+	if entry, ok := mavDataStreamEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavDataStream(%d)", uint32(e))
+}
+
+func (e MavDataStream) Description() string {
+	return mavDataStreamEntries[e].description
+}
+
+// Valid reports whether e is a MAV_DATA_STREAM entry
+func (e MavDataStream) Valid() bool {
+	_, ok := mavDataStreamEntries[e]
+	return ok
+}
+
+// ParseMavDataStream returns the MAV_DATA_STREAM entry called name
+func ParseMavDataStream(name string) (MavDataStream, error) {
+	for e, entry := range mavDataStreamEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_DATA_STREAM %q", name)
+}
+
 // MavRoi:  The ROI (region of interest) for the vehicle. This can be                 be used by the vehicle for camera/vehicle attitude alignment (see                 MAV_CMD_NAV_ROI).
+type MavRoi uint32
+
 const (
 	MAV_ROI_NONE     = 0 // No region of interest.
 	MAV_ROI_WPNEXT   = 1 // Point toward next MISSION.
@@ -391,7 +1235,44 @@ const (
 	MAV_ROI_TARGET   = 4 // Point toward of given id.
 )
 
+var mavRoiEntries = map[MavRoi]enumEntry{
+	MAV_ROI_NONE:     {"MAV_ROI_NONE", "No region of interest."},
+	MAV_ROI_WPNEXT:   {"MAV_ROI_WPNEXT", "Point toward next MISSION."},
+	MAV_ROI_WPINDEX:  {"MAV_ROI_WPINDEX", "Point toward given MISSION."},
+	MAV_ROI_LOCATION: {"MAV_ROI_LOCATION", "Point toward fixed location."},
+	MAV_ROI_TARGET:   {"MAV_ROI_TARGET", "Point toward of given id."},
+}
+
+func (e MavRoi) String() string {
+	if entry, ok := mavRoiEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavRoi(%d)", uint32(e))
+}
+
+func (e MavRoi) Description() string {
+	return mavRoiEntries[e].description
+}
+
+// Valid reports whether e is a MAV_ROI entry
+func (e MavRoi) Valid() bool {
+	_, ok := mavRoiEntries[e]
+	return ok
+}
+
+// ParseMavRoi returns the MAV_ROI entry called name
+func ParseMavRoi(name string) (MavRoi, error) {
+	for e, entry := range mavRoiEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_ROI %q", name)
+}
+
 // MavCmdAck: ACK / NACK / ERROR values as a result of MAV_CMDs and for mission item transmission.
+type MavCmdAck uint32
+
 const (
 	MAV_CMD_ACK_OK                                 = 0 // Command / mission item is ok.
 	MAV_CMD_ACK_ERR_FAIL                           = 1 // Generic error message if none of the other reasons fails or if no detailed error reporting is implemented.
@@ -404,7 +1285,48 @@ const (
 	MAV_CMD_ACK_ERR_Z_ALT_OUT_OF_RANGE             = 8 // The Z or altitude value is out of range.
 )
 
+var mavCmdAckEntries = map[MavCmdAck]enumEntry{
+	MAV_CMD_ACK_OK:                                 {"MAV_CMD_ACK_OK", "Command / mission item is ok."},
+	MAV_CMD_ACK_ERR_FAIL:                           {"MAV_CMD_ACK_ERR_FAIL", "Generic error message if none of the other reasons fails or if no detailed error reporting is implemented."},
+	MAV_CMD_ACK_ERR_ACCESS_DENIED:                  {"MAV_CMD_ACK_ERR_ACCESS_DENIED", "The system is refusing to accept this command from this source / communication partner."},
+	MAV_CMD_ACK_ERR_NOT_SUPPORTED:                  {"MAV_CMD_ACK_ERR_NOT_SUPPORTED", "Command or mission item is not supported, other commands would be accepted."},
+	MAV_CMD_ACK_ERR_COORDINATE_FRAME_NOT_SUPPORTED: {"MAV_CMD_ACK_ERR_COORDINATE_FRAME_NOT_SUPPORTED", "The coordinate frame of this command / mission item is not supported."},
+	MAV_CMD_ACK_ERR_COORDINATES_OUT_OF_RANGE:       {"MAV_CMD_ACK_ERR_COORDINATES_OUT_OF_RANGE", "The coordinate frame of this command is ok, but he coordinate values exceed the safety limits of this system. This is a generic error, please use the more specific error messages below if possible."},
+	MAV_CMD_ACK_ERR_X_LAT_OUT_OF_RANGE:             {"MAV_CMD_ACK_ERR_X_LAT_OUT_OF_RANGE", "The X or latitude value is out of range."},
+	MAV_CMD_ACK_ERR_Y_LON_OUT_OF_RANGE:             {"MAV_CMD_ACK_ERR_Y_LON_OUT_OF_RANGE", "The Y or longitude value is out of range."},
+	MAV_CMD_ACK_ERR_Z_ALT_OUT_OF_RANGE:             {"MAV_CMD_ACK_ERR_Z_ALT_OUT_OF_RANGE", "The Z or altitude value is out of range."},
+}
+
+func (e MavCmdAck) String() string {
+	if entry, ok := mavCmdAckEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavCmdAck(%d)", uint32(e))
+}
+
+func (e MavCmdAck) Description() string {
+	return mavCmdAckEntries[e].description
+}
+
+// Valid reports whether e is a MAV_CMD_ACK entry
+func (e MavCmdAck) Valid() bool {
+	_, ok := mavCmdAckEntries[e]
+	return ok
+}
+
+// ParseMavCmdAck returns the MAV_CMD_ACK entry called name
+func ParseMavCmdAck(name string) (MavCmdAck, error) {
+	for e, entry := range mavCmdAckEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_CMD_ACK %q", name)
+}
+
 // MavParamType: Specifies the datatype of a MAVLink parameter.
+type MavParamType uint32
+
 const (
 	MAV_PARAM_TYPE_UINT8  = 1  // 8-bit unsigned integer
 	MAV_PARAM_TYPE_INT8   = 2  // 8-bit signed integer
@@ -418,16 +1340,97 @@ const (
 	MAV_PARAM_TYPE_REAL64 = 10 // 64-bit floating-point
 )
 
+var mavParamTypeEntries = map[MavParamType]enumEntry{
+	MAV_PARAM_TYPE_UINT8:  {"MAV_PARAM_TYPE_UINT8", "8-bit unsigned integer"},
+	MAV_PARAM_TYPE_INT8:   {"MAV_PARAM_TYPE_INT8", "8-bit signed integer"},
+	MAV_PARAM_TYPE_UINT16: {"MAV_PARAM_TYPE_UINT16", "16-bit unsigned integer"},
+	MAV_PARAM_TYPE_INT16:  {"MAV_PARAM_TYPE_INT16", "16-bit signed integer"},
+	MAV_PARAM_TYPE_UINT32: {"MAV_PARAM_TYPE_UINT32", "32-bit unsigned integer"},
+	MAV_PARAM_TYPE_INT32:  {"MAV_PARAM_TYPE_INT32", "32-bit signed integer"},
+	MAV_PARAM_TYPE_UINT64: {"MAV_PARAM_TYPE_UINT64", "64-bit unsigned integer"},
+	MAV_PARAM_TYPE_INT64:  {"MAV_PARAM_TYPE_INT64", "64-bit signed integer"},
+	MAV_PARAM_TYPE_REAL32: {"MAV_PARAM_TYPE_REAL32", "32-bit floating-point"},
+	MAV_PARAM_TYPE_REAL64: {"MAV_PARAM_TYPE_REAL64", "64-bit floating-point"},
+}
+
+func (e MavParamType) String() string {
+	if entry, ok := mavParamTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavParamType(%d)", uint32(e))
+}
+
+func (e MavParamType) Description() string {
+	return mavParamTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_PARAM_TYPE entry
+func (e MavParamType) Valid() bool {
+	_, ok := mavParamTypeEntries[e]
+	return ok
+}
+
+// ParseMavParamType returns the MAV_PARAM_TYPE entry called name
+func ParseMavParamType(name string) (MavParamType, error) {
+	for e, entry := range mavParamTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_PARAM_TYPE %q", name)
+}
+
 // MavResult: result from a mavlink command
+type MavResult uint32
+
 const (
 	MAV_RESULT_ACCEPTED             = 0 // Command ACCEPTED and EXECUTED
 	MAV_RESULT_TEMPORARILY_REJECTED = 1 // Command TEMPORARY REJECTED/DENIED
 	MAV_RESULT_DENIED               = 2 // Command PERMANENTLY DENIED
 	MAV_RESULT_UNSUPPORTED          = 3 // Command UNKNOWN/UNSUPPORTED
 	MAV_RESULT_FAILED               = 4 // Command executed, but failed
+	MAV_RESULT_IN_PROGRESS          = 5 // Command is valid and is being executed. This will be followed by further progress updates, i.e. the component may send further COMMAND_ACK messages with result MAV_RESULT_IN_PROGRESS (at a rate decided by the implementation), and must terminate by sending a COMMAND_ACK message with final result of the operation.
 )
 
+var mavResultEntries = map[MavResult]enumEntry{
+	MAV_RESULT_ACCEPTED:             {"MAV_RESULT_ACCEPTED", "Command ACCEPTED and EXECUTED"},
+	MAV_RESULT_TEMPORARILY_REJECTED: {"MAV_RESULT_TEMPORARILY_REJECTED", "Command TEMPORARY REJECTED/DENIED"},
+	MAV_RESULT_DENIED:               {"MAV_RESULT_DENIED", "Command PERMANENTLY DENIED"},
+	MAV_RESULT_UNSUPPORTED:          {"MAV_RESULT_UNSUPPORTED", "Command UNKNOWN/UNSUPPORTED"},
+	MAV_RESULT_FAILED:               {"MAV_RESULT_FAILED", "Command executed, but failed"},
+	MAV_RESULT_IN_PROGRESS:          {"MAV_RESULT_IN_PROGRESS", "Command is valid and is being executed. This will be followed by further progress updates, i.e. the component may send further COMMAND_ACK messages with result MAV_RESULT_IN_PROGRESS (at a rate decided by the implementation), and must terminate by sending a COMMAND_ACK message with final result of the operation."},
+}
+
+func (e MavResult) String() string {
+	if entry, ok := mavResultEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavResult(%d)", uint32(e))
+}
+
+func (e MavResult) Description() string {
+	return mavResultEntries[e].description
+}
+
+// Valid reports whether e is a MAV_RESULT entry
+func (e MavResult) Valid() bool {
+	_, ok := mavResultEntries[e]
+	return ok
+}
+
+// ParseMavResult returns the MAV_RESULT entry called name
+func ParseMavResult(name string) (MavResult, error) {
+	for e, entry := range mavResultEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_RESULT %q", name)
+}
+
 // MavMissionResult: result in a mavlink mission ack
+type MavMissionResult uint32
+
 const (
 	MAV_MISSION_ACCEPTED          = 0  // mission accepted OK
 	MAV_MISSION_ERROR             = 1  // generic error / not accepting mission commands at all right now
@@ -446,7 +1449,98 @@ const (
 	MAV_MISSION_DENIED            = 14 // not accepting any mission commands from this communication partner
 )
 
+var mavMissionResultEntries = map[MavMissionResult]enumEntry{
+	MAV_MISSION_ACCEPTED:          {"MAV_MISSION_ACCEPTED", "mission accepted OK"},
+	MAV_MISSION_ERROR:             {"MAV_MISSION_ERROR", "generic error / not accepting mission commands at all right now"},
+	MAV_MISSION_UNSUPPORTED_FRAME: {"MAV_MISSION_UNSUPPORTED_FRAME", "coordinate frame is not supported"},
+	MAV_MISSION_UNSUPPORTED:       {"MAV_MISSION_UNSUPPORTED", "command is not supported"},
+	MAV_MISSION_NO_SPACE:          {"MAV_MISSION_NO_SPACE", "mission item exceeds storage space"},
+	MAV_MISSION_INVALID:           {"MAV_MISSION_INVALID", "one of the parameters has an invalid value"},
+	MAV_MISSION_INVALID_PARAM1:    {"MAV_MISSION_INVALID_PARAM1", "param1 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM2:    {"MAV_MISSION_INVALID_PARAM2", "param2 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM3:    {"MAV_MISSION_INVALID_PARAM3", "param3 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM4:    {"MAV_MISSION_INVALID_PARAM4", "param4 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM5_X:  {"MAV_MISSION_INVALID_PARAM5_X", "x/param5 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM6_Y:  {"MAV_MISSION_INVALID_PARAM6_Y", "y/param6 has an invalid value"},
+	MAV_MISSION_INVALID_PARAM7:    {"MAV_MISSION_INVALID_PARAM7", "param7 has an invalid value"},
+	MAV_MISSION_INVALID_SEQUENCE:  {"MAV_MISSION_INVALID_SEQUENCE", "received waypoint out of sequence"},
+	MAV_MISSION_DENIED:            {"MAV_MISSION_DENIED", "not accepting any mission commands from this communication partner"},
+}
+
+func (e MavMissionResult) String() string {
+	if entry, ok := mavMissionResultEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavMissionResult(%d)", uint32(e))
+}
+
+func (e MavMissionResult) Description() string {
+	return mavMissionResultEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MISSION_RESULT entry
+func (e MavMissionResult) Valid() bool {
+	_, ok := mavMissionResultEntries[e]
+	return ok
+}
+
+// ParseMavMissionResult returns the MAV_MISSION_RESULT entry called name
+func ParseMavMissionResult(name string) (MavMissionResult, error) {
+	for e, entry := range mavMissionResultEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MISSION_RESULT %q", name)
+}
+
+// MavMissionType: Type of mission items being requested/sent in mission protocol.
+type MavMissionType uint32
+
+const (
+	MAV_MISSION_TYPE_MISSION = 0   // Items are mission commands for main mission.
+	MAV_MISSION_TYPE_FENCE   = 1   // Specifies GeoFence area(s). Items are MAV_CMD_NAV_FENCE_ GeoFence items.
+	MAV_MISSION_TYPE_RALLY   = 2   // Specifies the rally points for the vehicle. Rally points are alternative RTL points. Items are MAV_CMD_NAV_RALLY_POINT rally point items.
+	MAV_MISSION_TYPE_ALL     = 255 // Only used in MISSION_CLEAR_ALL to clear all mission types.
+)
+
+var mavMissionTypeEntries = map[MavMissionType]enumEntry{
+	MAV_MISSION_TYPE_MISSION: {"MAV_MISSION_TYPE_MISSION", "Items are mission commands for main mission."},
+	MAV_MISSION_TYPE_FENCE:   {"MAV_MISSION_TYPE_FENCE", "Specifies GeoFence area(s). Items are MAV_CMD_NAV_FENCE_ GeoFence items."},
+	MAV_MISSION_TYPE_RALLY:   {"MAV_MISSION_TYPE_RALLY", "Specifies the rally points for the vehicle. Rally points are alternative RTL points. Items are MAV_CMD_NAV_RALLY_POINT rally point items."},
+	MAV_MISSION_TYPE_ALL:     {"MAV_MISSION_TYPE_ALL", "Only used in MISSION_CLEAR_ALL to clear all mission types."},
+}
+
+func (e MavMissionType) String() string {
+	if entry, ok := mavMissionTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavMissionType(%d)", uint32(e))
+}
+
+func (e MavMissionType) Description() string {
+	return mavMissionTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_MISSION_TYPE entry
+func (e MavMissionType) Valid() bool {
+	_, ok := mavMissionTypeEntries[e]
+	return ok
+}
+
+// ParseMavMissionType returns the MAV_MISSION_TYPE entry called name
+func ParseMavMissionType(name string) (MavMissionType, error) {
+	for e, entry := range mavMissionTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_MISSION_TYPE %q", name)
+}
+
 // MavSeverity: Indicates the severity level, generally used for status messages to indicate their relative urgency. Based on RFC-5424 using expanded definitions at: http://www.kiwisyslog.com/kb/info:-syslog-message-levels/.
+type MavSeverity uint32
+
 const (
 	MAV_SEVERITY_EMERGENCY = 0 // System is unusable. This is a "panic" condition.
 	MAV_SEVERITY_ALERT     = 1 // Action should be taken immediately. Indicates error in non-critical systems.
@@ -458,7 +1552,47 @@ const (
 	MAV_SEVERITY_DEBUG     = 7 // Useful non-operational messages that can assist in debugging. These should not occur during normal operation.
 )
 
+var mavSeverityEntries = map[MavSeverity]enumEntry{
+	MAV_SEVERITY_EMERGENCY: {"MAV_SEVERITY_EMERGENCY", "System is unusable. This is a \"panic\" condition."},
+	MAV_SEVERITY_ALERT:     {"MAV_SEVERITY_ALERT", "Action should be taken immediately. Indicates error in non-critical systems."},
+	MAV_SEVERITY_CRITICAL:  {"MAV_SEVERITY_CRITICAL", "Action must be taken immediately. Indicates failure in a primary system."},
+	MAV_SEVERITY_ERROR:     {"MAV_SEVERITY_ERROR", "Indicates an error in secondary/redundant systems."},
+	MAV_SEVERITY_WARNING:   {"MAV_SEVERITY_WARNING", "Indicates about a possible future error if this is not resolved within a given timeframe. Example would be a low battery warning."},
+	MAV_SEVERITY_NOTICE:    {"MAV_SEVERITY_NOTICE", "An unusual event has occured, though not an error condition. This should be investigated for the root cause."},
+	MAV_SEVERITY_INFO:      {"MAV_SEVERITY_INFO", "Normal operational messages. Useful for logging. No action is required for these messages."},
+	MAV_SEVERITY_DEBUG:     {"MAV_SEVERITY_DEBUG", "Useful non-operational messages that can assist in debugging. These should not occur during normal operation."},
+}
+
+func (e MavSeverity) String() string {
+	if entry, ok := mavSeverityEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavSeverity(%d)", uint32(e))
+}
+
+func (e MavSeverity) Description() string {
+	return mavSeverityEntries[e].description
+}
+
+// Valid reports whether e is a MAV_SEVERITY entry
+func (e MavSeverity) Valid() bool {
+	_, ok := mavSeverityEntries[e]
+	return ok
+}
+
+// ParseMavSeverity returns the MAV_SEVERITY entry called name
+func ParseMavSeverity(name string) (MavSeverity, error) {
+	for e, entry := range mavSeverityEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_SEVERITY %q", name)
+}
+
 // MavPowerStatus: Power supply status flags (bitmask)
+type MavPowerStatus uint32
+
 const (
 	MAV_POWER_STATUS_BRICK_VALID                = 1  // main brick power supply valid
 	MAV_POWER_STATUS_SERVO_VALID                = 2  // main servo power supply valid for FMU
@@ -468,7 +1602,45 @@ const (
 	MAV_POWER_STATUS_CHANGED                    = 32 // Power status has changed since boot
 )
 
+var mavPowerStatusEntries = map[MavPowerStatus]enumEntry{
+	MAV_POWER_STATUS_BRICK_VALID:                {"MAV_POWER_STATUS_BRICK_VALID", "main brick power supply valid"},
+	MAV_POWER_STATUS_SERVO_VALID:                {"MAV_POWER_STATUS_SERVO_VALID", "main servo power supply valid for FMU"},
+	MAV_POWER_STATUS_USB_CONNECTED:              {"MAV_POWER_STATUS_USB_CONNECTED", "USB power is connected"},
+	MAV_POWER_STATUS_PERIPH_OVERCURRENT:         {"MAV_POWER_STATUS_PERIPH_OVERCURRENT", "peripheral supply is in over-current state"},
+	MAV_POWER_STATUS_PERIPH_HIPOWER_OVERCURRENT: {"MAV_POWER_STATUS_PERIPH_HIPOWER_OVERCURRENT", "hi-power peripheral supply is in over-current state"},
+	MAV_POWER_STATUS_CHANGED:                    {"MAV_POWER_STATUS_CHANGED", "Power status has changed since boot"},
+}
+
+func (e MavPowerStatus) String() string {
+	if entry, ok := mavPowerStatusEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavPowerStatus(%d)", uint32(e))
+}
+
+func (e MavPowerStatus) Description() string {
+	return mavPowerStatusEntries[e].description
+}
+
+// Valid reports whether e is a MAV_POWER_STATUS entry
+func (e MavPowerStatus) Valid() bool {
+	_, ok := mavPowerStatusEntries[e]
+	return ok
+}
+
+// ParseMavPowerStatus returns the MAV_POWER_STATUS entry called name
+func ParseMavPowerStatus(name string) (MavPowerStatus, error) {
+	for e, entry := range mavPowerStatusEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_POWER_STATUS %q", name)
+}
+
 // SerialControlDev: SERIAL_CONTROL device types
+type SerialControlDev uint32
+
 const (
 	SERIAL_CONTROL_DEV_TELEM1 = 0  // First telemetry port
 	SERIAL_CONTROL_DEV_TELEM2 = 1  // Second telemetry port
@@ -477,7 +1649,44 @@ const (
 	SERIAL_CONTROL_DEV_SHELL  = 10 // system shell
 )
 
+var serialControlDevEntries = map[SerialControlDev]enumEntry{
+	SERIAL_CONTROL_DEV_TELEM1: {"SERIAL_CONTROL_DEV_TELEM1", "First telemetry port"},
+	SERIAL_CONTROL_DEV_TELEM2: {"SERIAL_CONTROL_DEV_TELEM2", "Second telemetry port"},
+	SERIAL_CONTROL_DEV_GPS1:   {"SERIAL_CONTROL_DEV_GPS1", "First GPS port"},
+	SERIAL_CONTROL_DEV_GPS2:   {"SERIAL_CONTROL_DEV_GPS2", "Second GPS port"},
+	SERIAL_CONTROL_DEV_SHELL:  {"SERIAL_CONTROL_DEV_SHELL", "system shell"},
+}
+
+func (e SerialControlDev) String() string {
+	if entry, ok := serialControlDevEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("SerialControlDev(%d)", uint32(e))
+}
+
+func (e SerialControlDev) Description() string {
+	return serialControlDevEntries[e].description
+}
+
+// Valid reports whether e is a SERIAL_CONTROL_DEV entry
+func (e SerialControlDev) Valid() bool {
+	_, ok := serialControlDevEntries[e]
+	return ok
+}
+
+// ParseSerialControlDev returns the SERIAL_CONTROL_DEV entry called name
+func ParseSerialControlDev(name string) (SerialControlDev, error) {
+	for e, entry := range serialControlDevEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown SERIAL_CONTROL_DEV %q", name)
+}
+
 // SerialControlFlag: SERIAL_CONTROL flags (bitmask)
+type SerialControlFlag uint32
+
 const (
 	SERIAL_CONTROL_FLAG_REPLY     = 1  // Set if this is a reply
 	SERIAL_CONTROL_FLAG_RESPOND   = 2  // Set if the sender wants the receiver to send a response as another SERIAL_CONTROL message
@@ -486,14 +1695,86 @@ const (
 	SERIAL_CONTROL_FLAG_MULTI     = 16 // Send multiple replies until port is drained
 )
 
+var serialControlFlagEntries = map[SerialControlFlag]enumEntry{
+	SERIAL_CONTROL_FLAG_REPLY:     {"SERIAL_CONTROL_FLAG_REPLY", "Set if this is a reply"},
+	SERIAL_CONTROL_FLAG_RESPOND:   {"SERIAL_CONTROL_FLAG_RESPOND", "Set if the sender wants the receiver to send a response as another SERIAL_CONTROL message"},
+	SERIAL_CONTROL_FLAG_EXCLUSIVE: {"SERIAL_CONTROL_FLAG_EXCLUSIVE", "Set if access to the serial port should be removed from whatever driver is currently using it, giving exclusive access to the SERIAL_CONTROL protocol. The port can be handed back by sending a request without this flag set"},
+	SERIAL_CONTROL_FLAG_BLOCKING:  {"SERIAL_CONTROL_FLAG_BLOCKING", "Block on writes to the serial port"},
+	SERIAL_CONTROL_FLAG_MULTI:     {"SERIAL_CONTROL_FLAG_MULTI", "Send multiple replies until port is drained"},
+}
+
+func (e SerialControlFlag) String() string {
+	if entry, ok := serialControlFlagEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("SerialControlFlag(%d)", uint32(e))
+}
+
+func (e SerialControlFlag) Description() string {
+	return serialControlFlagEntries[e].description
+}
+
+// Valid reports whether e is a SERIAL_CONTROL_FLAG entry
+func (e SerialControlFlag) Valid() bool {
+	_, ok := serialControlFlagEntries[e]
+	return ok
+}
+
+// ParseSerialControlFlag returns the SERIAL_CONTROL_FLAG entry called name
+func ParseSerialControlFlag(name string) (SerialControlFlag, error) {
+	for e, entry := range serialControlFlagEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown SERIAL_CONTROL_FLAG %q", name)
+}
+
 // MavDistanceSensor: Enumeration of distance sensor types
+type MavDistanceSensor uint32
+
 const (
 	MAV_DISTANCE_SENSOR_LASER      = 0 // Laser rangefinder, e.g. LightWare SF02/F or PulsedLight units
 	MAV_DISTANCE_SENSOR_ULTRASOUND = 1 // Ultrasound rangefinder, e.g. MaxBotix units
 	MAV_DISTANCE_SENSOR_INFRARED   = 2 // Infrared rangefinder, e.g. Sharp units
 )
 
+var mavDistanceSensorEntries = map[MavDistanceSensor]enumEntry{
+	MAV_DISTANCE_SENSOR_LASER:      {"MAV_DISTANCE_SENSOR_LASER", "Laser rangefinder, e.g. LightWare SF02/F or PulsedLight units"},
+	MAV_DISTANCE_SENSOR_ULTRASOUND: {"MAV_DISTANCE_SENSOR_ULTRASOUND", "Ultrasound rangefinder, e.g. MaxBotix units"},
+	MAV_DISTANCE_SENSOR_INFRARED:   {"MAV_DISTANCE_SENSOR_INFRARED", "Infrared rangefinder, e.g. Sharp units"},
+}
+
+func (e MavDistanceSensor) String() string {
+	if entry, ok := mavDistanceSensorEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavDistanceSensor(%d)", uint32(e))
+}
+
+func (e MavDistanceSensor) Description() string {
+	return mavDistanceSensorEntries[e].description
+}
+
+// Valid reports whether e is a MAV_DISTANCE_SENSOR entry
+func (e MavDistanceSensor) Valid() bool {
+	_, ok := mavDistanceSensorEntries[e]
+	return ok
+}
+
+// ParseMavDistanceSensor returns the MAV_DISTANCE_SENSOR entry called name
+func ParseMavDistanceSensor(name string) (MavDistanceSensor, error) {
+	for e, entry := range mavDistanceSensorEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_DISTANCE_SENSOR %q", name)
+}
+
 // MavSensorOrientation: Enumeration of sensor orientation, according to its rotations
+type MavSensorOrientation uint32
+
 const (
 	MAV_SENSOR_ROTATION_NONE                       = 0  // Roll: 0, Pitch: 0, Yaw: 0
 	MAV_SENSOR_ROTATION_YAW_45                     = 1  // Roll: 0, Pitch: 0, Yaw: 45
@@ -536,24 +1817,140 @@ const (
 	MAV_SENSOR_ROTATION_ROLL_315_PITCH_315_YAW_315 = 38 // Roll: 315, Pitch: 315, Yaw: 315
 )
 
+var mavSensorOrientationEntries = map[MavSensorOrientation]enumEntry{
+	MAV_SENSOR_ROTATION_NONE:                       {"MAV_SENSOR_ROTATION_NONE", "Roll: 0, Pitch: 0, Yaw: 0"},
+	MAV_SENSOR_ROTATION_YAW_45:                     {"MAV_SENSOR_ROTATION_YAW_45", "Roll: 0, Pitch: 0, Yaw: 45"},
+	MAV_SENSOR_ROTATION_YAW_90:                     {"MAV_SENSOR_ROTATION_YAW_90", "Roll: 0, Pitch: 0, Yaw: 90"},
+	MAV_SENSOR_ROTATION_YAW_135:                    {"MAV_SENSOR_ROTATION_YAW_135", "Roll: 0, Pitch: 0, Yaw: 135"},
+	MAV_SENSOR_ROTATION_YAW_180:                    {"MAV_SENSOR_ROTATION_YAW_180", "Roll: 0, Pitch: 0, Yaw: 180"},
+	MAV_SENSOR_ROTATION_YAW_225:                    {"MAV_SENSOR_ROTATION_YAW_225", "Roll: 0, Pitch: 0, Yaw: 225"},
+	MAV_SENSOR_ROTATION_YAW_270:                    {"MAV_SENSOR_ROTATION_YAW_270", "Roll: 0, Pitch: 0, Yaw: 270"},
+	MAV_SENSOR_ROTATION_YAW_315:                    {"MAV_SENSOR_ROTATION_YAW_315", "Roll: 0, Pitch: 0, Yaw: 315"},
+	MAV_SENSOR_ROTATION_ROLL_180:                   {"MAV_SENSOR_ROTATION_ROLL_180", "Roll: 180, Pitch: 0, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_45:            {"MAV_SENSOR_ROTATION_ROLL_180_YAW_45", "Roll: 180, Pitch: 0, Yaw: 45"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_90:            {"MAV_SENSOR_ROTATION_ROLL_180_YAW_90", "Roll: 180, Pitch: 0, Yaw: 90"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_135:           {"MAV_SENSOR_ROTATION_ROLL_180_YAW_135", "Roll: 180, Pitch: 0, Yaw: 135"},
+	MAV_SENSOR_ROTATION_PITCH_180:                  {"MAV_SENSOR_ROTATION_PITCH_180", "Roll: 0, Pitch: 180, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_225:           {"MAV_SENSOR_ROTATION_ROLL_180_YAW_225", "Roll: 180, Pitch: 0, Yaw: 225"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_270:           {"MAV_SENSOR_ROTATION_ROLL_180_YAW_270", "Roll: 180, Pitch: 0, Yaw: 270"},
+	MAV_SENSOR_ROTATION_ROLL_180_YAW_315:           {"MAV_SENSOR_ROTATION_ROLL_180_YAW_315", "Roll: 180, Pitch: 0, Yaw: 315"},
+	MAV_SENSOR_ROTATION_ROLL_90:                    {"MAV_SENSOR_ROTATION_ROLL_90", "Roll: 90, Pitch: 0, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_45:             {"MAV_SENSOR_ROTATION_ROLL_90_YAW_45", "Roll: 90, Pitch: 0, Yaw: 45"},
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_90:             {"MAV_SENSOR_ROTATION_ROLL_90_YAW_90", "Roll: 90, Pitch: 0, Yaw: 90"},
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_135:            {"MAV_SENSOR_ROTATION_ROLL_90_YAW_135", "Roll: 90, Pitch: 0, Yaw: 135"},
+	MAV_SENSOR_ROTATION_ROLL_270:                   {"MAV_SENSOR_ROTATION_ROLL_270", "Roll: 270, Pitch: 0, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_45:            {"MAV_SENSOR_ROTATION_ROLL_270_YAW_45", "Roll: 270, Pitch: 0, Yaw: 45"},
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_90:            {"MAV_SENSOR_ROTATION_ROLL_270_YAW_90", "Roll: 270, Pitch: 0, Yaw: 90"},
+	MAV_SENSOR_ROTATION_ROLL_270_YAW_135:           {"MAV_SENSOR_ROTATION_ROLL_270_YAW_135", "Roll: 270, Pitch: 0, Yaw: 135"},
+	MAV_SENSOR_ROTATION_PITCH_90:                   {"MAV_SENSOR_ROTATION_PITCH_90", "Roll: 0, Pitch: 90, Yaw: 0"},
+	MAV_SENSOR_ROTATION_PITCH_270:                  {"MAV_SENSOR_ROTATION_PITCH_270", "Roll: 0, Pitch: 270, Yaw: 0"},
+	MAV_SENSOR_ROTATION_PITCH_180_YAW_90:           {"MAV_SENSOR_ROTATION_PITCH_180_YAW_90", "Roll: 0, Pitch: 180, Yaw: 90"},
+	MAV_SENSOR_ROTATION_PITCH_180_YAW_270:          {"MAV_SENSOR_ROTATION_PITCH_180_YAW_270", "Roll: 0, Pitch: 180, Yaw: 270"},
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_90:           {"MAV_SENSOR_ROTATION_ROLL_90_PITCH_90", "Roll: 90, Pitch: 90, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_180_PITCH_90:          {"MAV_SENSOR_ROTATION_ROLL_180_PITCH_90", "Roll: 180, Pitch: 90, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_90:          {"MAV_SENSOR_ROTATION_ROLL_270_PITCH_90", "Roll: 270, Pitch: 90, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_180:          {"MAV_SENSOR_ROTATION_ROLL_90_PITCH_180", "Roll: 90, Pitch: 180, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_180:         {"MAV_SENSOR_ROTATION_ROLL_270_PITCH_180", "Roll: 270, Pitch: 180, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_270:          {"MAV_SENSOR_ROTATION_ROLL_90_PITCH_270", "Roll: 90, Pitch: 270, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_180_PITCH_270:         {"MAV_SENSOR_ROTATION_ROLL_180_PITCH_270", "Roll: 180, Pitch: 270, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_270_PITCH_270:         {"MAV_SENSOR_ROTATION_ROLL_270_PITCH_270", "Roll: 270, Pitch: 270, Yaw: 0"},
+	MAV_SENSOR_ROTATION_ROLL_90_PITCH_180_YAW_90:   {"MAV_SENSOR_ROTATION_ROLL_90_PITCH_180_YAW_90", "Roll: 90, Pitch: 180, Yaw: 90"},
+	MAV_SENSOR_ROTATION_ROLL_90_YAW_270:            {"MAV_SENSOR_ROTATION_ROLL_90_YAW_270", "Roll: 90, Pitch: 0, Yaw: 270"},
+	MAV_SENSOR_ROTATION_ROLL_315_PITCH_315_YAW_315: {"MAV_SENSOR_ROTATION_ROLL_315_PITCH_315_YAW_315", "Roll: 315, Pitch: 315, Yaw: 315"},
+}
+
+func (e MavSensorOrientation) String() string {
+	if entry, ok := mavSensorOrientationEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavSensorOrientation(%d)", uint32(e))
+}
+
+func (e MavSensorOrientation) Description() string {
+	return mavSensorOrientationEntries[e].description
+}
+
+// Valid reports whether e is a MAV_SENSOR_ORIENTATION entry
+func (e MavSensorOrientation) Valid() bool {
+	_, ok := mavSensorOrientationEntries[e]
+	return ok
+}
+
+// ParseMavSensorOrientation returns the MAV_SENSOR_ORIENTATION entry called name
+func ParseMavSensorOrientation(name string) (MavSensorOrientation, error) {
+	for e, entry := range mavSensorOrientationEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_SENSOR_ORIENTATION %q", name)
+}
+
 // MavProtocolCapability: Bitmask of (optional) autopilot capabilities (64 bit). If a bit is set, the autopilot supports this capability.
+type MavProtocolCapability uint32
+
 const (
-	MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT                  = 1   // Autopilot supports MISSION float message type.
-	MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT                    = 2   // Autopilot supports the new param float message type.
-	MAV_PROTOCOL_CAPABILITY_MISSION_INT                    = 4   // Autopilot supports MISSION_INT scaled integer message type.
-	MAV_PROTOCOL_CAPABILITY_COMMAND_INT                    = 8   // Autopilot supports COMMAND_INT scaled integer message type.
-	MAV_PROTOCOL_CAPABILITY_PARAM_UNION                    = 16  // Autopilot supports the new param union message type.
-	MAV_PROTOCOL_CAPABILITY_FTP                            = 32  // Autopilot supports the new FILE_TRANSFER_PROTOCOL message type.
-	MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET            = 64  // Autopilot supports commanding attitude offboard.
-	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED  = 128 // Autopilot supports commanding position and velocity targets in local NED frame.
-	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT = 256   // Autopilot supports commanding position and velocity targets in global scaled integers.
-	MAV_PROTOCOL_CAPABILITY_TERRAIN                        = 512   // Autopilot supports terrain protocol / data handling.
-	MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET            = 1024  // Autopilot supports direct actuator control.
-	MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION             = 2048  // Autopilot supports the flight termination command.
-	MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION            = 4096  // Autopilot supports onboard compass calibration.
+	MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT                  = 1    // Autopilot supports MISSION float message type.
+	MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT                    = 2    // Autopilot supports the new param float message type.
+	MAV_PROTOCOL_CAPABILITY_MISSION_INT                    = 4    // Autopilot supports MISSION_INT scaled integer message type.
+	MAV_PROTOCOL_CAPABILITY_COMMAND_INT                    = 8    // Autopilot supports COMMAND_INT scaled integer message type.
+	MAV_PROTOCOL_CAPABILITY_PARAM_UNION                    = 16   // Autopilot supports the new param union message type.
+	MAV_PROTOCOL_CAPABILITY_FTP                            = 32   // Autopilot supports the new FILE_TRANSFER_PROTOCOL message type.
+	MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET            = 64   // Autopilot supports commanding attitude offboard.
+	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED  = 128  // Autopilot supports commanding position and velocity targets in local NED frame.
+	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT = 256  // Autopilot supports commanding position and velocity targets in global scaled integers.
+	MAV_PROTOCOL_CAPABILITY_TERRAIN                        = 512  // Autopilot supports terrain protocol / data handling.
+	MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET            = 1024 // Autopilot supports direct actuator control.
+	MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION             = 2048 // Autopilot supports the flight termination command.
+	MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION            = 4096 // Autopilot supports onboard compass calibration.
 )
 
+var mavProtocolCapabilityEntries = map[MavProtocolCapability]enumEntry{
+	MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT:                  {"MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT", "Autopilot supports MISSION float message type."},
+	MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT:                    {"MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT", "Autopilot supports the new param float message type."},
+	MAV_PROTOCOL_CAPABILITY_MISSION_INT:                    {"MAV_PROTOCOL_CAPABILITY_MISSION_INT", "Autopilot supports MISSION_INT scaled integer message type."},
+	MAV_PROTOCOL_CAPABILITY_COMMAND_INT:                    {"MAV_PROTOCOL_CAPABILITY_COMMAND_INT", "Autopilot supports COMMAND_INT scaled integer message type."},
+	MAV_PROTOCOL_CAPABILITY_PARAM_UNION:                    {"MAV_PROTOCOL_CAPABILITY_PARAM_UNION", "Autopilot supports the new param union message type."},
+	MAV_PROTOCOL_CAPABILITY_FTP:                            {"MAV_PROTOCOL_CAPABILITY_FTP", "Autopilot supports the new FILE_TRANSFER_PROTOCOL message type."},
+	MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET:            {"MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET", "Autopilot supports commanding attitude offboard."},
+	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED:  {"MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED", "Autopilot supports commanding position and velocity targets in local NED frame."},
+	MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT: {"MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT", "Autopilot supports commanding position and velocity targets in global scaled integers."},
+	MAV_PROTOCOL_CAPABILITY_TERRAIN:                        {"MAV_PROTOCOL_CAPABILITY_TERRAIN", "Autopilot supports terrain protocol / data handling."},
+	MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET:            {"MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET", "Autopilot supports direct actuator control."},
+	MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION:             {"MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION", "Autopilot supports the flight termination command."},
+	MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION:            {"MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION", "Autopilot supports onboard compass calibration."},
+}
+
+func (e MavProtocolCapability) String() string {
+	if entry, ok := mavProtocolCapabilityEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavProtocolCapability(%d)", uint32(e))
+}
+
+func (e MavProtocolCapability) Description() string {
+	return mavProtocolCapabilityEntries[e].description
+}
+
+// Valid reports whether e is a MAV_PROTOCOL_CAPABILITY entry
+func (e MavProtocolCapability) Valid() bool {
+	_, ok := mavProtocolCapabilityEntries[e]
+	return ok
+}
+
+// ParseMavProtocolCapability returns the MAV_PROTOCOL_CAPABILITY entry called name
+func ParseMavProtocolCapability(name string) (MavProtocolCapability, error) {
+	for e, entry := range mavProtocolCapabilityEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_PROTOCOL_CAPABILITY %q", name)
+}
+
 // MavEstimatorType: Enumeration of estimator types
+type MavEstimatorType uint32
+
 const (
 	MAV_ESTIMATOR_TYPE_NAIVE   = 1 // This is a naive estimator without any real covariance feedback.
 	MAV_ESTIMATOR_TYPE_VISION  = 2 // Computer vision based estimate. Might be up to scale.
@@ -562,7 +1959,44 @@ const (
 	MAV_ESTIMATOR_TYPE_GPS_INS = 5 // Estimator integrating GPS and inertial sensing.
 )
 
+var mavEstimatorTypeEntries = map[MavEstimatorType]enumEntry{
+	MAV_ESTIMATOR_TYPE_NAIVE:   {"MAV_ESTIMATOR_TYPE_NAIVE", "This is a naive estimator without any real covariance feedback."},
+	MAV_ESTIMATOR_TYPE_VISION:  {"MAV_ESTIMATOR_TYPE_VISION", "Computer vision based estimate. Might be up to scale."},
+	MAV_ESTIMATOR_TYPE_VIO:     {"MAV_ESTIMATOR_TYPE_VIO", "Visual-inertial estimate."},
+	MAV_ESTIMATOR_TYPE_GPS:     {"MAV_ESTIMATOR_TYPE_GPS", "Plain GPS estimate."},
+	MAV_ESTIMATOR_TYPE_GPS_INS: {"MAV_ESTIMATOR_TYPE_GPS_INS", "Estimator integrating GPS and inertial sensing."},
+}
+
+func (e MavEstimatorType) String() string {
+	if entry, ok := mavEstimatorTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavEstimatorType(%d)", uint32(e))
+}
+
+func (e MavEstimatorType) Description() string {
+	return mavEstimatorTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_ESTIMATOR_TYPE entry
+func (e MavEstimatorType) Valid() bool {
+	_, ok := mavEstimatorTypeEntries[e]
+	return ok
+}
+
+// ParseMavEstimatorType returns the MAV_ESTIMATOR_TYPE entry called name
+func ParseMavEstimatorType(name string) (MavEstimatorType, error) {
+	for e, entry := range mavEstimatorTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_ESTIMATOR_TYPE %q", name)
+}
+
 // MavBatteryType: Enumeration of battery types
+type MavBatteryType uint32
+
 const (
 	MAV_BATTERY_TYPE_UNKNOWN = 0 // Not specified.
 	MAV_BATTERY_TYPE_LIPO    = 1 // Lithium polymer battery
@@ -571,16 +2005,90 @@ const (
 	MAV_BATTERY_TYPE_NIMH    = 4 // Nickel metal hydride battery
 )
 
-// MavBatteryFunction: Enumeration of battery functions
-const (
-	MAV_BATTERY_FUNCTION_UNKNOWN    = 0 // Battery function is unknown
-	MAV_BATTERY_FUNCTION_ALL        = 1 // Battery supports all flight systems
-	MAV_BATTERY_FUNCTION_PROPULSION = 2 // Battery for the propulsion system
-	MAV_BATTERY_FUNCTION_AVIONICS   = 3 // Avionics battery
-	MAV_BATTERY_TYPE_PAYLOAD        = 4 // Payload battery
-)
+var mavBatteryTypeEntries = map[MavBatteryType]enumEntry{
+	MAV_BATTERY_TYPE_UNKNOWN: {"MAV_BATTERY_TYPE_UNKNOWN", "Not specified."},
+	MAV_BATTERY_TYPE_LIPO:    {"MAV_BATTERY_TYPE_LIPO", "Lithium polymer battery"},
+	MAV_BATTERY_TYPE_LIFE:    {"MAV_BATTERY_TYPE_LIFE", "Lithium-iron-phosphate battery"},
+	MAV_BATTERY_TYPE_LION:    {"MAV_BATTERY_TYPE_LION", "Lithium-ION battery"},
+	MAV_BATTERY_TYPE_NIMH:    {"MAV_BATTERY_TYPE_NIMH", "Nickel metal hydride battery"},
+}
+
+func (e MavBatteryType) String() string {
+	if entry, ok := mavBatteryTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavBatteryType(%d)", uint32(e))
+}
+
+func (e MavBatteryType) Description() string {
+	return mavBatteryTypeEntries[e].description
+}
+
+// Valid reports whether e is a MAV_BATTERY_TYPE entry
+func (e MavBatteryType) Valid() bool {
+	_, ok := mavBatteryTypeEntries[e]
+	return ok
+}
+
+// ParseMavBatteryType returns the MAV_BATTERY_TYPE entry called name
+func ParseMavBatteryType(name string) (MavBatteryType, error) {
+	for e, entry := range mavBatteryTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_BATTERY_TYPE %q", name)
+}
+
+// MavBatteryFunction: Enumeration of battery functions
+type MavBatteryFunction uint32
+
+const (
+	MAV_BATTERY_FUNCTION_UNKNOWN    = 0 // Battery function is unknown
+	MAV_BATTERY_FUNCTION_ALL        = 1 // Battery supports all flight systems
+	MAV_BATTERY_FUNCTION_PROPULSION = 2 // Battery for the propulsion system
+	MAV_BATTERY_FUNCTION_AVIONICS   = 3 // Avionics battery
+	MAV_BATTERY_TYPE_PAYLOAD        = 4 // Payload battery
+)
+
+var mavBatteryFunctionEntries = map[MavBatteryFunction]enumEntry{
+	MAV_BATTERY_FUNCTION_UNKNOWN:    {"MAV_BATTERY_FUNCTION_UNKNOWN", "Battery function is unknown"},
+	MAV_BATTERY_FUNCTION_ALL:        {"MAV_BATTERY_FUNCTION_ALL", "Battery supports all flight systems"},
+	MAV_BATTERY_FUNCTION_PROPULSION: {"MAV_BATTERY_FUNCTION_PROPULSION", "Battery for the propulsion system"},
+	MAV_BATTERY_FUNCTION_AVIONICS:   {"MAV_BATTERY_FUNCTION_AVIONICS", "Avionics battery"},
+	MAV_BATTERY_TYPE_PAYLOAD:        {"MAV_BATTERY_TYPE_PAYLOAD", "Payload battery"},
+}
+
+func (e MavBatteryFunction) String() string {
+	if entry, ok := mavBatteryFunctionEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavBatteryFunction(%d)", uint32(e))
+}
+
+func (e MavBatteryFunction) Description() string {
+	return mavBatteryFunctionEntries[e].description
+}
+
+// Valid reports whether e is a MAV_BATTERY_FUNCTION entry
+func (e MavBatteryFunction) Valid() bool {
+	_, ok := mavBatteryFunctionEntries[e]
+	return ok
+}
+
+// ParseMavBatteryFunction returns the MAV_BATTERY_FUNCTION entry called name
+func ParseMavBatteryFunction(name string) (MavBatteryFunction, error) {
+	for e, entry := range mavBatteryFunctionEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_BATTERY_FUNCTION %q", name)
+}
 
 // MavVtolState: Enumeration of VTOL states
+type MavVtolState uint32
+
 const (
 	MAV_VTOL_STATE_UNDEFINED        = 0 // MAV is not configured as VTOL
 	MAV_VTOL_STATE_TRANSITION_TO_FW = 1 // VTOL is in transition from multicopter to fixed-wing
@@ -589,20 +2097,126 @@ const (
 	MAV_VTOL_STATE_FW               = 4 // VTOL is in fixed-wing state
 )
 
+var mavVtolStateEntries = map[MavVtolState]enumEntry{
+	MAV_VTOL_STATE_UNDEFINED:        {"MAV_VTOL_STATE_UNDEFINED", "MAV is not configured as VTOL"},
+	MAV_VTOL_STATE_TRANSITION_TO_FW: {"MAV_VTOL_STATE_TRANSITION_TO_FW", "VTOL is in transition from multicopter to fixed-wing"},
+	MAV_VTOL_STATE_TRANSITION_TO_MC: {"MAV_VTOL_STATE_TRANSITION_TO_MC", "VTOL is in transition from fixed-wing to multicopter"},
+	MAV_VTOL_STATE_MC:               {"MAV_VTOL_STATE_MC", "VTOL is in multicopter state"},
+	MAV_VTOL_STATE_FW:               {"MAV_VTOL_STATE_FW", "VTOL is in fixed-wing state"},
+}
+
+func (e MavVtolState) String() string {
+	if entry, ok := mavVtolStateEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavVtolState(%d)", uint32(e))
+}
+
+func (e MavVtolState) Description() string {
+	return mavVtolStateEntries[e].description
+}
+
+// Valid reports whether e is a MAV_VTOL_STATE entry
+func (e MavVtolState) Valid() bool {
+	_, ok := mavVtolStateEntries[e]
+	return ok
+}
+
+// ParseMavVtolState returns the MAV_VTOL_STATE entry called name
+func ParseMavVtolState(name string) (MavVtolState, error) {
+	for e, entry := range mavVtolStateEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_VTOL_STATE %q", name)
+}
+
 // MavLandedState: Enumeration of landed detector states
+type MavLandedState uint32
+
 const (
 	MAV_LANDED_STATE_UNDEFINED = 0 // MAV landed state is unknown
 	MAV_LANDED_STATE_ON_GROUND = 1 // MAV is landed (on ground)
 	MAV_LANDED_STATE_IN_AIR    = 2 // MAV is in air
 )
 
+var mavLandedStateEntries = map[MavLandedState]enumEntry{
+	MAV_LANDED_STATE_UNDEFINED: {"MAV_LANDED_STATE_UNDEFINED", "MAV landed state is unknown"},
+	MAV_LANDED_STATE_ON_GROUND: {"MAV_LANDED_STATE_ON_GROUND", "MAV is landed (on ground)"},
+	MAV_LANDED_STATE_IN_AIR:    {"MAV_LANDED_STATE_IN_AIR", "MAV is in air"},
+}
+
+func (e MavLandedState) String() string {
+	if entry, ok := mavLandedStateEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavLandedState(%d)", uint32(e))
+}
+
+func (e MavLandedState) Description() string {
+	return mavLandedStateEntries[e].description
+}
+
+// Valid reports whether e is a MAV_LANDED_STATE entry
+func (e MavLandedState) Valid() bool {
+	_, ok := mavLandedStateEntries[e]
+	return ok
+}
+
+// ParseMavLandedState returns the MAV_LANDED_STATE entry called name
+func ParseMavLandedState(name string) (MavLandedState, error) {
+	for e, entry := range mavLandedStateEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_LANDED_STATE %q", name)
+}
+
 // AdsbAltitudeType: Enumeration of the ADSB altimeter types
+type AdsbAltitudeType uint32
+
 const (
 	ADSB_ALTITUDE_TYPE_PRESSURE_QNH = 0 // Altitude reported from a Baro source using QNH reference
 	ADSB_ALTITUDE_TYPE_GEOMETRIC    = 1 // Altitude reported from a GNSS source
 )
 
+var adsbAltitudeTypeEntries = map[AdsbAltitudeType]enumEntry{
+	ADSB_ALTITUDE_TYPE_PRESSURE_QNH: {"ADSB_ALTITUDE_TYPE_PRESSURE_QNH", "Altitude reported from a Baro source using QNH reference"},
+	ADSB_ALTITUDE_TYPE_GEOMETRIC:    {"ADSB_ALTITUDE_TYPE_GEOMETRIC", "Altitude reported from a GNSS source"},
+}
+
+func (e AdsbAltitudeType) String() string {
+	if entry, ok := adsbAltitudeTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("AdsbAltitudeType(%d)", uint32(e))
+}
+
+func (e AdsbAltitudeType) Description() string {
+	return adsbAltitudeTypeEntries[e].description
+}
+
+// Valid reports whether e is a ADSB_ALTITUDE_TYPE entry
+func (e AdsbAltitudeType) Valid() bool {
+	_, ok := adsbAltitudeTypeEntries[e]
+	return ok
+}
+
+// ParseAdsbAltitudeType returns the ADSB_ALTITUDE_TYPE entry called name
+func ParseAdsbAltitudeType(name string) (AdsbAltitudeType, error) {
+	for e, entry := range adsbAltitudeTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ADSB_ALTITUDE_TYPE %q", name)
+}
+
 // AdsbEmitterType: ADSB classification for the type of vehicle emitting the transponder signal
+type AdsbEmitterType uint32
+
 const (
 	ADSB_EMITTER_TYPE_NO_INFO           = 0  //
 	ADSB_EMITTER_TYPE_LIGHT             = 1  //
@@ -626,7 +2240,59 @@ const (
 	ADSB_EMITTER_TYPE_POINT_OBSTACLE    = 19 //
 )
 
+var adsbEmitterTypeEntries = map[AdsbEmitterType]enumEntry{
+	ADSB_EMITTER_TYPE_NO_INFO:           {"ADSB_EMITTER_TYPE_NO_INFO", ""},
+	ADSB_EMITTER_TYPE_LIGHT:             {"ADSB_EMITTER_TYPE_LIGHT", ""},
+	ADSB_EMITTER_TYPE_SMALL:             {"ADSB_EMITTER_TYPE_SMALL", ""},
+	ADSB_EMITTER_TYPE_LARGE:             {"ADSB_EMITTER_TYPE_LARGE", ""},
+	ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE: {"ADSB_EMITTER_TYPE_HIGH_VORTEX_LARGE", ""},
+	ADSB_EMITTER_TYPE_HEAVY:             {"ADSB_EMITTER_TYPE_HEAVY", ""},
+	ADSB_EMITTER_TYPE_HIGHLY_MANUV:      {"ADSB_EMITTER_TYPE_HIGHLY_MANUV", ""},
+	ADSB_EMITTER_TYPE_ROTOCRAFT:         {"ADSB_EMITTER_TYPE_ROTOCRAFT", ""},
+	ADSB_EMITTER_TYPE_UNASSIGNED:        {"ADSB_EMITTER_TYPE_UNASSIGNED", ""},
+	ADSB_EMITTER_TYPE_GLIDER:            {"ADSB_EMITTER_TYPE_GLIDER", ""},
+	ADSB_EMITTER_TYPE_LIGHTER_AIR:       {"ADSB_EMITTER_TYPE_LIGHTER_AIR", ""},
+	ADSB_EMITTER_TYPE_PARACHUTE:         {"ADSB_EMITTER_TYPE_PARACHUTE", ""},
+	ADSB_EMITTER_TYPE_ULTRA_LIGHT:       {"ADSB_EMITTER_TYPE_ULTRA_LIGHT", ""},
+	ADSB_EMITTER_TYPE_UNASSIGNED2:       {"ADSB_EMITTER_TYPE_UNASSIGNED2", ""},
+	ADSB_EMITTER_TYPE_UAV:               {"ADSB_EMITTER_TYPE_UAV", ""},
+	ADSB_EMITTER_TYPE_SPACE:             {"ADSB_EMITTER_TYPE_SPACE", ""},
+	ADSB_EMITTER_TYPE_UNASSGINED3:       {"ADSB_EMITTER_TYPE_UNASSGINED3", ""},
+	ADSB_EMITTER_TYPE_EMERGENCY_SURFACE: {"ADSB_EMITTER_TYPE_EMERGENCY_SURFACE", ""},
+	ADSB_EMITTER_TYPE_SERVICE_SURFACE:   {"ADSB_EMITTER_TYPE_SERVICE_SURFACE", ""},
+	ADSB_EMITTER_TYPE_POINT_OBSTACLE:    {"ADSB_EMITTER_TYPE_POINT_OBSTACLE", ""},
+}
+
+func (e AdsbEmitterType) String() string {
+	if entry, ok := adsbEmitterTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("AdsbEmitterType(%d)", uint32(e))
+}
+
+func (e AdsbEmitterType) Description() string {
+	return adsbEmitterTypeEntries[e].description
+}
+
+// Valid reports whether e is a ADSB_EMITTER_TYPE entry
+func (e AdsbEmitterType) Valid() bool {
+	_, ok := adsbEmitterTypeEntries[e]
+	return ok
+}
+
+// ParseAdsbEmitterType returns the ADSB_EMITTER_TYPE entry called name
+func ParseAdsbEmitterType(name string) (AdsbEmitterType, error) {
+	for e, entry := range adsbEmitterTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ADSB_EMITTER_TYPE %q", name)
+}
+
 // AdsbFlags: These flags indicate status such as data validity of each data source. Set = data valid
+type AdsbFlags uint32
+
 const (
 	ADSB_FLAGS_VALID_COORDS   = 1  //
 	ADSB_FLAGS_VALID_ALTITUDE = 2  //
@@ -637,34 +2303,184 @@ const (
 	ADSB_FLAGS_SIMULATED      = 64 //
 )
 
+var adsbFlagsEntries = map[AdsbFlags]enumEntry{
+	ADSB_FLAGS_VALID_COORDS:   {"ADSB_FLAGS_VALID_COORDS", ""},
+	ADSB_FLAGS_VALID_ALTITUDE: {"ADSB_FLAGS_VALID_ALTITUDE", ""},
+	ADSB_FLAGS_VALID_HEADING:  {"ADSB_FLAGS_VALID_HEADING", ""},
+	ADSB_FLAGS_VALID_VELOCITY: {"ADSB_FLAGS_VALID_VELOCITY", ""},
+	ADSB_FLAGS_VALID_CALLSIGN: {"ADSB_FLAGS_VALID_CALLSIGN", ""},
+	ADSB_FLAGS_VALID_SQUAWK:   {"ADSB_FLAGS_VALID_SQUAWK", ""},
+	ADSB_FLAGS_SIMULATED:      {"ADSB_FLAGS_SIMULATED", ""},
+}
+
+func (e AdsbFlags) String() string {
+	if entry, ok := adsbFlagsEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("AdsbFlags(%d)", uint32(e))
+}
+
+func (e AdsbFlags) Description() string {
+	return adsbFlagsEntries[e].description
+}
+
+// Valid reports whether e is a ADSB_FLAGS entry
+func (e AdsbFlags) Valid() bool {
+	_, ok := adsbFlagsEntries[e]
+	return ok
+}
+
+// ParseAdsbFlags returns the ADSB_FLAGS entry called name
+func ParseAdsbFlags(name string) (AdsbFlags, error) {
+	for e, entry := range adsbFlagsEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ADSB_FLAGS %q", name)
+}
+
 // MavDoRepositionFlags: Bitmask of options for the MAV_CMD_DO_REPOSITION
+type MavDoRepositionFlags uint32
+
 const (
 	MAV_DO_REPOSITION_FLAGS_CHANGE_MODE = 1 // The aircraft should immediately transition into guided. This should not be set for follow me applications
 )
 
+var mavDoRepositionFlagsEntries = map[MavDoRepositionFlags]enumEntry{
+	MAV_DO_REPOSITION_FLAGS_CHANGE_MODE: {"MAV_DO_REPOSITION_FLAGS_CHANGE_MODE", "The aircraft should immediately transition into guided. This should not be set for follow me applications"},
+}
+
+func (e MavDoRepositionFlags) String() string {
+	if entry, ok := mavDoRepositionFlagsEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavDoRepositionFlags(%d)", uint32(e))
+}
+
+func (e MavDoRepositionFlags) Description() string {
+	return mavDoRepositionFlagsEntries[e].description
+}
+
+// Valid reports whether e is a MAV_DO_REPOSITION_FLAGS entry
+func (e MavDoRepositionFlags) Valid() bool {
+	_, ok := mavDoRepositionFlagsEntries[e]
+	return ok
+}
+
+// ParseMavDoRepositionFlags returns the MAV_DO_REPOSITION_FLAGS entry called name
+func ParseMavDoRepositionFlags(name string) (MavDoRepositionFlags, error) {
+	for e, entry := range mavDoRepositionFlagsEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_DO_REPOSITION_FLAGS %q", name)
+}
+
 // EstimatorStatusFlags: Flags in EKF_STATUS message
+type EstimatorStatusFlags uint32
+
 const (
-	ESTIMATOR_ATTITUDE           = 1   // True if the attitude estimate is good
-	ESTIMATOR_VELOCITY_HORIZ     = 2   // True if the horizontal velocity estimate is good
-	ESTIMATOR_VELOCITY_VERT      = 4   // True if the  vertical velocity estimate is good
-	ESTIMATOR_POS_HORIZ_REL      = 8   // True if the horizontal position (relative) estimate is good
-	ESTIMATOR_POS_HORIZ_ABS      = 16  // True if the horizontal position (absolute) estimate is good
-	ESTIMATOR_POS_VERT_ABS       = 32  // True if the vertical position (absolute) estimate is good
-	ESTIMATOR_POS_VERT_AGL       = 64  // True if the vertical position (above ground) estimate is good
-	ESTIMATOR_CONST_POS_MODE     = 128 // True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)
-	ESTIMATOR_PRED_POS_HORIZ_REL = 8   // True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate
-	ESTIMATOR_PRED_POS_HORIZ_ABS = 9   // True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate
-	ESTIMATOR_GPS_GLITCH         = 10  // True if the EKF has detected a GPS glitch
+	ESTIMATOR_ATTITUDE           = 1    // True if the attitude estimate is good
+	ESTIMATOR_VELOCITY_HORIZ     = 2    // True if the horizontal velocity estimate is good
+	ESTIMATOR_VELOCITY_VERT      = 4    // True if the  vertical velocity estimate is good
+	ESTIMATOR_POS_HORIZ_REL      = 8    // True if the horizontal position (relative) estimate is good
+	ESTIMATOR_POS_HORIZ_ABS      = 16   // True if the horizontal position (absolute) estimate is good
+	ESTIMATOR_POS_VERT_ABS       = 32   // True if the vertical position (absolute) estimate is good
+	ESTIMATOR_POS_VERT_AGL       = 64   // True if the vertical position (above ground) estimate is good
+	ESTIMATOR_CONST_POS_MODE     = 128  // True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)
+	ESTIMATOR_PRED_POS_HORIZ_REL = 256  // True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate
+	ESTIMATOR_PRED_POS_HORIZ_ABS = 512  // True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate
+	ESTIMATOR_GPS_GLITCH         = 1024 // True if the EKF has detected a GPS glitch
 )
 
+var estimatorStatusFlagsEntries = map[EstimatorStatusFlags]enumEntry{
+	ESTIMATOR_ATTITUDE:           {"ESTIMATOR_ATTITUDE", "True if the attitude estimate is good"},
+	ESTIMATOR_VELOCITY_HORIZ:     {"ESTIMATOR_VELOCITY_HORIZ", "True if the horizontal velocity estimate is good"},
+	ESTIMATOR_VELOCITY_VERT:      {"ESTIMATOR_VELOCITY_VERT", "True if the  vertical velocity estimate is good"},
+	ESTIMATOR_POS_HORIZ_REL:      {"ESTIMATOR_POS_HORIZ_REL", "True if the horizontal position (relative) estimate is good"},
+	ESTIMATOR_POS_HORIZ_ABS:      {"ESTIMATOR_POS_HORIZ_ABS", "True if the horizontal position (absolute) estimate is good"},
+	ESTIMATOR_POS_VERT_ABS:       {"ESTIMATOR_POS_VERT_ABS", "True if the vertical position (absolute) estimate is good"},
+	ESTIMATOR_POS_VERT_AGL:       {"ESTIMATOR_POS_VERT_AGL", "True if the vertical position (above ground) estimate is good"},
+	ESTIMATOR_CONST_POS_MODE:     {"ESTIMATOR_CONST_POS_MODE", "True if the EKF is in a constant position mode and is not using external measurements (eg GPS or optical flow)"},
+	ESTIMATOR_PRED_POS_HORIZ_REL: {"ESTIMATOR_PRED_POS_HORIZ_REL", "True if the EKF has sufficient data to enter a mode that will provide a (relative) position estimate"},
+	ESTIMATOR_PRED_POS_HORIZ_ABS: {"ESTIMATOR_PRED_POS_HORIZ_ABS", "True if the EKF has sufficient data to enter a mode that will provide a (absolute) position estimate"},
+	ESTIMATOR_GPS_GLITCH:         {"ESTIMATOR_GPS_GLITCH", "True if the EKF has detected a GPS glitch"},
+}
+
+func (e EstimatorStatusFlags) String() string {
+	if entry, ok := estimatorStatusFlagsEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("EstimatorStatusFlags(%d)", uint32(e))
+}
+
+func (e EstimatorStatusFlags) Description() string {
+	return estimatorStatusFlagsEntries[e].description
+}
+
+// Valid reports whether e is a ESTIMATOR_STATUS_FLAGS entry
+func (e EstimatorStatusFlags) Valid() bool {
+	_, ok := estimatorStatusFlagsEntries[e]
+	return ok
+}
+
+// ParseEstimatorStatusFlags returns the ESTIMATOR_STATUS_FLAGS entry called name
+func ParseEstimatorStatusFlags(name string) (EstimatorStatusFlags, error) {
+	for e, entry := range estimatorStatusFlagsEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown ESTIMATOR_STATUS_FLAGS %q", name)
+}
+
 // MotorTestThrottleType:
+type MotorTestThrottleType uint32
+
 const (
 	MOTOR_TEST_THROTTLE_PERCENT = 0 // throttle as a percentage from 0 ~ 100
 	MOTOR_TEST_THROTTLE_PWM     = 1 // throttle as an absolute PWM value (normally in range of 1000~2000)
 	MOTOR_TEST_THROTTLE_PILOT   = 2 // throttle pass-through from pilot's transmitter
 )
 
+var motorTestThrottleTypeEntries = map[MotorTestThrottleType]enumEntry{
+	MOTOR_TEST_THROTTLE_PERCENT: {"MOTOR_TEST_THROTTLE_PERCENT", "throttle as a percentage from 0 ~ 100"},
+	MOTOR_TEST_THROTTLE_PWM:     {"MOTOR_TEST_THROTTLE_PWM", "throttle as an absolute PWM value (normally in range of 1000~2000)"},
+	MOTOR_TEST_THROTTLE_PILOT:   {"MOTOR_TEST_THROTTLE_PILOT", "throttle pass-through from pilot's transmitter"},
+}
+
+func (e MotorTestThrottleType) String() string {
+	if entry, ok := motorTestThrottleTypeEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MotorTestThrottleType(%d)", uint32(e))
+}
+
+func (e MotorTestThrottleType) Description() string {
+	return motorTestThrottleTypeEntries[e].description
+}
+
+// Valid reports whether e is a MOTOR_TEST_THROTTLE_TYPE entry
+func (e MotorTestThrottleType) Valid() bool {
+	_, ok := motorTestThrottleTypeEntries[e]
+	return ok
+}
+
+// ParseMotorTestThrottleType returns the MOTOR_TEST_THROTTLE_TYPE entry called name
+func ParseMotorTestThrottleType(name string) (MotorTestThrottleType, error) {
+	for e, entry := range motorTestThrottleTypeEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MOTOR_TEST_THROTTLE_TYPE %q", name)
+}
+
 // GpsInputIgnoreFlags:
+type GpsInputIgnoreFlags uint32
+
 const (
 	GPS_INPUT_IGNORE_FLAG_ALT                 = 1   // ignore altitude field
 	GPS_INPUT_IGNORE_FLAG_HDOP                = 2   // ignore hdop field
@@ -676,7 +2492,47 @@ const (
 	GPS_INPUT_IGNORE_FLAG_VERTICAL_ACCURACY   = 128 // ignore vertical accuracy field
 )
 
+var gpsInputIgnoreFlagsEntries = map[GpsInputIgnoreFlags]enumEntry{
+	GPS_INPUT_IGNORE_FLAG_ALT:                 {"GPS_INPUT_IGNORE_FLAG_ALT", "ignore altitude field"},
+	GPS_INPUT_IGNORE_FLAG_HDOP:                {"GPS_INPUT_IGNORE_FLAG_HDOP", "ignore hdop field"},
+	GPS_INPUT_IGNORE_FLAG_VDOP:                {"GPS_INPUT_IGNORE_FLAG_VDOP", "ignore vdop field"},
+	GPS_INPUT_IGNORE_FLAG_VEL_HORIZ:           {"GPS_INPUT_IGNORE_FLAG_VEL_HORIZ", "ignore horizontal velocity field (vn and ve)"},
+	GPS_INPUT_IGNORE_FLAG_VEL_VERT:            {"GPS_INPUT_IGNORE_FLAG_VEL_VERT", "ignore vertical velocity field (vd)"},
+	GPS_INPUT_IGNORE_FLAG_SPEED_ACCURACY:      {"GPS_INPUT_IGNORE_FLAG_SPEED_ACCURACY", "ignore speed accuracy field"},
+	GPS_INPUT_IGNORE_FLAG_HORIZONTAL_ACCURACY: {"GPS_INPUT_IGNORE_FLAG_HORIZONTAL_ACCURACY", "ignore horizontal accuracy field"},
+	GPS_INPUT_IGNORE_FLAG_VERTICAL_ACCURACY:   {"GPS_INPUT_IGNORE_FLAG_VERTICAL_ACCURACY", "ignore vertical accuracy field"},
+}
+
+func (e GpsInputIgnoreFlags) String() string {
+	if entry, ok := gpsInputIgnoreFlagsEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("GpsInputIgnoreFlags(%d)", uint32(e))
+}
+
+func (e GpsInputIgnoreFlags) Description() string {
+	return gpsInputIgnoreFlagsEntries[e].description
+}
+
+// Valid reports whether e is a GPS_INPUT_IGNORE_FLAGS entry
+func (e GpsInputIgnoreFlags) Valid() bool {
+	_, ok := gpsInputIgnoreFlagsEntries[e]
+	return ok
+}
+
+// ParseGpsInputIgnoreFlags returns the GPS_INPUT_IGNORE_FLAGS entry called name
+func ParseGpsInputIgnoreFlags(name string) (GpsInputIgnoreFlags, error) {
+	for e, entry := range gpsInputIgnoreFlagsEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown GPS_INPUT_IGNORE_FLAGS %q", name)
+}
+
 // MavCollisionAction: Possible actions an aircraft can take to avoid a collision.
+type MavCollisionAction uint32
+
 const (
 	MAV_COLLISION_ACTION_NONE               = 0 // Ignore any potential collisions
 	MAV_COLLISION_ACTION_REPORT             = 1 // Report potential collision
@@ -687,30 +2543,136 @@ const (
 	MAV_COLLISION_ACTION_HOVER              = 6 // Aircraft to stop in place
 )
 
+var mavCollisionActionEntries = map[MavCollisionAction]enumEntry{
+	MAV_COLLISION_ACTION_NONE:               {"MAV_COLLISION_ACTION_NONE", "Ignore any potential collisions"},
+	MAV_COLLISION_ACTION_REPORT:             {"MAV_COLLISION_ACTION_REPORT", "Report potential collision"},
+	MAV_COLLISION_ACTION_ASCEND_OR_DESCEND:  {"MAV_COLLISION_ACTION_ASCEND_OR_DESCEND", "Ascend or Descend to avoid thread"},
+	MAV_COLLISION_ACTION_MOVE_HORIZONTALLY:  {"MAV_COLLISION_ACTION_MOVE_HORIZONTALLY", "Ascend or Descend to avoid thread"},
+	MAV_COLLISION_ACTION_MOVE_PERPENDICULAR: {"MAV_COLLISION_ACTION_MOVE_PERPENDICULAR", "Aircraft to move perpendicular to the collision's velocity vector"},
+	MAV_COLLISION_ACTION_RTL:                {"MAV_COLLISION_ACTION_RTL", "Aircraft to fly directly back to its launch point"},
+	MAV_COLLISION_ACTION_HOVER:              {"MAV_COLLISION_ACTION_HOVER", "Aircraft to stop in place"},
+}
+
+func (e MavCollisionAction) String() string {
+	if entry, ok := mavCollisionActionEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavCollisionAction(%d)", uint32(e))
+}
+
+func (e MavCollisionAction) Description() string {
+	return mavCollisionActionEntries[e].description
+}
+
+// Valid reports whether e is a MAV_COLLISION_ACTION entry
+func (e MavCollisionAction) Valid() bool {
+	_, ok := mavCollisionActionEntries[e]
+	return ok
+}
+
+// ParseMavCollisionAction returns the MAV_COLLISION_ACTION entry called name
+func ParseMavCollisionAction(name string) (MavCollisionAction, error) {
+	for e, entry := range mavCollisionActionEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_COLLISION_ACTION %q", name)
+}
+
 // MavCollisionThreatLevel: Aircraft-rated danger from this threat.
+type MavCollisionThreatLevel uint32
+
 const (
 	MAV_COLLISION_THREAT_LEVEL_NONE = 0 // Not a threat
 	MAV_COLLISION_THREAT_LEVEL_LOW  = 1 // Craft is mildly concerned about this threat
 	MAV_COLLISION_THREAT_LEVEL_HIGH = 2 // Craft is panicing, and may take actions to avoid threat
 )
 
+var mavCollisionThreatLevelEntries = map[MavCollisionThreatLevel]enumEntry{
+	MAV_COLLISION_THREAT_LEVEL_NONE: {"MAV_COLLISION_THREAT_LEVEL_NONE", "Not a threat"},
+	MAV_COLLISION_THREAT_LEVEL_LOW:  {"MAV_COLLISION_THREAT_LEVEL_LOW", "Craft is mildly concerned about this threat"},
+	MAV_COLLISION_THREAT_LEVEL_HIGH: {"MAV_COLLISION_THREAT_LEVEL_HIGH", "Craft is panicing, and may take actions to avoid threat"},
+}
+
+func (e MavCollisionThreatLevel) String() string {
+	if entry, ok := mavCollisionThreatLevelEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavCollisionThreatLevel(%d)", uint32(e))
+}
+
+func (e MavCollisionThreatLevel) Description() string {
+	return mavCollisionThreatLevelEntries[e].description
+}
+
+// Valid reports whether e is a MAV_COLLISION_THREAT_LEVEL entry
+func (e MavCollisionThreatLevel) Valid() bool {
+	_, ok := mavCollisionThreatLevelEntries[e]
+	return ok
+}
+
+// ParseMavCollisionThreatLevel returns the MAV_COLLISION_THREAT_LEVEL entry called name
+func ParseMavCollisionThreatLevel(name string) (MavCollisionThreatLevel, error) {
+	for e, entry := range mavCollisionThreatLevelEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_COLLISION_THREAT_LEVEL %q", name)
+}
+
 // MavCollisionSrc: Source of information about this collision.
+type MavCollisionSrc uint32
+
 const (
 	MAV_COLLISION_SRC_ADSB                   = 0 // ID field references ADSB_VEHICLE packets
 	MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT = 1 // ID field references MAVLink SRC ID
 )
 
+var mavCollisionSrcEntries = map[MavCollisionSrc]enumEntry{
+	MAV_COLLISION_SRC_ADSB:                   {"MAV_COLLISION_SRC_ADSB", "ID field references ADSB_VEHICLE packets"},
+	MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT: {"MAV_COLLISION_SRC_MAVLINK_GPS_GLOBAL_INT", "ID field references MAVLink SRC ID"},
+}
+
+func (e MavCollisionSrc) String() string {
+	if entry, ok := mavCollisionSrcEntries[e]; ok {
+		return entry.name
+	}
+	return fmt.Sprintf("MavCollisionSrc(%d)", uint32(e))
+}
+
+func (e MavCollisionSrc) Description() string {
+	return mavCollisionSrcEntries[e].description
+}
+
+// Valid reports whether e is a MAV_COLLISION_SRC entry
+func (e MavCollisionSrc) Valid() bool {
+	_, ok := mavCollisionSrcEntries[e]
+	return ok
+}
+
+// ParseMavCollisionSrc returns the MAV_COLLISION_SRC entry called name
+func ParseMavCollisionSrc(name string) (MavCollisionSrc, error) {
+	for e, entry := range mavCollisionSrcEntries {
+		if entry.name == name {
+			return e, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown MAV_COLLISION_SRC %q", name)
+}
+
 // The heartbeat message shows that a system is present and responding. The type of the MAV and Autopilot hardware allow the receiving system to treat further messages from this system appropriate (e.g. by laying out the user interface based on the autopilot).
 type Heartbeat struct {
-	CustomMode     uint32 // A bitfield for use for autopilot-specific flags.
-	Type           uint8  // Type of the MAV (quadrotor, helicopter, etc., up to 15 types, defined in MAV_TYPE ENUM)
-	Autopilot      uint8  // Autopilot type / class. defined in MAV_AUTOPILOT ENUM
-	BaseMode       uint8  // System mode bitfield, see MAV_MODE_FLAG ENUM in mavlink/include/mavlink_types.h
-	SystemStatus   uint8  // System status flag, see MAV_STATE ENUM
-	MavlinkVersion uint8  // MAVLink version, not writable by user, gets added by protocol because of magic data type: uint8_t_mavlink_version
+	CustomMode     uint32 `mavlink:"custom_mode"`     // A bitfield for use for autopilot-specific flags.
+	Type           uint8  `mavlink:"type"`            // Type of the MAV (quadrotor, helicopter, etc., up to 15 types, defined in MAV_TYPE ENUM)
+	Autopilot      uint8  `mavlink:"autopilot"`       // Autopilot type / class. defined in MAV_AUTOPILOT ENUM
+	BaseMode       uint8  `mavlink:"base_mode"`       // System mode bitfield, see MAV_MODE_FLAG ENUM in mavlink/include/mavlink_types.h
+	SystemStatus   uint8  `mavlink:"system_status"`   // System status flag, see MAV_STATE ENUM
+	MavlinkVersion uint8  `mavlink:"mavlink_version"` // MAVLink version, not writable by user, gets added by protocol because of magic data type: uint8_t_mavlink_version
 }
 
-func (self *Heartbeat) MsgID() uint8 {
+func (self *Heartbeat) MsgID() uint32 {
 	return 0
 }
 
@@ -736,33 +2698,42 @@ func (self *Heartbeat) Unpack(p *Packet) error {
 	if len(p.Payload) < 9 {
 		return fmt.Errorf("payload too small")
 	}
-	self.CustomMode = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Type = uint8(p.Payload[4])
-	self.Autopilot = uint8(p.Payload[5])
-	self.BaseMode = uint8(p.Payload[6])
-	self.SystemStatus = uint8(p.Payload[7])
-	self.MavlinkVersion = uint8(p.Payload[8])
+	payload := p.Payload
+	self.CustomMode = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Type = uint8(payload[4])
+	self.Autopilot = uint8(payload[5])
+	self.BaseMode = uint8(payload[6])
+	self.SystemStatus = uint8(payload[7])
+	self.MavlinkVersion = uint8(payload[8])
 	return nil
 }
 
+func (self *Heartbeat) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HEARTBEAT", self)
+}
+
+func (self *Heartbeat) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HEARTBEAT", self, data)
+}
+
 // The general system state. If the system is following the MAVLink standard, the system state is mainly defined by three orthogonal states/modes: The system mode, which is either LOCKED (motors shut down and locked), MANUAL (system under RC control), GUIDED (system with autonomous position control, position setpoint controlled manually) or AUTO (system guided by path/waypoint planner). The NAV_MODE defined the current flight state: LIFTOFF (often an open-loop maneuver), LANDING, WAYPOINTS or VECTOR. This represents the internal navigation state machine. The system status shows wether the system is currently active or not and if an emergency occured. During the CRITICAL and EMERGENCY states the MAV is still considered to be active, but should start emergency procedures autonomously. After a failure occured it should first move from active to critical to allow manual intervention and then move to emergency after a certain timeout.
 type SysStatus struct {
-	OnboardControlSensorsPresent uint32 // Bitmask showing which onboard controllers and sensors are present. Value of 0: not present. Value of 1: present. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
-	OnboardControlSensorsEnabled uint32 // Bitmask showing which onboard controllers and sensors are enabled:  Value of 0: not enabled. Value of 1: enabled. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
-	OnboardControlSensorsHealth  uint32 // Bitmask showing which onboard controllers and sensors are operational or have an error:  Value of 0: not enabled. Value of 1: enabled. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
-	Load                         uint16 // Maximum usage in percent of the mainloop time, (0%: 0, 100%: 1000) should be always below 1000
-	VoltageBattery               uint16 // Battery voltage, in millivolts (1 = 1 millivolt)
-	CurrentBattery               int16  // Battery current, in 10*milliamperes (1 = 10 milliampere), -1: autopilot does not measure the current
-	DropRateComm                 uint16 // Communication drops in percent, (0%: 0, 100%: 10'000), (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
-	ErrorsComm                   uint16 // Communication errors (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
-	ErrorsCount1                 uint16 // Autopilot-specific errors
-	ErrorsCount2                 uint16 // Autopilot-specific errors
-	ErrorsCount3                 uint16 // Autopilot-specific errors
-	ErrorsCount4                 uint16 // Autopilot-specific errors
-	BatteryRemaining             int8   // Remaining battery energy: (0%: 0, 100%: 100), -1: autopilot estimate the remaining battery
-}
-
-func (self *SysStatus) MsgID() uint8 {
+	OnboardControlSensorsPresent uint32 `mavlink:"onboard_control_sensors_present"` // Bitmask showing which onboard controllers and sensors are present. Value of 0: not present. Value of 1: present. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
+	OnboardControlSensorsEnabled uint32 `mavlink:"onboard_control_sensors_enabled"` // Bitmask showing which onboard controllers and sensors are enabled:  Value of 0: not enabled. Value of 1: enabled. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
+	OnboardControlSensorsHealth  uint32 `mavlink:"onboard_control_sensors_health"`  // Bitmask showing which onboard controllers and sensors are operational or have an error:  Value of 0: not enabled. Value of 1: enabled. Indices defined by ENUM MAV_SYS_STATUS_SENSOR
+	Load                         uint16 `mavlink:"load"`                            // Maximum usage in percent of the mainloop time, (0%: 0, 100%: 1000) should be always below 1000
+	VoltageBattery               uint16 `mavlink:"voltage_battery"`                 // Battery voltage, in millivolts (1 = 1 millivolt)
+	CurrentBattery               int16  `mavlink:"current_battery"`                 // Battery current, in 10*milliamperes (1 = 10 milliampere), -1: autopilot does not measure the current
+	DropRateComm                 uint16 `mavlink:"drop_rate_comm"`                  // Communication drops in percent, (0%: 0, 100%: 10'000), (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
+	ErrorsComm                   uint16 `mavlink:"errors_comm"`                     // Communication errors (UART, I2C, SPI, CAN), dropped packets on all links (packets that were corrupted on reception on the MAV)
+	ErrorsCount1                 uint16 `mavlink:"errors_count1"`                   // Autopilot-specific errors
+	ErrorsCount2                 uint16 `mavlink:"errors_count2"`                   // Autopilot-specific errors
+	ErrorsCount3                 uint16 `mavlink:"errors_count3"`                   // Autopilot-specific errors
+	ErrorsCount4                 uint16 `mavlink:"errors_count4"`                   // Autopilot-specific errors
+	BatteryRemaining             int8   `mavlink:"battery_remaining"`               // Remaining battery energy: (0%: 0, 100%: 100), -1: autopilot estimate the remaining battery
+}
+
+func (self *SysStatus) MsgID() uint32 {
 	return 1
 }
 
@@ -795,29 +2766,38 @@ func (self *SysStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 31 {
 		return fmt.Errorf("payload too small")
 	}
-	self.OnboardControlSensorsPresent = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.OnboardControlSensorsEnabled = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.OnboardControlSensorsHealth = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Load = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.VoltageBattery = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.CurrentBattery = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.DropRateComm = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.ErrorsComm = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.ErrorsCount1 = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.ErrorsCount2 = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.ErrorsCount3 = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.ErrorsCount4 = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.BatteryRemaining = int8(p.Payload[30])
+	payload := p.Payload
+	self.OnboardControlSensorsPresent = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.OnboardControlSensorsEnabled = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.OnboardControlSensorsHealth = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Load = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.VoltageBattery = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.CurrentBattery = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.DropRateComm = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.ErrorsComm = uint16(binary.LittleEndian.Uint16(payload[20:]))
+	self.ErrorsCount1 = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.ErrorsCount2 = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.ErrorsCount3 = uint16(binary.LittleEndian.Uint16(payload[26:]))
+	self.ErrorsCount4 = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.BatteryRemaining = int8(payload[30])
 	return nil
 }
 
+func (self *SysStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SYS_STATUS", self)
+}
+
+func (self *SysStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SYS_STATUS", self, data)
+}
+
 // The system time is the time of the master clock, typically the computer clock of the main onboard computer.
 type SystemTime struct {
-	TimeUnixUsec uint64 // Timestamp of the master clock in microseconds since UNIX epoch.
-	TimeBootMs   uint32 // Timestamp of the component clock since boot time in milliseconds.
+	TimeUnixUsec uint64 `mavlink:"time_unix_usec"` // Timestamp of the master clock in microseconds since UNIX epoch.
+	TimeBootMs   uint32 `mavlink:"time_boot_ms"`   // Timestamp of the component clock since boot time in milliseconds.
 }
 
-func (self *SystemTime) MsgID() uint8 {
+func (self *SystemTime) MsgID() uint32 {
 	return 2
 }
 
@@ -839,20 +2819,29 @@ func (self *SystemTime) Unpack(p *Packet) error {
 	if len(p.Payload) < 12 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUnixUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
+	payload := p.Payload
+	self.TimeUnixUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[8:]))
 	return nil
 }
 
+func (self *SystemTime) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SYSTEM_TIME", self)
+}
+
+func (self *SystemTime) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SYSTEM_TIME", self, data)
+}
+
 // A ping message either requesting or responding to a ping. This allows to measure the system latencies, including serial port, radio modem and UDP connections.
 type Ping struct {
-	TimeUsec        uint64 // Unix timestamp in microseconds or since system boot if smaller than MAVLink epoch (1.1.2009)
-	Seq             uint32 // PING sequence
-	TargetSystem    uint8  // 0: request ping from all receiving systems, if greater than 0: message is a ping response and number is the system id of the requesting system
-	TargetComponent uint8  // 0: request ping from all receiving components, if greater than 0: message is a ping response and number is the system id of the requesting system
+	TimeUsec        uint64 `mavlink:"time_usec"`        // Unix timestamp in microseconds or since system boot if smaller than MAVLink epoch (1.1.2009)
+	Seq             uint32 `mavlink:"seq"`              // PING sequence
+	TargetSystem    uint8  `mavlink:"target_system"`    // 0: request ping from all receiving systems, if greater than 0: message is a ping response and number is the system id of the requesting system
+	TargetComponent uint8  `mavlink:"target_component"` // 0: request ping from all receiving components, if greater than 0: message is a ping response and number is the system id of the requesting system
 }
 
-func (self *Ping) MsgID() uint8 {
+func (self *Ping) MsgID() uint32 {
 	return 4
 }
 
@@ -876,22 +2865,31 @@ func (self *Ping) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Seq = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.TargetSystem = uint8(p.Payload[12])
-	self.TargetComponent = uint8(p.Payload[13])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Seq = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.TargetSystem = uint8(payload[12])
+	self.TargetComponent = uint8(payload[13])
 	return nil
 }
 
+func (self *Ping) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PING", self)
+}
+
+func (self *Ping) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PING", self, data)
+}
+
 // Request to control this MAV
 type ChangeOperatorControl struct {
-	TargetSystem   uint8    // System the GCS requests control for
-	ControlRequest uint8    // 0: request control of this MAV, 1: Release control of this MAV
-	Version        uint8    // 0: key as plaintext, 1-255: future, different hashing/encryption variants. The GCS should in general use the safest mode possible initially and then gradually move down the encryption level if it gets a NACK message indicating an encryption mismatch.
-	Passkey        [25]byte // Password / Key, depending on version plaintext or encrypted. 25 or less characters, NULL terminated. The characters may involve A-Z, a-z, 0-9, and "!?,.-"
+	TargetSystem   uint8    `mavlink:"target_system"`   // System the GCS requests control for
+	ControlRequest uint8    `mavlink:"control_request"` // 0: request control of this MAV, 1: Release control of this MAV
+	Version        uint8    `mavlink:"version"`         // 0: key as plaintext, 1-255: future, different hashing/encryption variants. The GCS should in general use the safest mode possible initially and then gradually move down the encryption level if it gets a NACK message indicating an encryption mismatch.
+	Passkey        [25]byte `mavlink:"passkey,char"`    // Password / Key, depending on version plaintext or encrypted. 25 or less characters, NULL terminated. The characters may involve A-Z, a-z, 0-9, and "!?,.-"
 }
 
-func (self *ChangeOperatorControl) MsgID() uint8 {
+func (self *ChangeOperatorControl) MsgID() uint32 {
 	return 5
 }
 
@@ -915,21 +2913,30 @@ func (self *ChangeOperatorControl) Unpack(p *Packet) error {
 	if len(p.Payload) < 28 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.ControlRequest = uint8(p.Payload[1])
-	self.Version = uint8(p.Payload[2])
-	copy(self.Passkey[:], p.Payload[3:28])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.ControlRequest = uint8(payload[1])
+	self.Version = uint8(payload[2])
+	copy(self.Passkey[:], payload[3:28])
 	return nil
 }
 
+func (self *ChangeOperatorControl) MarshalJSON() ([]byte, error) {
+	return marshalMessage("CHANGE_OPERATOR_CONTROL", self)
+}
+
+func (self *ChangeOperatorControl) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("CHANGE_OPERATOR_CONTROL", self, data)
+}
+
 // Accept / deny control of this MAV
 type ChangeOperatorControlAck struct {
-	GcsSystemId    uint8 // ID of the GCS this message
-	ControlRequest uint8 // 0: request control of this MAV, 1: Release control of this MAV
-	Ack            uint8 // 0: ACK, 1: NACK: Wrong passkey, 2: NACK: Unsupported passkey encryption method, 3: NACK: Already under control
+	GcsSystemId    uint8 `mavlink:"gcs_system_id"`   // ID of the GCS this message
+	ControlRequest uint8 `mavlink:"control_request"` // 0: request control of this MAV, 1: Release control of this MAV
+	Ack            uint8 `mavlink:"ack"`             // 0: ACK, 1: NACK: Wrong passkey, 2: NACK: Unsupported passkey encryption method, 3: NACK: Already under control
 }
 
-func (self *ChangeOperatorControlAck) MsgID() uint8 {
+func (self *ChangeOperatorControlAck) MsgID() uint32 {
 	return 6
 }
 
@@ -952,18 +2959,27 @@ func (self *ChangeOperatorControlAck) Unpack(p *Packet) error {
 	if len(p.Payload) < 3 {
 		return fmt.Errorf("payload too small")
 	}
-	self.GcsSystemId = uint8(p.Payload[0])
-	self.ControlRequest = uint8(p.Payload[1])
-	self.Ack = uint8(p.Payload[2])
+	payload := p.Payload
+	self.GcsSystemId = uint8(payload[0])
+	self.ControlRequest = uint8(payload[1])
+	self.Ack = uint8(payload[2])
 	return nil
 }
 
+func (self *ChangeOperatorControlAck) MarshalJSON() ([]byte, error) {
+	return marshalMessage("CHANGE_OPERATOR_CONTROL_ACK", self)
+}
+
+func (self *ChangeOperatorControlAck) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("CHANGE_OPERATOR_CONTROL_ACK", self, data)
+}
+
 // Emit an encrypted signature / key identifying this system. PLEASE NOTE: This protocol has been kept simple, so transmitting the key requires an encrypted channel for true safety.
 type AuthKey struct {
-	Key [32]byte // key
+	Key [32]byte `mavlink:"key,char"` // key
 }
 
-func (self *AuthKey) MsgID() uint8 {
+func (self *AuthKey) MsgID() uint32 {
 	return 7
 }
 
@@ -984,18 +3000,27 @@ func (self *AuthKey) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	copy(self.Key[:], p.Payload[0:32])
+	payload := p.Payload
+	copy(self.Key[:], payload[0:32])
 	return nil
 }
 
+func (self *AuthKey) MarshalJSON() ([]byte, error) {
+	return marshalMessage("AUTH_KEY", self)
+}
+
+func (self *AuthKey) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("AUTH_KEY", self, data)
+}
+
 // THIS INTERFACE IS DEPRECATED. USE COMMAND_LONG with MAV_CMD_DO_SET_MODE INSTEAD. Set the system mode, as defined by enum MAV_MODE. There is no target component id as the mode is by definition for the overall aircraft, not only for one component.
 type SetMode struct {
-	CustomMode   uint32 // The new autopilot-specific mode. This field can be ignored by an autopilot.
-	TargetSystem uint8  // The system setting the mode
-	BaseMode     uint8  // The new base mode
+	CustomMode   uint32 `mavlink:"custom_mode"`   // The new autopilot-specific mode. This field can be ignored by an autopilot.
+	TargetSystem uint8  `mavlink:"target_system"` // The system setting the mode
+	BaseMode     uint8  `mavlink:"base_mode"`     // The new base mode
 }
 
-func (self *SetMode) MsgID() uint8 {
+func (self *SetMode) MsgID() uint32 {
 	return 11
 }
 
@@ -1018,21 +3043,30 @@ func (self *SetMode) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.CustomMode = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[4])
-	self.BaseMode = uint8(p.Payload[5])
+	payload := p.Payload
+	self.CustomMode = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.TargetSystem = uint8(payload[4])
+	self.BaseMode = uint8(payload[5])
 	return nil
 }
 
+func (self *SetMode) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_MODE", self)
+}
+
+func (self *SetMode) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_MODE", self, data)
+}
+
 // Request to read the onboard parameter with the param_id string id. Onboard parameters are stored as key[const char*] -> value[float]. This allows to send a parameter to any other component (such as the GCS) without the need of previous knowledge of possible parameter names. Thus the same GCS can store different parameters for different autopilots. See also http://qgroundcontrol.org/parameter_interface for a full documentation of QGroundControl and IMU code.
 type ParamRequestRead struct {
-	ParamIndex      int16    // Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored)
-	TargetSystem    uint8    // System ID
-	TargetComponent uint8    // Component ID
-	ParamId         [16]byte // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
+	ParamIndex      int16    `mavlink:"param_index"`      // Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored)
+	TargetSystem    uint8    `mavlink:"target_system"`    // System ID
+	TargetComponent uint8    `mavlink:"target_component"` // Component ID
+	ParamId         [16]byte `mavlink:"param_id,char"`    // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
 }
 
-func (self *ParamRequestRead) MsgID() uint8 {
+func (self *ParamRequestRead) MsgID() uint32 {
 	return 20
 }
 
@@ -1056,20 +3090,29 @@ func (self *ParamRequestRead) Unpack(p *Packet) error {
 	if len(p.Payload) < 20 {
 		return fmt.Errorf("payload too small")
 	}
-	self.ParamIndex = int16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
-	copy(self.ParamId[:], p.Payload[4:20])
+	payload := p.Payload
+	self.ParamIndex = int16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
+	copy(self.ParamId[:], payload[4:20])
 	return nil
 }
 
+func (self *ParamRequestRead) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PARAM_REQUEST_READ", self)
+}
+
+func (self *ParamRequestRead) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PARAM_REQUEST_READ", self, data)
+}
+
 // Request all parameters of this component. After this request, all parameters are emitted.
 type ParamRequestList struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
 }
 
-func (self *ParamRequestList) MsgID() uint8 {
+func (self *ParamRequestList) MsgID() uint32 {
 	return 21
 }
 
@@ -1091,21 +3134,30 @@ func (self *ParamRequestList) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
 	return nil
 }
 
+func (self *ParamRequestList) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PARAM_REQUEST_LIST", self)
+}
+
+func (self *ParamRequestList) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PARAM_REQUEST_LIST", self, data)
+}
+
 // Emit the value of a onboard parameter. The inclusion of param_count and param_index in the message allows the recipient to keep track of received parameters and allows him to re-request missing parameters after a loss or timeout.
 type ParamValue struct {
-	ParamValue float32  // Onboard parameter value
-	ParamCount uint16   // Total number of onboard parameters
-	ParamIndex uint16   // Index of this onboard parameter
-	ParamId    [16]byte // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
-	ParamType  uint8    // Onboard parameter type: see the MAV_PARAM_TYPE enum for supported data types.
+	ParamValue float32  `mavlink:"param_value"`   // Onboard parameter value
+	ParamCount uint16   `mavlink:"param_count"`   // Total number of onboard parameters
+	ParamIndex uint16   `mavlink:"param_index"`   // Index of this onboard parameter
+	ParamId    [16]byte `mavlink:"param_id,char"` // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
+	ParamType  uint8    `mavlink:"param_type"`    // Onboard parameter type: see the MAV_PARAM_TYPE enum for supported data types.
 }
 
-func (self *ParamValue) MsgID() uint8 {
+func (self *ParamValue) MsgID() uint32 {
 	return 22
 }
 
@@ -1130,24 +3182,33 @@ func (self *ParamValue) Unpack(p *Packet) error {
 	if len(p.Payload) < 25 {
 		return fmt.Errorf("payload too small")
 	}
-	self.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.ParamCount = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.ParamIndex = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	copy(self.ParamId[:], p.Payload[8:24])
-	self.ParamType = uint8(p.Payload[24])
+	payload := p.Payload
+	self.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.ParamCount = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.ParamIndex = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	copy(self.ParamId[:], payload[8:24])
+	self.ParamType = uint8(payload[24])
 	return nil
 }
 
+func (self *ParamValue) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PARAM_VALUE", self)
+}
+
+func (self *ParamValue) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PARAM_VALUE", self, data)
+}
+
 // Set a parameter value TEMPORARILY to RAM. It will be reset to default on system reboot. Send the ACTION MAV_ACTION_STORAGE_WRITE to PERMANENTLY write the RAM contents to EEPROM. IMPORTANT: The receiving component should acknowledge the new parameter value by sending a param_value message to all communication partners. This will also ensure that multiple GCS all have an up-to-date list of all parameters. If the sending GCS did not receive a PARAM_VALUE message within its timeout time, it should re-send the PARAM_SET message.
 type ParamSet struct {
-	ParamValue      float32  // Onboard parameter value
-	TargetSystem    uint8    // System ID
-	TargetComponent uint8    // Component ID
-	ParamId         [16]byte // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
-	ParamType       uint8    // Onboard parameter type: see the MAV_PARAM_TYPE enum for supported data types.
+	ParamValue      float32  `mavlink:"param_value"`      // Onboard parameter value
+	TargetSystem    uint8    `mavlink:"target_system"`    // System ID
+	TargetComponent uint8    `mavlink:"target_component"` // Component ID
+	ParamId         [16]byte `mavlink:"param_id,char"`    // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
+	ParamType       uint8    `mavlink:"param_type"`       // Onboard parameter type: see the MAV_PARAM_TYPE enum for supported data types.
 }
 
-func (self *ParamSet) MsgID() uint8 {
+func (self *ParamSet) MsgID() uint32 {
 	return 23
 }
 
@@ -1172,30 +3233,40 @@ func (self *ParamSet) Unpack(p *Packet) error {
 	if len(p.Payload) < 23 {
 		return fmt.Errorf("payload too small")
 	}
-	self.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[4])
-	self.TargetComponent = uint8(p.Payload[5])
-	copy(self.ParamId[:], p.Payload[6:22])
-	self.ParamType = uint8(p.Payload[22])
+	payload := p.Payload
+	self.ParamValue = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.TargetSystem = uint8(payload[4])
+	self.TargetComponent = uint8(payload[5])
+	copy(self.ParamId[:], payload[6:22])
+	self.ParamType = uint8(payload[22])
 	return nil
 }
 
+func (self *ParamSet) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PARAM_SET", self)
+}
+
+func (self *ParamSet) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PARAM_SET", self, data)
+}
+
 // The global position, as returned by the Global Positioning System (GPS). This is
-//                 NOT the global position estimate of the system, but rather a RAW sensor value. See message GLOBAL_POSITION for the global position estimate. Coordinate frame is right-handed, Z-axis up (GPS frame).
+//
+//	NOT the global position estimate of the system, but rather a RAW sensor value. See message GLOBAL_POSITION for the global position estimate. Coordinate frame is right-handed, Z-axis up (GPS frame).
 type GpsRawInt struct {
-	TimeUsec          uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Lat               int32  // Latitude (WGS84), in degrees * 1E7
-	Lon               int32  // Longitude (WGS84), in degrees * 1E7
-	Alt               int32  // Altitude (AMSL, NOT WGS84), in meters * 1000 (positive for up). Note that virtually all GPS modules provide the AMSL altitude in addition to the WGS84 altitude.
-	Eph               uint16 // GPS HDOP horizontal dilution of position (unitless). If unknown, set to: UINT16_MAX
-	Epv               uint16 // GPS VDOP vertical dilution of position (unitless). If unknown, set to: UINT16_MAX
-	Vel               uint16 // GPS ground speed (m/s * 100). If unknown, set to: UINT16_MAX
-	Cog               uint16 // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
-	FixType           uint8  // 0-1: no fix, 2: 2D fix, 3: 3D fix, 4: DGPS, 5: RTK. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
-	SatellitesVisible uint8  // Number of satellites visible. If unknown, set to 255
-}
-
-func (self *GpsRawInt) MsgID() uint8 {
+	TimeUsec          uint64 `mavlink:"time_usec"`          // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Lat               int32  `mavlink:"lat"`                // Latitude (WGS84), in degrees * 1E7
+	Lon               int32  `mavlink:"lon"`                // Longitude (WGS84), in degrees * 1E7
+	Alt               int32  `mavlink:"alt"`                // Altitude (AMSL, NOT WGS84), in meters * 1000 (positive for up). Note that virtually all GPS modules provide the AMSL altitude in addition to the WGS84 altitude.
+	Eph               uint16 `mavlink:"eph"`                // GPS HDOP horizontal dilution of position (unitless). If unknown, set to: UINT16_MAX
+	Epv               uint16 `mavlink:"epv"`                // GPS VDOP vertical dilution of position (unitless). If unknown, set to: UINT16_MAX
+	Vel               uint16 `mavlink:"vel"`                // GPS ground speed (m/s * 100). If unknown, set to: UINT16_MAX
+	Cog               uint16 `mavlink:"cog"`                // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+	FixType           uint8  `mavlink:"fix_type"`           // 0-1: no fix, 2: 2D fix, 3: 3D fix, 4: DGPS, 5: RTK. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
+	SatellitesVisible uint8  `mavlink:"satellites_visible"` // Number of satellites visible. If unknown, set to 255
+}
+
+func (self *GpsRawInt) MsgID() uint32 {
 	return 24
 }
 
@@ -1225,30 +3296,39 @@ func (self *GpsRawInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 30 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Eph = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Epv = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Vel = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Cog = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.FixType = uint8(p.Payload[28])
-	self.SatellitesVisible = uint8(p.Payload[29])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Eph = uint16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Epv = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Vel = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Cog = uint16(binary.LittleEndian.Uint16(payload[26:]))
+	self.FixType = uint8(payload[28])
+	self.SatellitesVisible = uint8(payload[29])
 	return nil
 }
 
+func (self *GpsRawInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_RAW_INT", self)
+}
+
+func (self *GpsRawInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_RAW_INT", self, data)
+}
+
 // The positioning status, as reported by GPS. This message is intended to display status information about each satellite visible to the receiver. See message GLOBAL_POSITION for the global position estimate. This message can contain information for up to 20 satellites.
 type GpsStatus struct {
-	SatellitesVisible  uint8     // Number of satellites visible
-	SatellitePrn       [20]uint8 // Global satellite ID
-	SatelliteUsed      [20]uint8 // 0: Satellite not used, 1: used for localization
-	SatelliteElevation [20]uint8 // Elevation (0: right on top of receiver, 90: on the horizon) of satellite
-	SatelliteAzimuth   [20]uint8 // Direction of satellite, 0: 0 deg, 255: 360 deg.
-	SatelliteSnr       [20]uint8 // Signal to noise ratio of satellite
+	SatellitesVisible  uint8     `mavlink:"satellites_visible"`  // Number of satellites visible
+	SatellitePrn       [20]uint8 `mavlink:"satellite_prn"`       // Global satellite ID
+	SatelliteUsed      [20]uint8 `mavlink:"satellite_used"`      // 0: Satellite not used, 1: used for localization
+	SatelliteElevation [20]uint8 `mavlink:"satellite_elevation"` // Elevation (0: right on top of receiver, 90: on the horizon) of satellite
+	SatelliteAzimuth   [20]uint8 `mavlink:"satellite_azimuth"`   // Direction of satellite, 0: 0 deg, 255: 360 deg.
+	SatelliteSnr       [20]uint8 `mavlink:"satellite_snr"`       // Signal to noise ratio of satellite
 }
 
-func (self *GpsStatus) MsgID() uint8 {
+func (self *GpsStatus) MsgID() uint32 {
 	return 25
 }
 
@@ -1274,30 +3354,39 @@ func (self *GpsStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 101 {
 		return fmt.Errorf("payload too small")
 	}
-	self.SatellitesVisible = uint8(p.Payload[0])
-	copy(self.SatellitePrn[:], p.Payload[1:21])
-	copy(self.SatelliteUsed[:], p.Payload[21:41])
-	copy(self.SatelliteElevation[:], p.Payload[41:61])
-	copy(self.SatelliteAzimuth[:], p.Payload[61:81])
-	copy(self.SatelliteSnr[:], p.Payload[81:101])
+	payload := p.Payload
+	self.SatellitesVisible = uint8(payload[0])
+	copy(self.SatellitePrn[:], payload[1:21])
+	copy(self.SatelliteUsed[:], payload[21:41])
+	copy(self.SatelliteElevation[:], payload[41:61])
+	copy(self.SatelliteAzimuth[:], payload[61:81])
+	copy(self.SatelliteSnr[:], payload[81:101])
 	return nil
 }
 
+func (self *GpsStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_STATUS", self)
+}
+
+func (self *GpsStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_STATUS", self, data)
+}
+
 // The RAW IMU readings for the usual 9DOF sensor setup. This message should contain the scaled values to the described units
 type ScaledImu struct {
-	TimeBootMs uint32 // Timestamp (milliseconds since system boot)
-	Xacc       int16  // X acceleration (mg)
-	Yacc       int16  // Y acceleration (mg)
-	Zacc       int16  // Z acceleration (mg)
-	Xgyro      int16  // Angular speed around X axis (millirad /sec)
-	Ygyro      int16  // Angular speed around Y axis (millirad /sec)
-	Zgyro      int16  // Angular speed around Z axis (millirad /sec)
-	Xmag       int16  // X Magnetic field (milli tesla)
-	Ymag       int16  // Y Magnetic field (milli tesla)
-	Zmag       int16  // Z Magnetic field (milli tesla)
-}
-
-func (self *ScaledImu) MsgID() uint8 {
+	TimeBootMs uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Xacc       int16  `mavlink:"xacc"`         // X acceleration (mg)
+	Yacc       int16  `mavlink:"yacc"`         // Y acceleration (mg)
+	Zacc       int16  `mavlink:"zacc"`         // Z acceleration (mg)
+	Xgyro      int16  `mavlink:"xgyro"`        // Angular speed around X axis (millirad /sec)
+	Ygyro      int16  `mavlink:"ygyro"`        // Angular speed around Y axis (millirad /sec)
+	Zgyro      int16  `mavlink:"zgyro"`        // Angular speed around Z axis (millirad /sec)
+	Xmag       int16  `mavlink:"xmag"`         // X Magnetic field (milli tesla)
+	Ymag       int16  `mavlink:"ymag"`         // Y Magnetic field (milli tesla)
+	Zmag       int16  `mavlink:"zmag"`         // Z Magnetic field (milli tesla)
+}
+
+func (self *ScaledImu) MsgID() uint32 {
 	return 26
 }
 
@@ -1327,34 +3416,43 @@ func (self *ScaledImu) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Xgyro = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Ygyro = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Zgyro = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Xmag = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Ymag = int16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Zmag = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Xgyro = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Ygyro = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Zgyro = int16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Xmag = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Ymag = int16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Zmag = int16(binary.LittleEndian.Uint16(payload[20:]))
 	return nil
 }
 
+func (self *ScaledImu) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_IMU", self)
+}
+
+func (self *ScaledImu) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_IMU", self, data)
+}
+
 // The RAW IMU readings for the usual 9DOF sensor setup. This message should always contain the true raw values without any scaling to allow data capture and system debugging.
 type RawImu struct {
-	TimeUsec uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Xacc     int16  // X acceleration (raw)
-	Yacc     int16  // Y acceleration (raw)
-	Zacc     int16  // Z acceleration (raw)
-	Xgyro    int16  // Angular speed around X axis (raw)
-	Ygyro    int16  // Angular speed around Y axis (raw)
-	Zgyro    int16  // Angular speed around Z axis (raw)
-	Xmag     int16  // X Magnetic field (raw)
-	Ymag     int16  // Y Magnetic field (raw)
-	Zmag     int16  // Z Magnetic field (raw)
-}
-
-func (self *RawImu) MsgID() uint8 {
+	TimeUsec uint64 `mavlink:"time_usec"` // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Xacc     int16  `mavlink:"xacc"`      // X acceleration (raw)
+	Yacc     int16  `mavlink:"yacc"`      // Y acceleration (raw)
+	Zacc     int16  `mavlink:"zacc"`      // Z acceleration (raw)
+	Xgyro    int16  `mavlink:"xgyro"`     // Angular speed around X axis (raw)
+	Ygyro    int16  `mavlink:"ygyro"`     // Angular speed around Y axis (raw)
+	Zgyro    int16  `mavlink:"zgyro"`     // Angular speed around Z axis (raw)
+	Xmag     int16  `mavlink:"xmag"`      // X Magnetic field (raw)
+	Ymag     int16  `mavlink:"ymag"`      // Y Magnetic field (raw)
+	Zmag     int16  `mavlink:"zmag"`      // Z Magnetic field (raw)
+}
+
+func (self *RawImu) MsgID() uint32 {
 	return 27
 }
 
@@ -1384,29 +3482,38 @@ func (self *RawImu) Unpack(p *Packet) error {
 	if len(p.Payload) < 26 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Xgyro = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Ygyro = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Zgyro = int16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Xmag = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Ymag = int16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Zmag = int16(binary.LittleEndian.Uint16(p.Payload[24:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Xgyro = int16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Ygyro = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Zgyro = int16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Xmag = int16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Ymag = int16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Zmag = int16(binary.LittleEndian.Uint16(payload[24:]))
 	return nil
 }
 
+func (self *RawImu) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RAW_IMU", self)
+}
+
+func (self *RawImu) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RAW_IMU", self, data)
+}
+
 // The RAW pressure readings for the typical setup of one absolute pressure and one differential pressure sensor. The sensor values should be the raw, UNSCALED ADC values.
 type RawPressure struct {
-	TimeUsec    uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	PressAbs    int16  // Absolute pressure (raw)
-	PressDiff1  int16  // Differential pressure 1 (raw, 0 if nonexistant)
-	PressDiff2  int16  // Differential pressure 2 (raw, 0 if nonexistant)
-	Temperature int16  // Raw Temperature measurement (raw)
+	TimeUsec    uint64 `mavlink:"time_usec"`   // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	PressAbs    int16  `mavlink:"press_abs"`   // Absolute pressure (raw)
+	PressDiff1  int16  `mavlink:"press_diff1"` // Differential pressure 1 (raw, 0 if nonexistant)
+	PressDiff2  int16  `mavlink:"press_diff2"` // Differential pressure 2 (raw, 0 if nonexistant)
+	Temperature int16  `mavlink:"temperature"` // Raw Temperature measurement (raw)
 }
 
-func (self *RawPressure) MsgID() uint8 {
+func (self *RawPressure) MsgID() uint32 {
 	return 28
 }
 
@@ -1431,23 +3538,32 @@ func (self *RawPressure) Unpack(p *Packet) error {
 	if len(p.Payload) < 16 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.PressAbs = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.PressDiff1 = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.PressDiff2 = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.PressAbs = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.PressDiff1 = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.PressDiff2 = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[14:]))
 	return nil
 }
 
+func (self *RawPressure) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RAW_PRESSURE", self)
+}
+
+func (self *RawPressure) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RAW_PRESSURE", self, data)
+}
+
 // The pressure readings for the typical setup of one absolute and differential pressure sensor. The units are as specified in each field.
 type ScaledPressure struct {
-	TimeBootMs  uint32  // Timestamp (milliseconds since system boot)
-	PressAbs    float32 // Absolute pressure (hectopascal)
-	PressDiff   float32 // Differential pressure 1 (hectopascal)
-	Temperature int16   // Temperature measurement (0.01 degrees celsius)
+	TimeBootMs  uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	PressAbs    float32 `mavlink:"press_abs"`    // Absolute pressure (hectopascal)
+	PressDiff   float32 `mavlink:"press_diff"`   // Differential pressure 1 (hectopascal)
+	Temperature int16   `mavlink:"temperature"`  // Temperature measurement (0.01 degrees celsius)
 }
 
-func (self *ScaledPressure) MsgID() uint8 {
+func (self *ScaledPressure) MsgID() uint32 {
 	return 29
 }
 
@@ -1471,25 +3587,34 @@ func (self *ScaledPressure) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[12:]))
 	return nil
 }
 
+func (self *ScaledPressure) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_PRESSURE", self)
+}
+
+func (self *ScaledPressure) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_PRESSURE", self, data)
+}
+
 // The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right).
 type Attitude struct {
-	TimeBootMs uint32  // Timestamp (milliseconds since system boot)
-	Roll       float32 // Roll angle (rad, -pi..+pi)
-	Pitch      float32 // Pitch angle (rad, -pi..+pi)
-	Yaw        float32 // Yaw angle (rad, -pi..+pi)
-	Rollspeed  float32 // Roll angular speed (rad/s)
-	Pitchspeed float32 // Pitch angular speed (rad/s)
-	Yawspeed   float32 // Yaw angular speed (rad/s)
+	TimeBootMs uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Roll       float32 `mavlink:"roll"`         // Roll angle (rad, -pi..+pi)
+	Pitch      float32 `mavlink:"pitch"`        // Pitch angle (rad, -pi..+pi)
+	Yaw        float32 `mavlink:"yaw"`          // Yaw angle (rad, -pi..+pi)
+	Rollspeed  float32 `mavlink:"rollspeed"`    // Roll angular speed (rad/s)
+	Pitchspeed float32 `mavlink:"pitchspeed"`   // Pitch angular speed (rad/s)
+	Yawspeed   float32 `mavlink:"yawspeed"`     // Yaw angular speed (rad/s)
 }
 
-func (self *Attitude) MsgID() uint8 {
+func (self *Attitude) MsgID() uint32 {
 	return 30
 }
 
@@ -1516,29 +3641,38 @@ func (self *Attitude) Unpack(p *Packet) error {
 	if len(p.Payload) < 28 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
 	return nil
 }
 
+func (self *Attitude) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ATTITUDE", self)
+}
+
+func (self *Attitude) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ATTITUDE", self, data)
+}
+
 // The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).
 type AttitudeQuaternion struct {
-	TimeBootMs uint32  // Timestamp (milliseconds since system boot)
-	Q1         float32 // Quaternion component 1, w (1 in null-rotation)
-	Q2         float32 // Quaternion component 2, x (0 in null-rotation)
-	Q3         float32 // Quaternion component 3, y (0 in null-rotation)
-	Q4         float32 // Quaternion component 4, z (0 in null-rotation)
-	Rollspeed  float32 // Roll angular speed (rad/s)
-	Pitchspeed float32 // Pitch angular speed (rad/s)
-	Yawspeed   float32 // Yaw angular speed (rad/s)
+	TimeBootMs uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Q1         float32 `mavlink:"q1"`           // Quaternion component 1, w (1 in null-rotation)
+	Q2         float32 `mavlink:"q2"`           // Quaternion component 2, x (0 in null-rotation)
+	Q3         float32 `mavlink:"q3"`           // Quaternion component 3, y (0 in null-rotation)
+	Q4         float32 `mavlink:"q4"`           // Quaternion component 4, z (0 in null-rotation)
+	Rollspeed  float32 `mavlink:"rollspeed"`    // Roll angular speed (rad/s)
+	Pitchspeed float32 `mavlink:"pitchspeed"`   // Pitch angular speed (rad/s)
+	Yawspeed   float32 `mavlink:"yawspeed"`     // Yaw angular speed (rad/s)
 }
 
-func (self *AttitudeQuaternion) MsgID() uint8 {
+func (self *AttitudeQuaternion) MsgID() uint32 {
 	return 31
 }
 
@@ -1566,29 +3700,38 @@ func (self *AttitudeQuaternion) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Q1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Q2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Q3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Q4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Q1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Q2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Q3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Q4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
+func (self *AttitudeQuaternion) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ATTITUDE_QUATERNION", self)
+}
+
+func (self *AttitudeQuaternion) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ATTITUDE_QUATERNION", self, data)
+}
+
 // The filtered local position (e.g. fused computer vision and accelerometers). Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)
 type LocalPositionNed struct {
-	TimeBootMs uint32  // Timestamp (milliseconds since system boot)
-	X          float32 // X Position
-	Y          float32 // Y Position
-	Z          float32 // Z Position
-	Vx         float32 // X Speed
-	Vy         float32 // Y Speed
-	Vz         float32 // Z Speed
+	TimeBootMs uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	X          float32 `mavlink:"x"`            // X Position
+	Y          float32 `mavlink:"y"`            // Y Position
+	Z          float32 `mavlink:"z"`            // Z Position
+	Vx         float32 `mavlink:"vx"`           // X Speed
+	Vy         float32 `mavlink:"vy"`           // Y Speed
+	Vz         float32 `mavlink:"vz"`           // Z Speed
 }
 
-func (self *LocalPositionNed) MsgID() uint8 {
+func (self *LocalPositionNed) MsgID() uint32 {
 	return 32
 }
 
@@ -1615,31 +3758,41 @@ func (self *LocalPositionNed) Unpack(p *Packet) error {
 	if len(p.Payload) < 28 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
 	return nil
 }
 
+func (self *LocalPositionNed) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOCAL_POSITION_NED", self)
+}
+
+func (self *LocalPositionNed) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOCAL_POSITION_NED", self, data)
+}
+
 // The filtered global position (e.g. fused GPS and accelerometers). The position is in GPS-frame (right-handed, Z-up). It
-//                is designed as scaled integer message since the resolution of float is not sufficient.
+//
+//	is designed as scaled integer message since the resolution of float is not sufficient.
 type GlobalPositionInt struct {
-	TimeBootMs  uint32 // Timestamp (milliseconds since system boot)
-	Lat         int32  // Latitude, expressed as degrees * 1E7
-	Lon         int32  // Longitude, expressed as degrees * 1E7
-	Alt         int32  // Altitude in meters, expressed as * 1000 (millimeters), AMSL (not WGS84 - note that virtually all GPS modules provide the AMSL as well)
-	RelativeAlt int32  // Altitude above ground in meters, expressed as * 1000 (millimeters)
-	Vx          int16  // Ground X Speed (Latitude, positive north), expressed as m/s * 100
-	Vy          int16  // Ground Y Speed (Longitude, positive east), expressed as m/s * 100
-	Vz          int16  // Ground Z Speed (Altitude, positive down), expressed as m/s * 100
-	Hdg         uint16 // Vehicle heading (yaw angle) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
-}
-
-func (self *GlobalPositionInt) MsgID() uint8 {
+	TimeBootMs  uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Lat         int32  `mavlink:"lat"`          // Latitude, expressed as degrees * 1E7
+	Lon         int32  `mavlink:"lon"`          // Longitude, expressed as degrees * 1E7
+	Alt         int32  `mavlink:"alt"`          // Altitude in meters, expressed as * 1000 (millimeters), AMSL (not WGS84 - note that virtually all GPS modules provide the AMSL as well)
+	RelativeAlt int32  `mavlink:"relative_alt"` // Altitude above ground in meters, expressed as * 1000 (millimeters)
+	Vx          int16  `mavlink:"vx"`           // Ground X Speed (Latitude, positive north), expressed as m/s * 100
+	Vy          int16  `mavlink:"vy"`           // Ground Y Speed (Longitude, positive east), expressed as m/s * 100
+	Vz          int16  `mavlink:"vz"`           // Ground Z Speed (Altitude, positive down), expressed as m/s * 100
+	Hdg         uint16 `mavlink:"hdg"`          // Vehicle heading (yaw angle) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+}
+
+func (self *GlobalPositionInt) MsgID() uint32 {
 	return 33
 }
 
@@ -1668,34 +3821,43 @@ func (self *GlobalPositionInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 28 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.RelativeAlt = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vx = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Vy = int16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Vz = int16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Hdg = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.RelativeAlt = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vx = int16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Vy = int16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Vz = int16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Hdg = uint16(binary.LittleEndian.Uint16(payload[26:]))
 	return nil
 }
 
+func (self *GlobalPositionInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GLOBAL_POSITION_INT", self)
+}
+
+func (self *GlobalPositionInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GLOBAL_POSITION_INT", self, data)
+}
+
 // The scaled values of the RC channels received. (-100%) -10000, (0%) 0, (100%) 10000. Channels that are inactive should be set to UINT16_MAX.
 type RcChannelsScaled struct {
-	TimeBootMs  uint32 // Timestamp (milliseconds since system boot)
-	Chan1Scaled int16  // RC channel 1 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan2Scaled int16  // RC channel 2 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan3Scaled int16  // RC channel 3 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan4Scaled int16  // RC channel 4 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan5Scaled int16  // RC channel 5 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan6Scaled int16  // RC channel 6 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan7Scaled int16  // RC channel 7 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Chan8Scaled int16  // RC channel 8 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
-	Port        uint8  // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows for more than 8 servos.
-	Rssi        uint8  // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
-}
-
-func (self *RcChannelsScaled) MsgID() uint8 {
+	TimeBootMs  uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Chan1Scaled int16  `mavlink:"chan1_scaled"` // RC channel 1 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan2Scaled int16  `mavlink:"chan2_scaled"` // RC channel 2 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan3Scaled int16  `mavlink:"chan3_scaled"` // RC channel 3 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan4Scaled int16  `mavlink:"chan4_scaled"` // RC channel 4 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan5Scaled int16  `mavlink:"chan5_scaled"` // RC channel 5 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan6Scaled int16  `mavlink:"chan6_scaled"` // RC channel 6 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan7Scaled int16  `mavlink:"chan7_scaled"` // RC channel 7 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Chan8Scaled int16  `mavlink:"chan8_scaled"` // RC channel 8 value scaled, (-100%) -10000, (0%) 0, (100%) 10000, (invalid) INT16_MAX.
+	Port        uint8  `mavlink:"port"`         // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows for more than 8 servos.
+	Rssi        uint8  `mavlink:"rssi"`         // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
+}
+
+func (self *RcChannelsScaled) MsgID() uint32 {
 	return 34
 }
 
@@ -1726,36 +3888,45 @@ func (self *RcChannelsScaled) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Chan1Scaled = int16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Chan2Scaled = int16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Chan3Scaled = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Chan4Scaled = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Chan5Scaled = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Chan6Scaled = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Chan7Scaled = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Chan8Scaled = int16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Port = uint8(p.Payload[20])
-	self.Rssi = uint8(p.Payload[21])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Chan1Scaled = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Chan2Scaled = int16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Chan3Scaled = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Chan4Scaled = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Chan5Scaled = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Chan6Scaled = int16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Chan7Scaled = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Chan8Scaled = int16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Port = uint8(payload[20])
+	self.Rssi = uint8(payload[21])
 	return nil
 }
 
+func (self *RcChannelsScaled) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RC_CHANNELS_SCALED", self)
+}
+
+func (self *RcChannelsScaled) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RC_CHANNELS_SCALED", self, data)
+}
+
 // The RAW values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.
 type RcChannelsRaw struct {
-	TimeBootMs uint32 // Timestamp (milliseconds since system boot)
-	Chan1Raw   uint16 // RC channel 1 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan2Raw   uint16 // RC channel 2 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan3Raw   uint16 // RC channel 3 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan4Raw   uint16 // RC channel 4 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan5Raw   uint16 // RC channel 5 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan6Raw   uint16 // RC channel 6 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan7Raw   uint16 // RC channel 7 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan8Raw   uint16 // RC channel 8 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Port       uint8  // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows for more than 8 servos.
-	Rssi       uint8  // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
-}
-
-func (self *RcChannelsRaw) MsgID() uint8 {
+	TimeBootMs uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Chan1Raw   uint16 `mavlink:"chan1_raw"`    // RC channel 1 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan2Raw   uint16 `mavlink:"chan2_raw"`    // RC channel 2 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan3Raw   uint16 `mavlink:"chan3_raw"`    // RC channel 3 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan4Raw   uint16 `mavlink:"chan4_raw"`    // RC channel 4 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan5Raw   uint16 `mavlink:"chan5_raw"`    // RC channel 5 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan6Raw   uint16 `mavlink:"chan6_raw"`    // RC channel 6 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan7Raw   uint16 `mavlink:"chan7_raw"`    // RC channel 7 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan8Raw   uint16 `mavlink:"chan8_raw"`    // RC channel 8 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Port       uint8  `mavlink:"port"`         // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows for more than 8 servos.
+	Rssi       uint8  `mavlink:"rssi"`         // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
+}
+
+func (self *RcChannelsRaw) MsgID() uint32 {
 	return 35
 }
 
@@ -1786,43 +3957,52 @@ func (self *RcChannelsRaw) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Port = uint8(p.Payload[20])
-	self.Rssi = uint8(p.Payload[21])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Port = uint8(payload[20])
+	self.Rssi = uint8(payload[21])
 	return nil
 }
 
+func (self *RcChannelsRaw) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RC_CHANNELS_RAW", self)
+}
+
+func (self *RcChannelsRaw) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RC_CHANNELS_RAW", self, data)
+}
+
 // The RAW values of the servo outputs (for RC input from the remote, use the RC_CHANNELS messages). The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%.
 type ServoOutputRaw struct {
-	TimeUsec   uint32 // Timestamp (microseconds since system boot)
-	Servo1Raw  uint16 // Servo output 1 value, in microseconds
-	Servo2Raw  uint16 // Servo output 2 value, in microseconds
-	Servo3Raw  uint16 // Servo output 3 value, in microseconds
-	Servo4Raw  uint16 // Servo output 4 value, in microseconds
-	Servo5Raw  uint16 // Servo output 5 value, in microseconds
-	Servo6Raw  uint16 // Servo output 6 value, in microseconds
-	Servo7Raw  uint16 // Servo output 7 value, in microseconds
-	Servo8Raw  uint16 // Servo output 8 value, in microseconds
-	// Servo9Raw  uint16 // Servo output 9 value, in microseconds
-	// Servo10Raw uint16 // Servo output 10 value, in microseconds
-	// Servo11Raw uint16 // Servo output 11 value, in microseconds
-	// Servo12Raw uint16 // Servo output 12 value, in microseconds
-	// Servo13Raw uint16 // Servo output 13 value, in microseconds
-	// Servo14Raw uint16 // Servo output 14 value, in microseconds
-	// Servo15Raw uint16 // Servo output 15 value, in microseconds
-	// Servo16Raw uint16 // Servo output 16 value, in microseconds
-	Port       uint8  // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows to encode more than 8 servos.
-}
-
-func (self *ServoOutputRaw) MsgID() uint8 {
+	TimeUsec   uint32 `mavlink:"time_usec"`   // Timestamp (microseconds since system boot)
+	Servo1Raw  uint16 `mavlink:"servo1_raw"`  // Servo output 1 value, in microseconds
+	Servo2Raw  uint16 `mavlink:"servo2_raw"`  // Servo output 2 value, in microseconds
+	Servo3Raw  uint16 `mavlink:"servo3_raw"`  // Servo output 3 value, in microseconds
+	Servo4Raw  uint16 `mavlink:"servo4_raw"`  // Servo output 4 value, in microseconds
+	Servo5Raw  uint16 `mavlink:"servo5_raw"`  // Servo output 5 value, in microseconds
+	Servo6Raw  uint16 `mavlink:"servo6_raw"`  // Servo output 6 value, in microseconds
+	Servo7Raw  uint16 `mavlink:"servo7_raw"`  // Servo output 7 value, in microseconds
+	Servo8Raw  uint16 `mavlink:"servo8_raw"`  // Servo output 8 value, in microseconds
+	Port       uint8  `mavlink:"port"`        // Servo output port (set of 8 outputs = 1 port). Most MAVs will just use one, but this allows to encode more than 8 servos.
+	Servo9Raw  uint16 `mavlink:"servo9_raw"`  // Servo output 9 value, in microseconds
+	Servo10Raw uint16 `mavlink:"servo10_raw"` // Servo output 10 value, in microseconds
+	Servo11Raw uint16 `mavlink:"servo11_raw"` // Servo output 11 value, in microseconds
+	Servo12Raw uint16 `mavlink:"servo12_raw"` // Servo output 12 value, in microseconds
+	Servo13Raw uint16 `mavlink:"servo13_raw"` // Servo output 13 value, in microseconds
+	Servo14Raw uint16 `mavlink:"servo14_raw"` // Servo output 14 value, in microseconds
+	Servo15Raw uint16 `mavlink:"servo15_raw"` // Servo output 15 value, in microseconds
+	Servo16Raw uint16 `mavlink:"servo16_raw"` // Servo output 16 value, in microseconds
+}
+
+func (self *ServoOutputRaw) MsgID() uint32 {
 	return 36
 }
 
@@ -1841,15 +4021,15 @@ func (self *ServoOutputRaw) Pack(p *Packet) error {
 	binary.LittleEndian.PutUint16(payload[14:], uint16(self.Servo6Raw))
 	binary.LittleEndian.PutUint16(payload[16:], uint16(self.Servo7Raw))
 	binary.LittleEndian.PutUint16(payload[18:], uint16(self.Servo8Raw))
-	// binary.LittleEndian.PutUint16(payload[20:], uint16(self.Servo9Raw))
-	// binary.LittleEndian.PutUint16(payload[22:], uint16(self.Servo10Raw))
-	// binary.LittleEndian.PutUint16(payload[24:], uint16(self.Servo11Raw))
-	// binary.LittleEndian.PutUint16(payload[26:], uint16(self.Servo12Raw))
-	// binary.LittleEndian.PutUint16(payload[28:], uint16(self.Servo13Raw))
-	// binary.LittleEndian.PutUint16(payload[30:], uint16(self.Servo14Raw))
-	// binary.LittleEndian.PutUint16(payload[32:], uint16(self.Servo15Raw))
-	// binary.LittleEndian.PutUint16(payload[34:], uint16(self.Servo16Raw))
-	payload[36] = byte(self.Port)
+	payload[20] = byte(self.Port)
+	binary.LittleEndian.PutUint16(payload[21:], uint16(self.Servo9Raw))
+	binary.LittleEndian.PutUint16(payload[23:], uint16(self.Servo10Raw))
+	binary.LittleEndian.PutUint16(payload[25:], uint16(self.Servo11Raw))
+	binary.LittleEndian.PutUint16(payload[27:], uint16(self.Servo12Raw))
+	binary.LittleEndian.PutUint16(payload[29:], uint16(self.Servo13Raw))
+	binary.LittleEndian.PutUint16(payload[31:], uint16(self.Servo14Raw))
+	binary.LittleEndian.PutUint16(payload[33:], uint16(self.Servo15Raw))
+	binary.LittleEndian.PutUint16(payload[35:], uint16(self.Servo16Raw))
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -1860,36 +4040,51 @@ func (self *ServoOutputRaw) Unpack(p *Packet) error {
 	if len(p.Payload) < 21 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Servo1Raw = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Servo2Raw = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Servo3Raw = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Servo4Raw = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Servo5Raw = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Servo6Raw = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Servo7Raw = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Servo8Raw = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	// self.Servo9Raw = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	// self.Servo10Raw = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	// self.Servo11Raw = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	// self.Servo12Raw = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	// self.Servo13Raw = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	// self.Servo14Raw = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	// self.Servo15Raw = uint16(binary.LittleEndian.Uint16(p.Payload[32:]))
-	// self.Servo16Raw = uint16(binary.LittleEndian.Uint16(p.Payload[34:]))
-	self.Port = uint8(p.Payload[20])
+	payload := p.Payload
+	if len(payload) < 37 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 37)
+		copy(payload, p.Payload)
+	}
+	self.TimeUsec = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Servo1Raw = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Servo2Raw = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Servo3Raw = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Servo4Raw = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Servo5Raw = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Servo6Raw = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Servo7Raw = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Servo8Raw = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Port = uint8(payload[20])
+	self.Servo9Raw = uint16(binary.LittleEndian.Uint16(payload[21:]))
+	self.Servo10Raw = uint16(binary.LittleEndian.Uint16(payload[23:]))
+	self.Servo11Raw = uint16(binary.LittleEndian.Uint16(payload[25:]))
+	self.Servo12Raw = uint16(binary.LittleEndian.Uint16(payload[27:]))
+	self.Servo13Raw = uint16(binary.LittleEndian.Uint16(payload[29:]))
+	self.Servo14Raw = uint16(binary.LittleEndian.Uint16(payload[31:]))
+	self.Servo15Raw = uint16(binary.LittleEndian.Uint16(payload[33:]))
+	self.Servo16Raw = uint16(binary.LittleEndian.Uint16(payload[35:]))
 	return nil
 }
 
+func (self *ServoOutputRaw) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SERVO_OUTPUT_RAW", self)
+}
+
+func (self *ServoOutputRaw) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SERVO_OUTPUT_RAW", self, data)
+}
+
 // Request a partial list of mission items from the system/component. http://qgroundcontrol.org/mavlink/waypoint_protocol. If start and end index are the same, just send one waypoint.
 type MissionRequestPartialList struct {
-	StartIndex      int16 // Start index, 0 by default
-	EndIndex        int16 // End index, -1 by default (-1: send list to end). Else a valid index of the list
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	StartIndex      int16 `mavlink:"start_index"`      // Start index, 0 by default
+	EndIndex        int16 `mavlink:"end_index"`        // End index, -1 by default (-1: send list to end). Else a valid index of the list
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+	MissionType     uint8 `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionRequestPartialList) MsgID() uint8 {
+func (self *MissionRequestPartialList) MsgID() uint32 {
 	return 37
 }
 
@@ -1898,11 +4093,12 @@ func (self *MissionRequestPartialList) MsgName() string {
 }
 
 func (self *MissionRequestPartialList) Pack(p *Packet) error {
-	payload := make([]byte, 6)
+	payload := make([]byte, 7)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.StartIndex))
 	binary.LittleEndian.PutUint16(payload[2:], uint16(self.EndIndex))
 	payload[4] = byte(self.TargetSystem)
 	payload[5] = byte(self.TargetComponent)
+	payload[6] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -1913,22 +4109,38 @@ func (self *MissionRequestPartialList) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.StartIndex = int16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.EndIndex = int16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.TargetSystem = uint8(p.Payload[4])
-	self.TargetComponent = uint8(p.Payload[5])
+	payload := p.Payload
+	if len(payload) < 7 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 7)
+		copy(payload, p.Payload)
+	}
+	self.StartIndex = int16(binary.LittleEndian.Uint16(payload[0:]))
+	self.EndIndex = int16(binary.LittleEndian.Uint16(payload[2:]))
+	self.TargetSystem = uint8(payload[4])
+	self.TargetComponent = uint8(payload[5])
+	self.MissionType = uint8(payload[6])
 	return nil
 }
 
+func (self *MissionRequestPartialList) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_REQUEST_PARTIAL_LIST", self)
+}
+
+func (self *MissionRequestPartialList) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_REQUEST_PARTIAL_LIST", self, data)
+}
+
 // This message is sent to the MAV to write a partial list. If start index == end index, only one item will be transmitted / updated. If the start index is NOT 0 and above the current list size, this request should be REJECTED!
 type MissionWritePartialList struct {
-	StartIndex      int16 // Start index, 0 by default and smaller / equal to the largest index of the current onboard list.
-	EndIndex        int16 // End index, equal or greater than start index.
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	StartIndex      int16 `mavlink:"start_index"`      // Start index, 0 by default and smaller / equal to the largest index of the current onboard list.
+	EndIndex        int16 `mavlink:"end_index"`        // End index, equal or greater than start index.
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+	MissionType     uint8 `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionWritePartialList) MsgID() uint8 {
+func (self *MissionWritePartialList) MsgID() uint32 {
 	return 38
 }
 
@@ -1937,11 +4149,12 @@ func (self *MissionWritePartialList) MsgName() string {
 }
 
 func (self *MissionWritePartialList) Pack(p *Packet) error {
-	payload := make([]byte, 6)
+	payload := make([]byte, 7)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.StartIndex))
 	binary.LittleEndian.PutUint16(payload[2:], uint16(self.EndIndex))
 	payload[4] = byte(self.TargetSystem)
 	payload[5] = byte(self.TargetComponent)
+	payload[6] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -1952,33 +4165,50 @@ func (self *MissionWritePartialList) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.StartIndex = int16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.EndIndex = int16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.TargetSystem = uint8(p.Payload[4])
-	self.TargetComponent = uint8(p.Payload[5])
+	payload := p.Payload
+	if len(payload) < 7 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 7)
+		copy(payload, p.Payload)
+	}
+	self.StartIndex = int16(binary.LittleEndian.Uint16(payload[0:]))
+	self.EndIndex = int16(binary.LittleEndian.Uint16(payload[2:]))
+	self.TargetSystem = uint8(payload[4])
+	self.TargetComponent = uint8(payload[5])
+	self.MissionType = uint8(payload[6])
 	return nil
 }
 
+func (self *MissionWritePartialList) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_WRITE_PARTIAL_LIST", self)
+}
+
+func (self *MissionWritePartialList) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_WRITE_PARTIAL_LIST", self, data)
+}
+
 // Message encoding a mission item. This message is emitted to announce
-//                 the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). See also http://qgroundcontrol.org/mavlink/waypoint_protocol.
+//
+//	the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). See also http://qgroundcontrol.org/mavlink/waypoint_protocol.
 type MissionItem struct {
-	Param1          float32 // PARAM1, see MAV_CMD enum
-	Param2          float32 // PARAM2, see MAV_CMD enum
-	Param3          float32 // PARAM3, see MAV_CMD enum
-	Param4          float32 // PARAM4, see MAV_CMD enum
-	X               float32 // PARAM5 / local: x position, global: latitude
-	Y               float32 // PARAM6 / y position: global: longitude
-	Z               float32 // PARAM7 / z position: global: altitude (relative or absolute, depending on frame.
-	Seq             uint16  // Sequence
-	Command         uint16  // The scheduled action for the MISSION. see MAV_CMD in common.xml MAVLink specs
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	Frame           uint8   // The coordinate system of the MISSION. see MAV_FRAME in mavlink_types.h
-	Current         uint8   // false:0, true:1
-	Autocontinue    uint8   // autocontinue to next wp
-}
-
-func (self *MissionItem) MsgID() uint8 {
+	Param1          float32 `mavlink:"param1"`           // PARAM1, see MAV_CMD enum
+	Param2          float32 `mavlink:"param2"`           // PARAM2, see MAV_CMD enum
+	Param3          float32 `mavlink:"param3"`           // PARAM3, see MAV_CMD enum
+	Param4          float32 `mavlink:"param4"`           // PARAM4, see MAV_CMD enum
+	X               float32 `mavlink:"x"`                // PARAM5 / local: x position, global: latitude
+	Y               float32 `mavlink:"y"`                // PARAM6 / y position: global: longitude
+	Z               float32 `mavlink:"z"`                // PARAM7 / z position: global: altitude (relative or absolute, depending on frame.
+	Seq             uint16  `mavlink:"seq"`              // Sequence
+	Command         uint16  `mavlink:"command"`          // The scheduled action for the MISSION. see MAV_CMD in common.xml MAVLink specs
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	Frame           uint8   `mavlink:"frame"`            // The coordinate system of the MISSION. see MAV_FRAME in mavlink_types.h
+	Current         uint8   `mavlink:"current"`          // false:0, true:1
+	Autocontinue    uint8   `mavlink:"autocontinue"`     // autocontinue to next wp
+	MissionType     uint8   `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
+}
+
+func (self *MissionItem) MsgID() uint32 {
 	return 39
 }
 
@@ -1987,7 +4217,7 @@ func (self *MissionItem) MsgName() string {
 }
 
 func (self *MissionItem) Pack(p *Packet) error {
-	payload := make([]byte, 37)
+	payload := make([]byte, 38)
 	binary.LittleEndian.PutUint32(payload[0:], math.Float32bits(self.Param1))
 	binary.LittleEndian.PutUint32(payload[4:], math.Float32bits(self.Param2))
 	binary.LittleEndian.PutUint32(payload[8:], math.Float32bits(self.Param3))
@@ -2002,6 +4232,7 @@ func (self *MissionItem) Pack(p *Packet) error {
 	payload[34] = byte(self.Frame)
 	payload[35] = byte(self.Current)
 	payload[36] = byte(self.Autocontinue)
+	payload[37] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2012,31 +4243,47 @@ func (self *MissionItem) Unpack(p *Packet) error {
 	if len(p.Payload) < 37 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Command = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.TargetSystem = uint8(p.Payload[32])
-	self.TargetComponent = uint8(p.Payload[33])
-	self.Frame = uint8(p.Payload[34])
-	self.Current = uint8(p.Payload[35])
-	self.Autocontinue = uint8(p.Payload[36])
+	payload := p.Payload
+	if len(payload) < 38 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 38)
+		copy(payload, p.Payload)
+	}
+	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Command = uint16(binary.LittleEndian.Uint16(payload[30:]))
+	self.TargetSystem = uint8(payload[32])
+	self.TargetComponent = uint8(payload[33])
+	self.Frame = uint8(payload[34])
+	self.Current = uint8(payload[35])
+	self.Autocontinue = uint8(payload[36])
+	self.MissionType = uint8(payload[37])
 	return nil
 }
 
+func (self *MissionItem) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_ITEM", self)
+}
+
+func (self *MissionItem) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_ITEM", self, data)
+}
+
 // Request the information of the mission item with the sequence number seq. The response of the system to this message should be a MISSION_ITEM message. http://qgroundcontrol.org/mavlink/waypoint_protocol
 type MissionRequest struct {
-	Seq             uint16 // Sequence
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Seq             uint16 `mavlink:"seq"`              // Sequence
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
+	MissionType     uint8  `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionRequest) MsgID() uint8 {
+func (self *MissionRequest) MsgID() uint32 {
 	return 40
 }
 
@@ -2045,10 +4292,11 @@ func (self *MissionRequest) MsgName() string {
 }
 
 func (self *MissionRequest) Pack(p *Packet) error {
-	payload := make([]byte, 4)
+	payload := make([]byte, 5)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Seq))
 	payload[2] = byte(self.TargetSystem)
 	payload[3] = byte(self.TargetComponent)
+	payload[4] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2059,20 +4307,35 @@ func (self *MissionRequest) Unpack(p *Packet) error {
 	if len(p.Payload) < 4 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
+	payload := p.Payload
+	if len(payload) < 5 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 5)
+		copy(payload, p.Payload)
+	}
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
+	self.MissionType = uint8(payload[4])
 	return nil
 }
 
+func (self *MissionRequest) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_REQUEST", self)
+}
+
+func (self *MissionRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_REQUEST", self, data)
+}
+
 // Set the mission item with sequence number seq as current item. This means that the MAV will continue to this mission item on the shortest path (not following the mission items in-between).
 type MissionSetCurrent struct {
-	Seq             uint16 // Sequence
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Seq             uint16 `mavlink:"seq"`              // Sequence
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
 }
 
-func (self *MissionSetCurrent) MsgID() uint8 {
+func (self *MissionSetCurrent) MsgID() uint32 {
 	return 41
 }
 
@@ -2095,18 +4358,27 @@ func (self *MissionSetCurrent) Unpack(p *Packet) error {
 	if len(p.Payload) < 4 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
+	payload := p.Payload
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
 	return nil
 }
 
+func (self *MissionSetCurrent) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_SET_CURRENT", self)
+}
+
+func (self *MissionSetCurrent) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_SET_CURRENT", self, data)
+}
+
 // Message that announces the sequence number of the current active mission item. The MAV will fly towards this mission item.
 type MissionCurrent struct {
-	Seq uint16 // Sequence
+	Seq uint16 `mavlink:"seq"` // Sequence
 }
 
-func (self *MissionCurrent) MsgID() uint8 {
+func (self *MissionCurrent) MsgID() uint32 {
 	return 42
 }
 
@@ -2127,17 +4399,27 @@ func (self *MissionCurrent) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
+	payload := p.Payload
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[0:]))
 	return nil
 }
 
+func (self *MissionCurrent) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_CURRENT", self)
+}
+
+func (self *MissionCurrent) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_CURRENT", self, data)
+}
+
 // Request the overall list of mission items from the system/component.
 type MissionRequestList struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+	MissionType     uint8 `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionRequestList) MsgID() uint8 {
+func (self *MissionRequestList) MsgID() uint32 {
 	return 43
 }
 
@@ -2146,9 +4428,10 @@ func (self *MissionRequestList) MsgName() string {
 }
 
 func (self *MissionRequestList) Pack(p *Packet) error {
-	payload := make([]byte, 2)
+	payload := make([]byte, 3)
 	payload[0] = byte(self.TargetSystem)
 	payload[1] = byte(self.TargetComponent)
+	payload[2] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2159,19 +4442,35 @@ func (self *MissionRequestList) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
+	payload := p.Payload
+	if len(payload) < 3 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 3)
+		copy(payload, p.Payload)
+	}
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
+	self.MissionType = uint8(payload[2])
 	return nil
 }
 
+func (self *MissionRequestList) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_REQUEST_LIST", self)
+}
+
+func (self *MissionRequestList) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_REQUEST_LIST", self, data)
+}
+
 // This message is emitted as response to MISSION_REQUEST_LIST by the MAV and to initiate a write transaction. The GCS can then request the individual mission item based on the knowledge of the total number of MISSIONs.
 type MissionCount struct {
-	Count           uint16 // Number of mission items in the sequence
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Count           uint16 `mavlink:"count"`            // Number of mission items in the sequence
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
+	MissionType     uint8  `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionCount) MsgID() uint8 {
+func (self *MissionCount) MsgID() uint32 {
 	return 44
 }
 
@@ -2180,10 +4479,11 @@ func (self *MissionCount) MsgName() string {
 }
 
 func (self *MissionCount) Pack(p *Packet) error {
-	payload := make([]byte, 4)
+	payload := make([]byte, 5)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Count))
 	payload[2] = byte(self.TargetSystem)
 	payload[3] = byte(self.TargetComponent)
+	payload[4] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2194,19 +4494,35 @@ func (self *MissionCount) Unpack(p *Packet) error {
 	if len(p.Payload) < 4 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Count = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
+	payload := p.Payload
+	if len(payload) < 5 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 5)
+		copy(payload, p.Payload)
+	}
+	self.Count = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
+	self.MissionType = uint8(payload[4])
 	return nil
 }
 
+func (self *MissionCount) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_COUNT", self)
+}
+
+func (self *MissionCount) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_COUNT", self, data)
+}
+
 // Delete all mission items at once.
 type MissionClearAll struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+	MissionType     uint8 `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionClearAll) MsgID() uint8 {
+func (self *MissionClearAll) MsgID() uint32 {
 	return 45
 }
 
@@ -2215,9 +4531,10 @@ func (self *MissionClearAll) MsgName() string {
 }
 
 func (self *MissionClearAll) Pack(p *Packet) error {
-	payload := make([]byte, 2)
+	payload := make([]byte, 3)
 	payload[0] = byte(self.TargetSystem)
 	payload[1] = byte(self.TargetComponent)
+	payload[2] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2228,17 +4545,32 @@ func (self *MissionClearAll) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
+	payload := p.Payload
+	if len(payload) < 3 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 3)
+		copy(payload, p.Payload)
+	}
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
+	self.MissionType = uint8(payload[2])
 	return nil
 }
 
+func (self *MissionClearAll) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_CLEAR_ALL", self)
+}
+
+func (self *MissionClearAll) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_CLEAR_ALL", self, data)
+}
+
 // A certain mission item has been reached. The system will either hold this position (or circle on the orbit) or (if the autocontinue on the WP was set) continue to the next MISSION.
 type MissionItemReached struct {
-	Seq uint16 // Sequence
+	Seq uint16 `mavlink:"seq"` // Sequence
 }
 
-func (self *MissionItemReached) MsgID() uint8 {
+func (self *MissionItemReached) MsgID() uint32 {
 	return 46
 }
 
@@ -2259,18 +4591,28 @@ func (self *MissionItemReached) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
+	payload := p.Payload
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[0:]))
 	return nil
 }
 
+func (self *MissionItemReached) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_ITEM_REACHED", self)
+}
+
+func (self *MissionItemReached) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_ITEM_REACHED", self, data)
+}
+
 // Ack message during MISSION handling. The type field states if this message is a positive ack (type=0) or if an error happened (type=non-zero).
 type MissionAck struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
-	Type            uint8 // See MAV_MISSION_RESULT enum
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
+	Type            uint8 `mavlink:"type"`             // See MAV_MISSION_RESULT enum
+	MissionType     uint8 `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionAck) MsgID() uint8 {
+func (self *MissionAck) MsgID() uint32 {
 	return 47
 }
 
@@ -2279,10 +4621,11 @@ func (self *MissionAck) MsgName() string {
 }
 
 func (self *MissionAck) Pack(p *Packet) error {
-	payload := make([]byte, 3)
+	payload := make([]byte, 4)
 	payload[0] = byte(self.TargetSystem)
 	payload[1] = byte(self.TargetComponent)
 	payload[2] = byte(self.Type)
+	payload[3] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2293,21 +4636,36 @@ func (self *MissionAck) Unpack(p *Packet) error {
 	if len(p.Payload) < 3 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
-	self.Type = uint8(p.Payload[2])
+	payload := p.Payload
+	if len(payload) < 4 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 4)
+		copy(payload, p.Payload)
+	}
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
+	self.Type = uint8(payload[2])
+	self.MissionType = uint8(payload[3])
 	return nil
 }
 
+func (self *MissionAck) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_ACK", self)
+}
+
+func (self *MissionAck) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_ACK", self, data)
+}
+
 // As local waypoints exist, the global MISSION reference allows to transform between the local coordinate frame and the global (GPS) coordinate frame. This can be necessary when e.g. in- and outdoor settings are connected and the MAV should move from in- to outdoor.
 type SetGpsGlobalOrigin struct {
-	Latitude     int32 // Latitude (WGS84), in degrees * 1E7
-	Longitude    int32 // Longitude (WGS84, in degrees * 1E7
-	Altitude     int32 // Altitude (AMSL), in meters * 1000 (positive for up)
-	TargetSystem uint8 // System ID
+	Latitude     int32 `mavlink:"latitude"`      // Latitude (WGS84), in degrees * 1E7
+	Longitude    int32 `mavlink:"longitude"`     // Longitude (WGS84, in degrees * 1E7
+	Altitude     int32 `mavlink:"altitude"`      // Altitude (AMSL), in meters * 1000 (positive for up)
+	TargetSystem uint8 `mavlink:"target_system"` // System ID
 }
 
-func (self *SetGpsGlobalOrigin) MsgID() uint8 {
+func (self *SetGpsGlobalOrigin) MsgID() uint32 {
 	return 48
 }
 
@@ -2331,21 +4689,30 @@ func (self *SetGpsGlobalOrigin) Unpack(p *Packet) error {
 	if len(p.Payload) < 13 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Latitude = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Longitude = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Altitude = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.TargetSystem = uint8(p.Payload[12])
+	payload := p.Payload
+	self.Latitude = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Longitude = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Altitude = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.TargetSystem = uint8(payload[12])
 	return nil
 }
 
+func (self *SetGpsGlobalOrigin) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_GPS_GLOBAL_ORIGIN", self)
+}
+
+func (self *SetGpsGlobalOrigin) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_GPS_GLOBAL_ORIGIN", self, data)
+}
+
 // Once the MAV sets a new GPS-Local correspondence, this message announces the origin (0,0,0) position
 type GpsGlobalOrigin struct {
-	Latitude  int32 // Latitude (WGS84), in degrees * 1E7
-	Longitude int32 // Longitude (WGS84), in degrees * 1E7
-	Altitude  int32 // Altitude (AMSL), in meters * 1000 (positive for up)
+	Latitude  int32 `mavlink:"latitude"`  // Latitude (WGS84), in degrees * 1E7
+	Longitude int32 `mavlink:"longitude"` // Longitude (WGS84), in degrees * 1E7
+	Altitude  int32 `mavlink:"altitude"`  // Altitude (AMSL), in meters * 1000 (positive for up)
 }
 
-func (self *GpsGlobalOrigin) MsgID() uint8 {
+func (self *GpsGlobalOrigin) MsgID() uint32 {
 	return 49
 }
 
@@ -2368,26 +4735,35 @@ func (self *GpsGlobalOrigin) Unpack(p *Packet) error {
 	if len(p.Payload) < 12 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Latitude = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Longitude = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Altitude = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
+	payload := p.Payload
+	self.Latitude = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Longitude = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Altitude = int32(binary.LittleEndian.Uint32(payload[8:]))
 	return nil
 }
 
+func (self *GpsGlobalOrigin) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_GLOBAL_ORIGIN", self)
+}
+
+func (self *GpsGlobalOrigin) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_GLOBAL_ORIGIN", self, data)
+}
+
 // Bind a RC channel to a parameter. The parameter should change accoding to the RC channel value.
 type ParamMapRc struct {
-	ParamValue0             float32  // Initial parameter value
-	Scale                   float32  // Scale, maps the RC range [-1, 1] to a parameter value
-	ParamValueMin           float32  // Minimum param value. The protocol does not define if this overwrites an onboard minimum value. (Depends on implementation)
-	ParamValueMax           float32  // Maximum param value. The protocol does not define if this overwrites an onboard maximum value. (Depends on implementation)
-	ParamIndex              int16    // Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored), send -2 to disable any existing map for this rc_channel_index.
-	TargetSystem            uint8    // System ID
-	TargetComponent         uint8    // Component ID
-	ParamId                 [16]byte // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
-	ParameterRcChannelIndex uint8    // Index of parameter RC channel. Not equal to the RC channel id. Typically correpsonds to a potentiometer-knob on the RC.
-}
-
-func (self *ParamMapRc) MsgID() uint8 {
+	ParamValue0             float32  `mavlink:"param_value0"`               // Initial parameter value
+	Scale                   float32  `mavlink:"scale"`                      // Scale, maps the RC range [-1, 1] to a parameter value
+	ParamValueMin           float32  `mavlink:"param_value_min"`            // Minimum param value. The protocol does not define if this overwrites an onboard minimum value. (Depends on implementation)
+	ParamValueMax           float32  `mavlink:"param_value_max"`            // Maximum param value. The protocol does not define if this overwrites an onboard maximum value. (Depends on implementation)
+	ParamIndex              int16    `mavlink:"param_index"`                // Parameter index. Send -1 to use the param ID field as identifier (else the param id will be ignored), send -2 to disable any existing map for this rc_channel_index.
+	TargetSystem            uint8    `mavlink:"target_system"`              // System ID
+	TargetComponent         uint8    `mavlink:"target_component"`           // Component ID
+	ParamId                 [16]byte `mavlink:"param_id,char"`              // Onboard parameter id, terminated by NULL if the length is less than 16 human-readable chars and WITHOUT null termination (NULL) byte if the length is exactly 16 chars - applications have to provide 16+1 bytes storage if the ID is stored as string
+	ParameterRcChannelIndex uint8    `mavlink:"parameter_rc_channel_index"` // Index of parameter RC channel. Not equal to the RC channel id. Typically correpsonds to a potentiometer-knob on the RC.
+}
+
+func (self *ParamMapRc) MsgID() uint32 {
 	return 50
 }
 
@@ -2416,26 +4792,36 @@ func (self *ParamMapRc) Unpack(p *Packet) error {
 	if len(p.Payload) < 37 {
 		return fmt.Errorf("payload too small")
 	}
-	self.ParamValue0 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Scale = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.ParamValueMin = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.ParamValueMax = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.ParamIndex = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.TargetSystem = uint8(p.Payload[18])
-	self.TargetComponent = uint8(p.Payload[19])
-	copy(self.ParamId[:], p.Payload[20:36])
-	self.ParameterRcChannelIndex = uint8(p.Payload[36])
+	payload := p.Payload
+	self.ParamValue0 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Scale = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.ParamValueMin = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.ParamValueMax = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.ParamIndex = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.TargetSystem = uint8(payload[18])
+	self.TargetComponent = uint8(payload[19])
+	copy(self.ParamId[:], payload[20:36])
+	self.ParameterRcChannelIndex = uint8(payload[36])
 	return nil
 }
 
+func (self *ParamMapRc) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PARAM_MAP_RC", self)
+}
+
+func (self *ParamMapRc) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PARAM_MAP_RC", self, data)
+}
+
 // Request the information of the mission item with the sequence number seq. The response of the system to this message should be a MISSION_ITEM_INT message. http://qgroundcontrol.org/mavlink/waypoint_protocol
 type MissionRequestInt struct {
-	Seq             uint16 // Sequence
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Seq             uint16 `mavlink:"seq"`              // Sequence
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
+	MissionType     uint8  `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
 }
 
-func (self *MissionRequestInt) MsgID() uint8 {
+func (self *MissionRequestInt) MsgID() uint32 {
 	return 51
 }
 
@@ -2444,10 +4830,11 @@ func (self *MissionRequestInt) MsgName() string {
 }
 
 func (self *MissionRequestInt) Pack(p *Packet) error {
-	payload := make([]byte, 4)
+	payload := make([]byte, 5)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Seq))
 	payload[2] = byte(self.TargetSystem)
 	payload[3] = byte(self.TargetComponent)
+	payload[4] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -2458,26 +4845,41 @@ func (self *MissionRequestInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 4 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
+	payload := p.Payload
+	if len(payload) < 5 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 5)
+		copy(payload, p.Payload)
+	}
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
+	self.MissionType = uint8(payload[4])
 	return nil
 }
 
+func (self *MissionRequestInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_REQUEST_INT", self)
+}
+
+func (self *MissionRequestInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_REQUEST_INT", self, data)
+}
+
 // Set a safety zone (volume), which is defined by two corners of a cube. This message can be used to tell the MAV which setpoints/MISSIONs to accept and which to reject. Safety areas are often enforced by national or competition regulations.
 type SafetySetAllowedArea struct {
-	P1x             float32 // x position 1 / Latitude 1
-	P1y             float32 // y position 1 / Longitude 1
-	P1z             float32 // z position 1 / Altitude 1
-	P2x             float32 // x position 2 / Latitude 2
-	P2y             float32 // y position 2 / Longitude 2
-	P2z             float32 // z position 2 / Altitude 2
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	Frame           uint8   // Coordinate frame, as defined by MAV_FRAME enum in mavlink_types.h. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down.
-}
-
-func (self *SafetySetAllowedArea) MsgID() uint8 {
+	P1x             float32 `mavlink:"p1x"`              // x position 1 / Latitude 1
+	P1y             float32 `mavlink:"p1y"`              // y position 1 / Longitude 1
+	P1z             float32 `mavlink:"p1z"`              // z position 1 / Altitude 1
+	P2x             float32 `mavlink:"p2x"`              // x position 2 / Latitude 2
+	P2y             float32 `mavlink:"p2y"`              // y position 2 / Longitude 2
+	P2z             float32 `mavlink:"p2z"`              // z position 2 / Altitude 2
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	Frame           uint8   `mavlink:"frame"`            // Coordinate frame, as defined by MAV_FRAME enum in mavlink_types.h. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down.
+}
+
+func (self *SafetySetAllowedArea) MsgID() uint32 {
 	return 54
 }
 
@@ -2506,30 +4908,39 @@ func (self *SafetySetAllowedArea) Unpack(p *Packet) error {
 	if len(p.Payload) < 27 {
 		return fmt.Errorf("payload too small")
 	}
-	self.P1x = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.P1y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.P1z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.P2x = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.P2y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.P2z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.TargetSystem = uint8(p.Payload[24])
-	self.TargetComponent = uint8(p.Payload[25])
-	self.Frame = uint8(p.Payload[26])
+	payload := p.Payload
+	self.P1x = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.P1y = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.P1z = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.P2x = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.P2y = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.P2z = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.TargetSystem = uint8(payload[24])
+	self.TargetComponent = uint8(payload[25])
+	self.Frame = uint8(payload[26])
 	return nil
 }
 
+func (self *SafetySetAllowedArea) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SAFETY_SET_ALLOWED_AREA", self)
+}
+
+func (self *SafetySetAllowedArea) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SAFETY_SET_ALLOWED_AREA", self, data)
+}
+
 // Read out the safety zone the MAV currently assumes.
 type SafetyAllowedArea struct {
-	P1x   float32 // x position 1 / Latitude 1
-	P1y   float32 // y position 1 / Longitude 1
-	P1z   float32 // z position 1 / Altitude 1
-	P2x   float32 // x position 2 / Latitude 2
-	P2y   float32 // y position 2 / Longitude 2
-	P2z   float32 // z position 2 / Altitude 2
-	Frame uint8   // Coordinate frame, as defined by MAV_FRAME enum in mavlink_types.h. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down.
+	P1x   float32 `mavlink:"p1x"`   // x position 1 / Latitude 1
+	P1y   float32 `mavlink:"p1y"`   // y position 1 / Longitude 1
+	P1z   float32 `mavlink:"p1z"`   // z position 1 / Altitude 1
+	P2x   float32 `mavlink:"p2x"`   // x position 2 / Latitude 2
+	P2y   float32 `mavlink:"p2y"`   // y position 2 / Longitude 2
+	P2z   float32 `mavlink:"p2z"`   // z position 2 / Altitude 2
+	Frame uint8   `mavlink:"frame"` // Coordinate frame, as defined by MAV_FRAME enum in mavlink_types.h. Can be either global, GPS, right-handed with Z axis up or local, right handed, Z axis down.
 }
 
-func (self *SafetyAllowedArea) MsgID() uint8 {
+func (self *SafetyAllowedArea) MsgID() uint32 {
 	return 55
 }
 
@@ -2556,27 +4967,36 @@ func (self *SafetyAllowedArea) Unpack(p *Packet) error {
 	if len(p.Payload) < 25 {
 		return fmt.Errorf("payload too small")
 	}
-	self.P1x = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.P1y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.P1z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.P2x = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.P2y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.P2z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Frame = uint8(p.Payload[24])
+	payload := p.Payload
+	self.P1x = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.P1y = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.P1z = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.P2x = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.P2y = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.P2z = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Frame = uint8(payload[24])
 	return nil
 }
 
+func (self *SafetyAllowedArea) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SAFETY_ALLOWED_AREA", self)
+}
+
+func (self *SafetyAllowedArea) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SAFETY_ALLOWED_AREA", self, data)
+}
+
 // The attitude in the aeronautical frame (right-handed, Z-down, X-front, Y-right), expressed as quaternion. Quaternion order is w, x, y, z and a zero rotation would be expressed as (1 0 0 0).
 type AttitudeQuaternionCov struct {
-	TimeBootMs uint32     // Timestamp (milliseconds since system boot)
-	Q          [4]float32 // Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)
-	Rollspeed  float32    // Roll angular speed (rad/s)
-	Pitchspeed float32    // Pitch angular speed (rad/s)
-	Yawspeed   float32    // Yaw angular speed (rad/s)
-	Covariance [9]float32 // Attitude covariance
+	TimeBootMs uint32     `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Q          [4]float32 `mavlink:"q"`            // Quaternion components, w, x, y, z (1 0 0 0 is the null-rotation)
+	Rollspeed  float32    `mavlink:"rollspeed"`    // Roll angular speed (rad/s)
+	Pitchspeed float32    `mavlink:"pitchspeed"`   // Pitch angular speed (rad/s)
+	Yawspeed   float32    `mavlink:"yawspeed"`     // Yaw angular speed (rad/s)
+	Covariance [9]float32 `mavlink:"covariance"`   // Attitude covariance
 }
 
-func (self *AttitudeQuaternionCov) MsgID() uint8 {
+func (self *AttitudeQuaternionCov) MsgID() uint32 {
 	return 61
 }
 
@@ -2606,32 +5026,41 @@ func (self *AttitudeQuaternionCov) Unpack(p *Packet) error {
 	if len(p.Payload) < 68 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[4+i*4:]))
 	}
-	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	for i := 0; i < len(self.Covariance); i++ {
-		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32+i*4:]))
+		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[32+i*4:]))
 	}
 	return nil
 }
 
+func (self *AttitudeQuaternionCov) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ATTITUDE_QUATERNION_COV", self)
+}
+
+func (self *AttitudeQuaternionCov) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ATTITUDE_QUATERNION_COV", self, data)
+}
+
 // The state of the fixed wing navigation and position controller.
 type NavControllerOutput struct {
-	NavRoll       float32 // Current desired roll in degrees
-	NavPitch      float32 // Current desired pitch in degrees
-	AltError      float32 // Current altitude error in meters
-	AspdError     float32 // Current airspeed error in meters/second
-	XtrackError   float32 // Current crosstrack error on x-y plane in meters
-	NavBearing    int16   // Current desired heading in degrees
-	TargetBearing int16   // Bearing to current MISSION/target in degrees
-	WpDist        uint16  // Distance to active MISSION in meters
+	NavRoll       float32 `mavlink:"nav_roll"`       // Current desired roll in degrees
+	NavPitch      float32 `mavlink:"nav_pitch"`      // Current desired pitch in degrees
+	AltError      float32 `mavlink:"alt_error"`      // Current altitude error in meters
+	AspdError     float32 `mavlink:"aspd_error"`     // Current airspeed error in meters/second
+	XtrackError   float32 `mavlink:"xtrack_error"`   // Current crosstrack error on x-y plane in meters
+	NavBearing    int16   `mavlink:"nav_bearing"`    // Current desired heading in degrees
+	TargetBearing int16   `mavlink:"target_bearing"` // Bearing to current MISSION/target in degrees
+	WpDist        uint16  `mavlink:"wp_dist"`        // Distance to active MISSION in meters
 }
 
-func (self *NavControllerOutput) MsgID() uint8 {
+func (self *NavControllerOutput) MsgID() uint32 {
 	return 62
 }
 
@@ -2659,33 +5088,42 @@ func (self *NavControllerOutput) Unpack(p *Packet) error {
 	if len(p.Payload) < 26 {
 		return fmt.Errorf("payload too small")
 	}
-	self.NavRoll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.NavPitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.AltError = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.AspdError = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.XtrackError = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.NavBearing = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.TargetBearing = int16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.WpDist = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
+	payload := p.Payload
+	self.NavRoll = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.NavPitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.AltError = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.AspdError = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.XtrackError = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.NavBearing = int16(binary.LittleEndian.Uint16(payload[20:]))
+	self.TargetBearing = int16(binary.LittleEndian.Uint16(payload[22:]))
+	self.WpDist = uint16(binary.LittleEndian.Uint16(payload[24:]))
 	return nil
 }
 
+func (self *NavControllerOutput) MarshalJSON() ([]byte, error) {
+	return marshalMessage("NAV_CONTROLLER_OUTPUT", self)
+}
+
+func (self *NavControllerOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("NAV_CONTROLLER_OUTPUT", self, data)
+}
+
 // The filtered global position (e.g. fused GPS and accelerometers). The position is in GPS-frame (right-handed, Z-up). It  is designed as scaled integer message since the resolution of float is not sufficient. NOTE: This message is intended for onboard networks / companion computers and higher-bandwidth links and optimized for accuracy and completeness. Please use the GLOBAL_POSITION_INT message for a minimal subset.
 type GlobalPositionIntCov struct {
-	TimeUtc       uint64      // Timestamp (microseconds since UNIX epoch) in UTC. 0 for unknown. Commonly filled by the precision time source of a GPS receiver.
-	TimeBootMs    uint32      // Timestamp (milliseconds since system boot)
-	Lat           int32       // Latitude, expressed as degrees * 1E7
-	Lon           int32       // Longitude, expressed as degrees * 1E7
-	Alt           int32       // Altitude in meters, expressed as * 1000 (millimeters), above MSL
-	RelativeAlt   int32       // Altitude above ground in meters, expressed as * 1000 (millimeters)
-	Vx            float32     // Ground X Speed (Latitude), expressed as m/s
-	Vy            float32     // Ground Y Speed (Longitude), expressed as m/s
-	Vz            float32     // Ground Z Speed (Altitude), expressed as m/s
-	Covariance    [36]float32 // Covariance matrix (first six entries are the first ROW, next six entries are the second row, etc.)
-	EstimatorType uint8       // Class id of the estimator this estimate originated from.
-}
-
-func (self *GlobalPositionIntCov) MsgID() uint8 {
+	TimeUtc       uint64      `mavlink:"time_utc"`       // Timestamp (microseconds since UNIX epoch) in UTC. 0 for unknown. Commonly filled by the precision time source of a GPS receiver.
+	TimeBootMs    uint32      `mavlink:"time_boot_ms"`   // Timestamp (milliseconds since system boot)
+	Lat           int32       `mavlink:"lat"`            // Latitude, expressed as degrees * 1E7
+	Lon           int32       `mavlink:"lon"`            // Longitude, expressed as degrees * 1E7
+	Alt           int32       `mavlink:"alt"`            // Altitude in meters, expressed as * 1000 (millimeters), above MSL
+	RelativeAlt   int32       `mavlink:"relative_alt"`   // Altitude above ground in meters, expressed as * 1000 (millimeters)
+	Vx            float32     `mavlink:"vx"`             // Ground X Speed (Latitude), expressed as m/s
+	Vy            float32     `mavlink:"vy"`             // Ground Y Speed (Longitude), expressed as m/s
+	Vz            float32     `mavlink:"vz"`             // Ground Z Speed (Altitude), expressed as m/s
+	Covariance    [36]float32 `mavlink:"covariance"`     // Covariance matrix (first six entries are the first ROW, next six entries are the second row, etc.)
+	EstimatorType uint8       `mavlink:"estimator_type"` // Class id of the estimator this estimate originated from.
+}
+
+func (self *GlobalPositionIntCov) MsgID() uint32 {
 	return 63
 }
 
@@ -2718,40 +5156,49 @@ func (self *GlobalPositionIntCov) Unpack(p *Packet) error {
 	if len(p.Payload) < 185 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUtc = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.RelativeAlt = int32(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
+	payload := p.Payload
+	self.TimeUtc = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[20:]))
+	self.RelativeAlt = int32(binary.LittleEndian.Uint32(payload[24:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
 	for i := 0; i < len(self.Covariance); i++ {
-		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40+i*4:]))
+		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[40+i*4:]))
 	}
-	self.EstimatorType = uint8(p.Payload[184])
+	self.EstimatorType = uint8(payload[184])
 	return nil
 }
 
+func (self *GlobalPositionIntCov) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GLOBAL_POSITION_INT_COV", self)
+}
+
+func (self *GlobalPositionIntCov) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GLOBAL_POSITION_INT_COV", self, data)
+}
+
 // The filtered local position (e.g. fused computer vision and accelerometers). Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)
 type LocalPositionNedCov struct {
-	TimeUtc       uint64      // Timestamp (microseconds since UNIX epoch) in UTC. 0 for unknown. Commonly filled by the precision time source of a GPS receiver.
-	TimeBootMs    uint32      // Timestamp (milliseconds since system boot). 0 for system without monotonic timestamp
-	X             float32     // X Position
-	Y             float32     // Y Position
-	Z             float32     // Z Position
-	Vx            float32     // X Speed (m/s)
-	Vy            float32     // Y Speed (m/s)
-	Vz            float32     // Z Speed (m/s)
-	Ax            float32     // X Acceleration (m/s^2)
-	Ay            float32     // Y Acceleration (m/s^2)
-	Az            float32     // Z Acceleration (m/s^2)
-	Covariance    [45]float32 // Covariance matrix upper right triangular (first nine entries are the first ROW, next eight entries are the second row, etc.)
-	EstimatorType uint8       // Class id of the estimator this estimate originated from.
-}
-
-func (self *LocalPositionNedCov) MsgID() uint8 {
+	TimeUtc       uint64      `mavlink:"time_utc"`       // Timestamp (microseconds since UNIX epoch) in UTC. 0 for unknown. Commonly filled by the precision time source of a GPS receiver.
+	TimeBootMs    uint32      `mavlink:"time_boot_ms"`   // Timestamp (milliseconds since system boot). 0 for system without monotonic timestamp
+	X             float32     `mavlink:"x"`              // X Position
+	Y             float32     `mavlink:"y"`              // Y Position
+	Z             float32     `mavlink:"z"`              // Z Position
+	Vx            float32     `mavlink:"vx"`             // X Speed (m/s)
+	Vy            float32     `mavlink:"vy"`             // Y Speed (m/s)
+	Vz            float32     `mavlink:"vz"`             // Z Speed (m/s)
+	Ax            float32     `mavlink:"ax"`             // X Acceleration (m/s^2)
+	Ay            float32     `mavlink:"ay"`             // Y Acceleration (m/s^2)
+	Az            float32     `mavlink:"az"`             // Z Acceleration (m/s^2)
+	Covariance    [45]float32 `mavlink:"covariance"`     // Covariance matrix upper right triangular (first nine entries are the first ROW, next eight entries are the second row, etc.)
+	EstimatorType uint8       `mavlink:"estimator_type"` // Class id of the estimator this estimate originated from.
+}
+
+func (self *LocalPositionNedCov) MsgID() uint32 {
 	return 64
 }
 
@@ -2786,50 +5233,59 @@ func (self *LocalPositionNedCov) Unpack(p *Packet) error {
 	if len(p.Payload) < 229 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUtc = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Ax = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Ay = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.Az = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
+	payload := p.Payload
+	self.TimeUtc = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Ax = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Ay = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.Az = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
 	for i := 0; i < len(self.Covariance); i++ {
-		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48+i*4:]))
+		self.Covariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[48+i*4:]))
 	}
-	self.EstimatorType = uint8(p.Payload[228])
+	self.EstimatorType = uint8(payload[228])
 	return nil
 }
 
+func (self *LocalPositionNedCov) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOCAL_POSITION_NED_COV", self)
+}
+
+func (self *LocalPositionNedCov) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOCAL_POSITION_NED_COV", self, data)
+}
+
 // The PPM values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.
 type RcChannels struct {
-	TimeBootMs uint32 // Timestamp (milliseconds since system boot)
-	Chan1Raw   uint16 // RC channel 1 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan2Raw   uint16 // RC channel 2 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan3Raw   uint16 // RC channel 3 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan4Raw   uint16 // RC channel 4 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan5Raw   uint16 // RC channel 5 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan6Raw   uint16 // RC channel 6 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan7Raw   uint16 // RC channel 7 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan8Raw   uint16 // RC channel 8 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan9Raw   uint16 // RC channel 9 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan10Raw  uint16 // RC channel 10 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan11Raw  uint16 // RC channel 11 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan12Raw  uint16 // RC channel 12 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan13Raw  uint16 // RC channel 13 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan14Raw  uint16 // RC channel 14 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan15Raw  uint16 // RC channel 15 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan16Raw  uint16 // RC channel 16 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan17Raw  uint16 // RC channel 17 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chan18Raw  uint16 // RC channel 18 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
-	Chancount  uint8  // Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in this message. This value should be 0 when no RC channels are available.
-	Rssi       uint8  // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
-}
-
-func (self *RcChannels) MsgID() uint8 {
+	TimeBootMs uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Chan1Raw   uint16 `mavlink:"chan1_raw"`    // RC channel 1 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan2Raw   uint16 `mavlink:"chan2_raw"`    // RC channel 2 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan3Raw   uint16 `mavlink:"chan3_raw"`    // RC channel 3 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan4Raw   uint16 `mavlink:"chan4_raw"`    // RC channel 4 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan5Raw   uint16 `mavlink:"chan5_raw"`    // RC channel 5 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan6Raw   uint16 `mavlink:"chan6_raw"`    // RC channel 6 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan7Raw   uint16 `mavlink:"chan7_raw"`    // RC channel 7 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan8Raw   uint16 `mavlink:"chan8_raw"`    // RC channel 8 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan9Raw   uint16 `mavlink:"chan9_raw"`    // RC channel 9 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan10Raw  uint16 `mavlink:"chan10_raw"`   // RC channel 10 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan11Raw  uint16 `mavlink:"chan11_raw"`   // RC channel 11 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan12Raw  uint16 `mavlink:"chan12_raw"`   // RC channel 12 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan13Raw  uint16 `mavlink:"chan13_raw"`   // RC channel 13 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan14Raw  uint16 `mavlink:"chan14_raw"`   // RC channel 14 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan15Raw  uint16 `mavlink:"chan15_raw"`   // RC channel 15 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan16Raw  uint16 `mavlink:"chan16_raw"`   // RC channel 16 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan17Raw  uint16 `mavlink:"chan17_raw"`   // RC channel 17 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chan18Raw  uint16 `mavlink:"chan18_raw"`   // RC channel 18 value, in microseconds. A value of UINT16_MAX implies the channel is unused.
+	Chancount  uint8  `mavlink:"chancount"`    // Total number of RC channels being received. This can be larger than 18, indicating that more channels are available but not given in this message. This value should be 0 when no RC channels are available.
+	Rssi       uint8  `mavlink:"rssi"`         // Receive signal strength indicator, 0: 0%, 100: 100%, 255: invalid/unknown.
+}
+
+func (self *RcChannels) MsgID() uint32 {
 	return 65
 }
 
@@ -2870,40 +5326,49 @@ func (self *RcChannels) Unpack(p *Packet) error {
 	if len(p.Payload) < 42 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Chan9Raw = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Chan10Raw = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Chan11Raw = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Chan12Raw = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.Chan13Raw = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Chan14Raw = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.Chan15Raw = uint16(binary.LittleEndian.Uint16(p.Payload[32:]))
-	self.Chan16Raw = uint16(binary.LittleEndian.Uint16(p.Payload[34:]))
-	self.Chan17Raw = uint16(binary.LittleEndian.Uint16(p.Payload[36:]))
-	self.Chan18Raw = uint16(binary.LittleEndian.Uint16(p.Payload[38:]))
-	self.Chancount = uint8(p.Payload[40])
-	self.Rssi = uint8(p.Payload[41])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Chan9Raw = uint16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Chan10Raw = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Chan11Raw = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Chan12Raw = uint16(binary.LittleEndian.Uint16(payload[26:]))
+	self.Chan13Raw = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Chan14Raw = uint16(binary.LittleEndian.Uint16(payload[30:]))
+	self.Chan15Raw = uint16(binary.LittleEndian.Uint16(payload[32:]))
+	self.Chan16Raw = uint16(binary.LittleEndian.Uint16(payload[34:]))
+	self.Chan17Raw = uint16(binary.LittleEndian.Uint16(payload[36:]))
+	self.Chan18Raw = uint16(binary.LittleEndian.Uint16(payload[38:]))
+	self.Chancount = uint8(payload[40])
+	self.Rssi = uint8(payload[41])
 	return nil
 }
 
+func (self *RcChannels) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RC_CHANNELS", self)
+}
+
+func (self *RcChannels) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RC_CHANNELS", self, data)
+}
+
 // THIS INTERFACE IS DEPRECATED. USE SET_MESSAGE_INTERVAL INSTEAD.
 type RequestDataStream struct {
-	ReqMessageRate  uint16 // The requested message rate
-	TargetSystem    uint8  // The target requested to send the message stream.
-	TargetComponent uint8  // The target requested to send the message stream.
-	ReqStreamId     uint8  // The ID of the requested data stream
-	StartStop       uint8  // 1 to start sending, 0 to stop sending.
+	ReqMessageRate  uint16 `mavlink:"req_message_rate"` // The requested message rate
+	TargetSystem    uint8  `mavlink:"target_system"`    // The target requested to send the message stream.
+	TargetComponent uint8  `mavlink:"target_component"` // The target requested to send the message stream.
+	ReqStreamId     uint8  `mavlink:"req_stream_id"`    // The ID of the requested data stream
+	StartStop       uint8  `mavlink:"start_stop"`       // 1 to start sending, 0 to stop sending.
 }
 
-func (self *RequestDataStream) MsgID() uint8 {
+func (self *RequestDataStream) MsgID() uint32 {
 	return 66
 }
 
@@ -2928,22 +5393,31 @@ func (self *RequestDataStream) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.ReqMessageRate = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[2])
-	self.TargetComponent = uint8(p.Payload[3])
-	self.ReqStreamId = uint8(p.Payload[4])
-	self.StartStop = uint8(p.Payload[5])
+	payload := p.Payload
+	self.ReqMessageRate = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetSystem = uint8(payload[2])
+	self.TargetComponent = uint8(payload[3])
+	self.ReqStreamId = uint8(payload[4])
+	self.StartStop = uint8(payload[5])
 	return nil
 }
 
+func (self *RequestDataStream) MarshalJSON() ([]byte, error) {
+	return marshalMessage("REQUEST_DATA_STREAM", self)
+}
+
+func (self *RequestDataStream) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("REQUEST_DATA_STREAM", self, data)
+}
+
 // THIS INTERFACE IS DEPRECATED. USE MESSAGE_INTERVAL INSTEAD.
 type DataStream struct {
-	MessageRate uint16 // The message rate
-	StreamId    uint8  // The ID of the requested data stream
-	OnOff       uint8  // 1 stream is enabled, 0 stream is stopped.
+	MessageRate uint16 `mavlink:"message_rate"` // The message rate
+	StreamId    uint8  `mavlink:"stream_id"`    // The ID of the requested data stream
+	OnOff       uint8  `mavlink:"on_off"`       // 1 stream is enabled, 0 stream is stopped.
 }
 
-func (self *DataStream) MsgID() uint8 {
+func (self *DataStream) MsgID() uint32 {
 	return 67
 }
 
@@ -2966,23 +5440,32 @@ func (self *DataStream) Unpack(p *Packet) error {
 	if len(p.Payload) < 4 {
 		return fmt.Errorf("payload too small")
 	}
-	self.MessageRate = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.StreamId = uint8(p.Payload[2])
-	self.OnOff = uint8(p.Payload[3])
+	payload := p.Payload
+	self.MessageRate = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.StreamId = uint8(payload[2])
+	self.OnOff = uint8(payload[3])
 	return nil
 }
 
+func (self *DataStream) MarshalJSON() ([]byte, error) {
+	return marshalMessage("DATA_STREAM", self)
+}
+
+func (self *DataStream) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("DATA_STREAM", self, data)
+}
+
 // This message provides an API for manually controlling the vehicle using standard joystick axes nomenclature, along with a joystick-like input device. Unused axes can be disabled an buttons are also transmit as boolean values of their
 type ManualControl struct {
-	X       int16  // X-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to forward(1000)-backward(-1000) movement on a joystick and the pitch of a vehicle.
-	Y       int16  // Y-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to left(-1000)-right(1000) movement on a joystick and the roll of a vehicle.
-	Z       int16  // Z-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a separate slider movement with maximum being 1000 and minimum being -1000 on a joystick and the thrust of a vehicle. Positive values are positive thrust, negative values are negative thrust.
-	R       int16  // R-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a twisting of the joystick, with counter-clockwise being 1000 and clockwise being -1000, and the yaw of a vehicle.
-	Buttons uint16 // A bitfield corresponding to the joystick buttons' current state, 1 for pressed, 0 for released. The lowest bit corresponds to Button 1.
-	Target  uint8  // The system to be controlled.
+	X       int16  `mavlink:"x"`       // X-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to forward(1000)-backward(-1000) movement on a joystick and the pitch of a vehicle.
+	Y       int16  `mavlink:"y"`       // Y-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to left(-1000)-right(1000) movement on a joystick and the roll of a vehicle.
+	Z       int16  `mavlink:"z"`       // Z-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a separate slider movement with maximum being 1000 and minimum being -1000 on a joystick and the thrust of a vehicle. Positive values are positive thrust, negative values are negative thrust.
+	R       int16  `mavlink:"r"`       // R-axis, normalized to the range [-1000,1000]. A value of INT16_MAX indicates that this axis is invalid. Generally corresponds to a twisting of the joystick, with counter-clockwise being 1000 and clockwise being -1000, and the yaw of a vehicle.
+	Buttons uint16 `mavlink:"buttons"` // A bitfield corresponding to the joystick buttons' current state, 1 for pressed, 0 for released. The lowest bit corresponds to Button 1.
+	Target  uint8  `mavlink:"target"`  // The system to be controlled.
 }
 
-func (self *ManualControl) MsgID() uint8 {
+func (self *ManualControl) MsgID() uint32 {
 	return 69
 }
 
@@ -3008,30 +5491,39 @@ func (self *ManualControl) Unpack(p *Packet) error {
 	if len(p.Payload) < 11 {
 		return fmt.Errorf("payload too small")
 	}
-	self.X = int16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Y = int16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.Z = int16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.R = int16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Buttons = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Target = uint8(p.Payload[10])
+	payload := p.Payload
+	self.X = int16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Y = int16(binary.LittleEndian.Uint16(payload[2:]))
+	self.Z = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.R = int16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Buttons = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Target = uint8(payload[10])
 	return nil
 }
 
+func (self *ManualControl) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MANUAL_CONTROL", self)
+}
+
+func (self *ManualControl) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MANUAL_CONTROL", self, data)
+}
+
 // The RAW values of the RC channels sent to the MAV to override info received from the RC radio. A value of UINT16_MAX means no change to that channel. A value of 0 means control of that channel should be released back to the RC radio. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.
 type RcChannelsOverride struct {
-	Chan1Raw        uint16 // RC channel 1 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan2Raw        uint16 // RC channel 2 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan3Raw        uint16 // RC channel 3 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan4Raw        uint16 // RC channel 4 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan5Raw        uint16 // RC channel 5 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan6Raw        uint16 // RC channel 6 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan7Raw        uint16 // RC channel 7 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	Chan8Raw        uint16 // RC channel 8 value, in microseconds. A value of UINT16_MAX means to ignore this field.
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
-}
-
-func (self *RcChannelsOverride) MsgID() uint8 {
+	Chan1Raw        uint16 `mavlink:"chan1_raw"`        // RC channel 1 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan2Raw        uint16 `mavlink:"chan2_raw"`        // RC channel 2 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan3Raw        uint16 `mavlink:"chan3_raw"`        // RC channel 3 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan4Raw        uint16 `mavlink:"chan4_raw"`        // RC channel 4 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan5Raw        uint16 `mavlink:"chan5_raw"`        // RC channel 5 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan6Raw        uint16 `mavlink:"chan6_raw"`        // RC channel 6 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan7Raw        uint16 `mavlink:"chan7_raw"`        // RC channel 7 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	Chan8Raw        uint16 `mavlink:"chan8_raw"`        // RC channel 8 value, in microseconds. A value of UINT16_MAX means to ignore this field.
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
+}
+
+func (self *RcChannelsOverride) MsgID() uint32 {
 	return 70
 }
 
@@ -3061,39 +5553,50 @@ func (self *RcChannelsOverride) Unpack(p *Packet) error {
 	if len(p.Payload) < 18 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.TargetSystem = uint8(p.Payload[16])
-	self.TargetComponent = uint8(p.Payload[17])
+	payload := p.Payload
+	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(payload[2:]))
+	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.TargetSystem = uint8(payload[16])
+	self.TargetComponent = uint8(payload[17])
 	return nil
 }
 
+func (self *RcChannelsOverride) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RC_CHANNELS_OVERRIDE", self)
+}
+
+func (self *RcChannelsOverride) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RC_CHANNELS_OVERRIDE", self, data)
+}
+
 // Message encoding a mission item. This message is emitted to announce
-//                 the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). See alsohttp://qgroundcontrol.org/mavlink/waypoint_protocol.
+//
+//	the presence of a mission item and to set a mission item on the system. The mission item can be either in x, y, z meters (type: LOCAL) or x:lat, y:lon, z:altitude. Local frame is Z-down, right handed (NED), global frame is Z-up, right handed (ENU). See alsohttp://qgroundcontrol.org/mavlink/waypoint_protocol.
 type MissionItemInt struct {
-	Param1          float32 // PARAM1, see MAV_CMD enum
-	Param2          float32 // PARAM2, see MAV_CMD enum
-	Param3          float32 // PARAM3, see MAV_CMD enum
-	Param4          float32 // PARAM4, see MAV_CMD enum
-	X               int32   // PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7
-	Y               int32   // PARAM6 / y position: local: x position in meters * 1e4, global: longitude in degrees *10^7
-	Z               float32 // PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame.
-	Seq             uint16  // Waypoint ID (sequence number). Starts at zero. Increases monotonically for each waypoint, no gaps in the sequence (0,1,2,3,4).
-	Command         uint16  // The scheduled action for the MISSION. see MAV_CMD in common.xml MAVLink specs
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	Frame           uint8   // The coordinate system of the MISSION. see MAV_FRAME in mavlink_types.h
-	Current         uint8   // false:0, true:1
-	Autocontinue    uint8   // autocontinue to next wp
-}
-
-func (self *MissionItemInt) MsgID() uint8 {
+	Param1          float32 `mavlink:"param1"`           // PARAM1, see MAV_CMD enum
+	Param2          float32 `mavlink:"param2"`           // PARAM2, see MAV_CMD enum
+	Param3          float32 `mavlink:"param3"`           // PARAM3, see MAV_CMD enum
+	Param4          float32 `mavlink:"param4"`           // PARAM4, see MAV_CMD enum
+	X               int32   `mavlink:"x"`                // PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7
+	Y               int32   `mavlink:"y"`                // PARAM6 / y position: local: x position in meters * 1e4, global: longitude in degrees *10^7
+	Z               float32 `mavlink:"z"`                // PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame.
+	Seq             uint16  `mavlink:"seq"`              // Waypoint ID (sequence number). Starts at zero. Increases monotonically for each waypoint, no gaps in the sequence (0,1,2,3,4).
+	Command         uint16  `mavlink:"command"`          // The scheduled action for the MISSION. see MAV_CMD in common.xml MAVLink specs
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	Frame           uint8   `mavlink:"frame"`            // The coordinate system of the MISSION. see MAV_FRAME in mavlink_types.h
+	Current         uint8   `mavlink:"current"`          // false:0, true:1
+	Autocontinue    uint8   `mavlink:"autocontinue"`     // autocontinue to next wp
+	MissionType     uint8   `mavlink:"mission_type"`     // Mission type, see MAV_MISSION_TYPE
+}
+
+func (self *MissionItemInt) MsgID() uint32 {
 	return 73
 }
 
@@ -3102,7 +5605,7 @@ func (self *MissionItemInt) MsgName() string {
 }
 
 func (self *MissionItemInt) Pack(p *Packet) error {
-	payload := make([]byte, 37)
+	payload := make([]byte, 38)
 	binary.LittleEndian.PutUint32(payload[0:], math.Float32bits(self.Param1))
 	binary.LittleEndian.PutUint32(payload[4:], math.Float32bits(self.Param2))
 	binary.LittleEndian.PutUint32(payload[8:], math.Float32bits(self.Param3))
@@ -3117,6 +5620,7 @@ func (self *MissionItemInt) Pack(p *Packet) error {
 	payload[34] = byte(self.Frame)
 	payload[35] = byte(self.Current)
 	payload[36] = byte(self.Autocontinue)
+	payload[37] = byte(self.MissionType)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -3127,34 +5631,49 @@ func (self *MissionItemInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 37 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.X = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Y = int32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Seq = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Command = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.TargetSystem = uint8(p.Payload[32])
-	self.TargetComponent = uint8(p.Payload[33])
-	self.Frame = uint8(p.Payload[34])
-	self.Current = uint8(p.Payload[35])
-	self.Autocontinue = uint8(p.Payload[36])
+	payload := p.Payload
+	if len(payload) < 38 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 38)
+		copy(payload, p.Payload)
+	}
+	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.X = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Y = int32(binary.LittleEndian.Uint32(payload[20:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Seq = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Command = uint16(binary.LittleEndian.Uint16(payload[30:]))
+	self.TargetSystem = uint8(payload[32])
+	self.TargetComponent = uint8(payload[33])
+	self.Frame = uint8(payload[34])
+	self.Current = uint8(payload[35])
+	self.Autocontinue = uint8(payload[36])
+	self.MissionType = uint8(payload[37])
 	return nil
 }
 
+func (self *MissionItemInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MISSION_ITEM_INT", self)
+}
+
+func (self *MissionItemInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MISSION_ITEM_INT", self, data)
+}
+
 // Metrics typically displayed on a HUD for fixed wing aircraft
 type VfrHud struct {
-	Airspeed    float32 // Current airspeed in m/s
-	Groundspeed float32 // Current ground speed in m/s
-	Alt         float32 // Current altitude (MSL), in meters
-	Climb       float32 // Current climb rate in meters/second
-	Heading     int16   // Current heading in degrees, in compass units (0..360, 0=north)
-	Throttle    uint16  // Current throttle setting in integer percent, 0 to 100
+	Airspeed    float32 `mavlink:"airspeed"`    // Current airspeed in m/s
+	Groundspeed float32 `mavlink:"groundspeed"` // Current ground speed in m/s
+	Alt         float32 `mavlink:"alt"`         // Current altitude (MSL), in meters
+	Climb       float32 `mavlink:"climb"`       // Current climb rate in meters/second
+	Heading     int16   `mavlink:"heading"`     // Current heading in degrees, in compass units (0..360, 0=north)
+	Throttle    uint16  `mavlink:"throttle"`    // Current throttle setting in integer percent, 0 to 100
 }
 
-func (self *VfrHud) MsgID() uint8 {
+func (self *VfrHud) MsgID() uint32 {
 	return 74
 }
 
@@ -3180,33 +5699,42 @@ func (self *VfrHud) Unpack(p *Packet) error {
 	if len(p.Payload) < 20 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Groundspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Climb = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Heading = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Throttle = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
+	payload := p.Payload
+	self.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Groundspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Climb = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Heading = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Throttle = uint16(binary.LittleEndian.Uint16(payload[18:]))
 	return nil
 }
 
+func (self *VfrHud) MarshalJSON() ([]byte, error) {
+	return marshalMessage("VFR_HUD", self)
+}
+
+func (self *VfrHud) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("VFR_HUD", self, data)
+}
+
 // Message encoding a command with parameters as scaled integers. Scaling depends on the actual command value.
 type CommandInt struct {
-	Param1          float32 // PARAM1, see MAV_CMD enum
-	Param2          float32 // PARAM2, see MAV_CMD enum
-	Param3          float32 // PARAM3, see MAV_CMD enum
-	Param4          float32 // PARAM4, see MAV_CMD enum
-	X               int32   // PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7
-	Y               int32   // PARAM6 / local: y position in meters * 1e4, global: longitude in degrees * 10^7
-	Z               float32 // PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame.
-	Command         uint16  // The scheduled action for the mission item. see MAV_CMD in common.xml MAVLink specs
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	Frame           uint8   // The coordinate system of the COMMAND. see MAV_FRAME in mavlink_types.h
-	Current         uint8   // false:0, true:1
-	Autocontinue    uint8   // autocontinue to next wp
-}
-
-func (self *CommandInt) MsgID() uint8 {
+	Param1          float32 `mavlink:"param1"`           // PARAM1, see MAV_CMD enum
+	Param2          float32 `mavlink:"param2"`           // PARAM2, see MAV_CMD enum
+	Param3          float32 `mavlink:"param3"`           // PARAM3, see MAV_CMD enum
+	Param4          float32 `mavlink:"param4"`           // PARAM4, see MAV_CMD enum
+	X               int32   `mavlink:"x"`                // PARAM5 / local: x position in meters * 1e4, global: latitude in degrees * 10^7
+	Y               int32   `mavlink:"y"`                // PARAM6 / local: y position in meters * 1e4, global: longitude in degrees * 10^7
+	Z               float32 `mavlink:"z"`                // PARAM7 / z position: global: altitude in meters (relative or absolute, depending on frame.
+	Command         uint16  `mavlink:"command"`          // The scheduled action for the mission item. see MAV_CMD in common.xml MAVLink specs
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	Frame           uint8   `mavlink:"frame"`            // The coordinate system of the COMMAND. see MAV_FRAME in mavlink_types.h
+	Current         uint8   `mavlink:"current"`          // false:0, true:1
+	Autocontinue    uint8   `mavlink:"autocontinue"`     // autocontinue to next wp
+}
+
+func (self *CommandInt) MsgID() uint32 {
 	return 75
 }
 
@@ -3239,38 +5767,47 @@ func (self *CommandInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 35 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.X = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Y = int32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Command = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.TargetSystem = uint8(p.Payload[30])
-	self.TargetComponent = uint8(p.Payload[31])
-	self.Frame = uint8(p.Payload[32])
-	self.Current = uint8(p.Payload[33])
-	self.Autocontinue = uint8(p.Payload[34])
+	payload := p.Payload
+	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.X = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Y = int32(binary.LittleEndian.Uint32(payload[20:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Command = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.TargetSystem = uint8(payload[30])
+	self.TargetComponent = uint8(payload[31])
+	self.Frame = uint8(payload[32])
+	self.Current = uint8(payload[33])
+	self.Autocontinue = uint8(payload[34])
 	return nil
 }
 
+func (self *CommandInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("COMMAND_INT", self)
+}
+
+func (self *CommandInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("COMMAND_INT", self, data)
+}
+
 // Send a command with up to seven parameters to the MAV
 type CommandLong struct {
-	Param1          float32 // Parameter 1, as defined by MAV_CMD enum.
-	Param2          float32 // Parameter 2, as defined by MAV_CMD enum.
-	Param3          float32 // Parameter 3, as defined by MAV_CMD enum.
-	Param4          float32 // Parameter 4, as defined by MAV_CMD enum.
-	Param5          float32 // Parameter 5, as defined by MAV_CMD enum.
-	Param6          float32 // Parameter 6, as defined by MAV_CMD enum.
-	Param7          float32 // Parameter 7, as defined by MAV_CMD enum.
-	Command         uint16  // Command ID, as defined by MAV_CMD enum.
-	TargetSystem    uint8   // System which should execute the command
-	TargetComponent uint8   // Component which should execute the command, 0 for all components
-	Confirmation    uint8   // 0: First transmission of this command. 1-255: Confirmation transmissions (e.g. for kill command)
-}
-
-func (self *CommandLong) MsgID() uint8 {
+	Param1          float32 `mavlink:"param1"`           // Parameter 1, as defined by MAV_CMD enum.
+	Param2          float32 `mavlink:"param2"`           // Parameter 2, as defined by MAV_CMD enum.
+	Param3          float32 `mavlink:"param3"`           // Parameter 3, as defined by MAV_CMD enum.
+	Param4          float32 `mavlink:"param4"`           // Parameter 4, as defined by MAV_CMD enum.
+	Param5          float32 `mavlink:"param5"`           // Parameter 5, as defined by MAV_CMD enum.
+	Param6          float32 `mavlink:"param6"`           // Parameter 6, as defined by MAV_CMD enum.
+	Param7          float32 `mavlink:"param7"`           // Parameter 7, as defined by MAV_CMD enum.
+	Command         uint16  `mavlink:"command"`          // Command ID, as defined by MAV_CMD enum.
+	TargetSystem    uint8   `mavlink:"target_system"`    // System which should execute the command
+	TargetComponent uint8   `mavlink:"target_component"` // Component which should execute the command, 0 for all components
+	Confirmation    uint8   `mavlink:"confirmation"`     // 0: First transmission of this command. 1-255: Confirmation transmissions (e.g. for kill command)
+}
+
+func (self *CommandLong) MsgID() uint32 {
 	return 76
 }
 
@@ -3301,27 +5838,40 @@ func (self *CommandLong) Unpack(p *Packet) error {
 	if len(p.Payload) < 33 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Param5 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Param6 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Param7 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Command = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.TargetSystem = uint8(p.Payload[30])
-	self.TargetComponent = uint8(p.Payload[31])
-	self.Confirmation = uint8(p.Payload[32])
+	payload := p.Payload
+	self.Param1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Param2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Param3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Param4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Param5 = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Param6 = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Param7 = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Command = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.TargetSystem = uint8(payload[30])
+	self.TargetComponent = uint8(payload[31])
+	self.Confirmation = uint8(payload[32])
 	return nil
 }
 
+func (self *CommandLong) MarshalJSON() ([]byte, error) {
+	return marshalMessage("COMMAND_LONG", self)
+}
+
+func (self *CommandLong) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("COMMAND_LONG", self, data)
+}
+
 // Report status of a command. Includes feedback wether the command was executed.
 type CommandAck struct {
-	Command uint16 // Command ID, as defined by MAV_CMD enum.
-	Result  uint8  // See MAV_RESULT enum
+	Command         uint16 `mavlink:"command"`          // Command ID, as defined by MAV_CMD enum.
+	Result          uint8  `mavlink:"result"`           // See MAV_RESULT enum
+	Progress        uint8  `mavlink:"progress"`         // Also used as result_param1, it can be set with a enum containing the errors reasons of why the command was denied or the progress percentage or 255 if unknown the progress when result is MAV_RESULT_IN_PROGRESS.
+	ResultParam2    int32  `mavlink:"result_param2"`    // Additional parameter of the result, example: which parameter of MAV_CMD_NAV_WAYPOINT caused it to be denied.
+	TargetSystem    uint8  `mavlink:"target_system"`    // System which requested the command to be executed
+	TargetComponent uint8  `mavlink:"target_component"` // Component which requested the command to be executed
 }
 
-func (self *CommandAck) MsgID() uint8 {
+func (self *CommandAck) MsgID() uint32 {
 	return 77
 }
 
@@ -3330,9 +5880,13 @@ func (self *CommandAck) MsgName() string {
 }
 
 func (self *CommandAck) Pack(p *Packet) error {
-	payload := make([]byte, 3)
+	payload := make([]byte, 10)
 	binary.LittleEndian.PutUint16(payload[0:], uint16(self.Command))
 	payload[2] = byte(self.Result)
+	payload[3] = byte(self.Progress)
+	binary.LittleEndian.PutUint32(payload[4:], uint32(self.ResultParam2))
+	payload[8] = byte(self.TargetSystem)
+	payload[9] = byte(self.TargetComponent)
 
 	p.MsgID = self.MsgID()
 	p.Payload = payload
@@ -3343,23 +5897,41 @@ func (self *CommandAck) Unpack(p *Packet) error {
 	if len(p.Payload) < 3 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Command = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Result = uint8(p.Payload[2])
+	payload := p.Payload
+	if len(payload) < 10 {
+		// extension fields were not sent, leave them zeroed
+		payload = make([]byte, 10)
+		copy(payload, p.Payload)
+	}
+	self.Command = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Result = uint8(payload[2])
+	self.Progress = uint8(payload[3])
+	self.ResultParam2 = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.TargetSystem = uint8(payload[8])
+	self.TargetComponent = uint8(payload[9])
 	return nil
 }
 
+func (self *CommandAck) MarshalJSON() ([]byte, error) {
+	return marshalMessage("COMMAND_ACK", self)
+}
+
+func (self *CommandAck) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("COMMAND_ACK", self, data)
+}
+
 // Setpoint in roll, pitch, yaw and thrust from the operator
 type ManualSetpoint struct {
-	TimeBootMs           uint32  // Timestamp in milliseconds since system boot
-	Roll                 float32 // Desired roll rate in radians per second
-	Pitch                float32 // Desired pitch rate in radians per second
-	Yaw                  float32 // Desired yaw rate in radians per second
-	Thrust               float32 // Collective thrust, normalized to 0 .. 1
-	ModeSwitch           uint8   // Flight mode switch position, 0.. 255
-	ManualOverrideSwitch uint8   // Override mode switch position, 0.. 255
+	TimeBootMs           uint32  `mavlink:"time_boot_ms"`           // Timestamp in milliseconds since system boot
+	Roll                 float32 `mavlink:"roll"`                   // Desired roll rate in radians per second
+	Pitch                float32 `mavlink:"pitch"`                  // Desired pitch rate in radians per second
+	Yaw                  float32 `mavlink:"yaw"`                    // Desired yaw rate in radians per second
+	Thrust               float32 `mavlink:"thrust"`                 // Collective thrust, normalized to 0 .. 1
+	ModeSwitch           uint8   `mavlink:"mode_switch"`            // Flight mode switch position, 0.. 255
+	ManualOverrideSwitch uint8   `mavlink:"manual_override_switch"` // Override mode switch position, 0.. 255
 }
 
-func (self *ManualSetpoint) MsgID() uint8 {
+func (self *ManualSetpoint) MsgID() uint32 {
 	return 81
 }
 
@@ -3386,30 +5958,39 @@ func (self *ManualSetpoint) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.ModeSwitch = uint8(p.Payload[20])
-	self.ManualOverrideSwitch = uint8(p.Payload[21])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.ModeSwitch = uint8(payload[20])
+	self.ManualOverrideSwitch = uint8(payload[21])
 	return nil
 }
 
+func (self *ManualSetpoint) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MANUAL_SETPOINT", self)
+}
+
+func (self *ManualSetpoint) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MANUAL_SETPOINT", self, data)
+}
+
 // Sets a desired vehicle attitude. Used by an external controller to command the vehicle (manual controller or other system).
 type SetAttitudeTarget struct {
-	TimeBootMs      uint32     // Timestamp in milliseconds since system boot
-	Q               [4]float32 // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
-	BodyRollRate    float32    // Body roll rate in radians per second
-	BodyPitchRate   float32    // Body roll rate in radians per second
-	BodyYawRate     float32    // Body roll rate in radians per second
-	Thrust          float32    // Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse trust)
-	TargetSystem    uint8      // System ID
-	TargetComponent uint8      // Component ID
-	TypeMask        uint8      // Mappings: If any of these bits are set, the corresponding input should be ignored: bit 1: body roll rate, bit 2: body pitch rate, bit 3: body yaw rate. bit 4-bit 6: reserved, bit 7: throttle, bit 8: attitude
-}
-
-func (self *SetAttitudeTarget) MsgID() uint8 {
+	TimeBootMs      uint32     `mavlink:"time_boot_ms"`     // Timestamp in milliseconds since system boot
+	Q               [4]float32 `mavlink:"q"`                // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
+	BodyRollRate    float32    `mavlink:"body_roll_rate"`   // Body roll rate in radians per second
+	BodyPitchRate   float32    `mavlink:"body_pitch_rate"`  // Body roll rate in radians per second
+	BodyYawRate     float32    `mavlink:"body_yaw_rate"`    // Body roll rate in radians per second
+	Thrust          float32    `mavlink:"thrust"`           // Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse trust)
+	TargetSystem    uint8      `mavlink:"target_system"`    // System ID
+	TargetComponent uint8      `mavlink:"target_component"` // Component ID
+	TypeMask        uint8      `mavlink:"type_mask"`        // Mappings: If any of these bits are set, the corresponding input should be ignored: bit 1: body roll rate, bit 2: body pitch rate, bit 3: body yaw rate. bit 4-bit 6: reserved, bit 7: throttle, bit 8: attitude
+}
+
+func (self *SetAttitudeTarget) MsgID() uint32 {
 	return 82
 }
 
@@ -3440,32 +6021,41 @@ func (self *SetAttitudeTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 39 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[4+i*4:]))
 	}
-	self.BodyRollRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.BodyPitchRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.BodyYawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.TargetSystem = uint8(p.Payload[36])
-	self.TargetComponent = uint8(p.Payload[37])
-	self.TypeMask = uint8(p.Payload[38])
+	self.BodyRollRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.BodyPitchRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.BodyYawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.TargetSystem = uint8(payload[36])
+	self.TargetComponent = uint8(payload[37])
+	self.TypeMask = uint8(payload[38])
 	return nil
 }
 
+func (self *SetAttitudeTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_ATTITUDE_TARGET", self)
+}
+
+func (self *SetAttitudeTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_ATTITUDE_TARGET", self, data)
+}
+
 // Reports the current commanded attitude of the vehicle as specified by the autopilot. This should match the commands sent in a SET_ATTITUDE_TARGET message if the vehicle is being controlled this way.
 type AttitudeTarget struct {
-	TimeBootMs    uint32     // Timestamp in milliseconds since system boot
-	Q             [4]float32 // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
-	BodyRollRate  float32    // Body roll rate in radians per second
-	BodyPitchRate float32    // Body roll rate in radians per second
-	BodyYawRate   float32    // Body roll rate in radians per second
-	Thrust        float32    // Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse trust)
-	TypeMask      uint8      // Mappings: If any of these bits are set, the corresponding input should be ignored: bit 1: body roll rate, bit 2: body pitch rate, bit 3: body yaw rate. bit 4-bit 7: reserved, bit 8: attitude
+	TimeBootMs    uint32     `mavlink:"time_boot_ms"`    // Timestamp in milliseconds since system boot
+	Q             [4]float32 `mavlink:"q"`               // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
+	BodyRollRate  float32    `mavlink:"body_roll_rate"`  // Body roll rate in radians per second
+	BodyPitchRate float32    `mavlink:"body_pitch_rate"` // Body roll rate in radians per second
+	BodyYawRate   float32    `mavlink:"body_yaw_rate"`   // Body roll rate in radians per second
+	Thrust        float32    `mavlink:"thrust"`          // Collective thrust, normalized to 0 .. 1 (-1 .. 1 for vehicles capable of reverse trust)
+	TypeMask      uint8      `mavlink:"type_mask"`       // Mappings: If any of these bits are set, the corresponding input should be ignored: bit 1: body roll rate, bit 2: body pitch rate, bit 3: body yaw rate. bit 4-bit 7: reserved, bit 8: attitude
 }
 
-func (self *AttitudeTarget) MsgID() uint8 {
+func (self *AttitudeTarget) MsgID() uint32 {
 	return 83
 }
 
@@ -3494,39 +6084,48 @@ func (self *AttitudeTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 37 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[4+i*4:]))
 	}
-	self.BodyRollRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.BodyPitchRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.BodyYawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.TypeMask = uint8(p.Payload[36])
+	self.BodyRollRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.BodyPitchRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.BodyYawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Thrust = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.TypeMask = uint8(payload[36])
 	return nil
 }
 
+func (self *AttitudeTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ATTITUDE_TARGET", self)
+}
+
+func (self *AttitudeTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ATTITUDE_TARGET", self, data)
+}
+
 // Sets a desired vehicle position in a local north-east-down coordinate frame. Used by an external controller to command the vehicle (manual controller or other system).
 type SetPositionTargetLocalNed struct {
-	TimeBootMs      uint32  // Timestamp in milliseconds since system boot
-	X               float32 // X Position in NED frame in meters
-	Y               float32 // Y Position in NED frame in meters
-	Z               float32 // Z Position in NED frame in meters (note, altitude is negative in NED)
-	Vx              float32 // X velocity in NED frame in meter / s
-	Vy              float32 // Y velocity in NED frame in meter / s
-	Vz              float32 // Z velocity in NED frame in meter / s
-	Afx             float32 // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afy             float32 // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afz             float32 // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Yaw             float32 // yaw setpoint in rad
-	YawRate         float32 // yaw rate setpoint in rad/s
-	TypeMask        uint16  // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	CoordinateFrame uint8   // Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9
-}
-
-func (self *SetPositionTargetLocalNed) MsgID() uint8 {
+	TimeBootMs      uint32  `mavlink:"time_boot_ms"`     // Timestamp in milliseconds since system boot
+	X               float32 `mavlink:"x"`                // X Position in NED frame in meters
+	Y               float32 `mavlink:"y"`                // Y Position in NED frame in meters
+	Z               float32 `mavlink:"z"`                // Z Position in NED frame in meters (note, altitude is negative in NED)
+	Vx              float32 `mavlink:"vx"`               // X velocity in NED frame in meter / s
+	Vy              float32 `mavlink:"vy"`               // Y velocity in NED frame in meter / s
+	Vz              float32 `mavlink:"vz"`               // Z velocity in NED frame in meter / s
+	Afx             float32 `mavlink:"afx"`              // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afy             float32 `mavlink:"afy"`              // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afz             float32 `mavlink:"afz"`              // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Yaw             float32 `mavlink:"yaw"`              // yaw setpoint in rad
+	YawRate         float32 `mavlink:"yaw_rate"`         // yaw rate setpoint in rad/s
+	TypeMask        uint16  `mavlink:"type_mask"`        // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	CoordinateFrame uint8   `mavlink:"coordinate_frame"` // Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9
+}
+
+func (self *SetPositionTargetLocalNed) MsgID() uint32 {
 	return 84
 }
 
@@ -3562,44 +6161,53 @@ func (self *SetPositionTargetLocalNed) Unpack(p *Packet) error {
 	if len(p.Payload) < 53 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.TypeMask = uint16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.TargetSystem = uint8(p.Payload[50])
-	self.TargetComponent = uint8(p.Payload[51])
-	self.CoordinateFrame = uint8(p.Payload[52])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.TypeMask = uint16(binary.LittleEndian.Uint16(payload[48:]))
+	self.TargetSystem = uint8(payload[50])
+	self.TargetComponent = uint8(payload[51])
+	self.CoordinateFrame = uint8(payload[52])
 	return nil
 }
 
+func (self *SetPositionTargetLocalNed) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_POSITION_TARGET_LOCAL_NED", self)
+}
+
+func (self *SetPositionTargetLocalNed) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_POSITION_TARGET_LOCAL_NED", self, data)
+}
+
 // Reports the current commanded vehicle position, velocity, and acceleration as specified by the autopilot. This should match the commands sent in SET_POSITION_TARGET_LOCAL_NED if the vehicle is being controlled this way.
 type PositionTargetLocalNed struct {
-	TimeBootMs      uint32  // Timestamp in milliseconds since system boot
-	X               float32 // X Position in NED frame in meters
-	Y               float32 // Y Position in NED frame in meters
-	Z               float32 // Z Position in NED frame in meters (note, altitude is negative in NED)
-	Vx              float32 // X velocity in NED frame in meter / s
-	Vy              float32 // Y velocity in NED frame in meter / s
-	Vz              float32 // Z velocity in NED frame in meter / s
-	Afx             float32 // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afy             float32 // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afz             float32 // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Yaw             float32 // yaw setpoint in rad
-	YawRate         float32 // yaw rate setpoint in rad/s
-	TypeMask        uint16  // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
-	CoordinateFrame uint8   // Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9
-}
-
-func (self *PositionTargetLocalNed) MsgID() uint8 {
+	TimeBootMs      uint32  `mavlink:"time_boot_ms"`     // Timestamp in milliseconds since system boot
+	X               float32 `mavlink:"x"`                // X Position in NED frame in meters
+	Y               float32 `mavlink:"y"`                // Y Position in NED frame in meters
+	Z               float32 `mavlink:"z"`                // Z Position in NED frame in meters (note, altitude is negative in NED)
+	Vx              float32 `mavlink:"vx"`               // X velocity in NED frame in meter / s
+	Vy              float32 `mavlink:"vy"`               // Y velocity in NED frame in meter / s
+	Vz              float32 `mavlink:"vz"`               // Z velocity in NED frame in meter / s
+	Afx             float32 `mavlink:"afx"`              // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afy             float32 `mavlink:"afy"`              // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afz             float32 `mavlink:"afz"`              // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Yaw             float32 `mavlink:"yaw"`              // yaw setpoint in rad
+	YawRate         float32 `mavlink:"yaw_rate"`         // yaw rate setpoint in rad/s
+	TypeMask        uint16  `mavlink:"type_mask"`        // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
+	CoordinateFrame uint8   `mavlink:"coordinate_frame"` // Valid options are: MAV_FRAME_LOCAL_NED = 1, MAV_FRAME_LOCAL_OFFSET_NED = 7, MAV_FRAME_BODY_NED = 8, MAV_FRAME_BODY_OFFSET_NED = 9
+}
+
+func (self *PositionTargetLocalNed) MsgID() uint32 {
 	return 85
 }
 
@@ -3633,44 +6241,53 @@ func (self *PositionTargetLocalNed) Unpack(p *Packet) error {
 	if len(p.Payload) < 51 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.TypeMask = uint16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.CoordinateFrame = uint8(p.Payload[50])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.TypeMask = uint16(binary.LittleEndian.Uint16(payload[48:]))
+	self.CoordinateFrame = uint8(payload[50])
 	return nil
 }
 
+func (self *PositionTargetLocalNed) MarshalJSON() ([]byte, error) {
+	return marshalMessage("POSITION_TARGET_LOCAL_NED", self)
+}
+
+func (self *PositionTargetLocalNed) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("POSITION_TARGET_LOCAL_NED", self, data)
+}
+
 // Sets a desired vehicle position, velocity, and/or acceleration in a global coordinate system (WGS84). Used by an external controller to command the vehicle (manual controller or other system).
 type SetPositionTargetGlobalInt struct {
-	TimeBootMs      uint32  // Timestamp in milliseconds since system boot. The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency.
-	LatInt          int32   // X Position in WGS84 frame in 1e7 * meters
-	LonInt          int32   // Y Position in WGS84 frame in 1e7 * meters
-	Alt             float32 // Altitude in meters in AMSL altitude, not WGS84 if absolute or relative, above terrain if GLOBAL_TERRAIN_ALT_INT
-	Vx              float32 // X velocity in NED frame in meter / s
-	Vy              float32 // Y velocity in NED frame in meter / s
-	Vz              float32 // Z velocity in NED frame in meter / s
-	Afx             float32 // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afy             float32 // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afz             float32 // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Yaw             float32 // yaw setpoint in rad
-	YawRate         float32 // yaw rate setpoint in rad/s
-	TypeMask        uint16  // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
-	TargetSystem    uint8   // System ID
-	TargetComponent uint8   // Component ID
-	CoordinateFrame uint8   // Valid options are: MAV_FRAME_GLOBAL_INT = 5, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT = 6, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT = 11
-}
-
-func (self *SetPositionTargetGlobalInt) MsgID() uint8 {
+	TimeBootMs      uint32  `mavlink:"time_boot_ms"`     // Timestamp in milliseconds since system boot. The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency.
+	LatInt          int32   `mavlink:"lat_int"`          // X Position in WGS84 frame in 1e7 * meters
+	LonInt          int32   `mavlink:"lon_int"`          // Y Position in WGS84 frame in 1e7 * meters
+	Alt             float32 `mavlink:"alt"`              // Altitude in meters in AMSL altitude, not WGS84 if absolute or relative, above terrain if GLOBAL_TERRAIN_ALT_INT
+	Vx              float32 `mavlink:"vx"`               // X velocity in NED frame in meter / s
+	Vy              float32 `mavlink:"vy"`               // Y velocity in NED frame in meter / s
+	Vz              float32 `mavlink:"vz"`               // Z velocity in NED frame in meter / s
+	Afx             float32 `mavlink:"afx"`              // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afy             float32 `mavlink:"afy"`              // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afz             float32 `mavlink:"afz"`              // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Yaw             float32 `mavlink:"yaw"`              // yaw setpoint in rad
+	YawRate         float32 `mavlink:"yaw_rate"`         // yaw rate setpoint in rad/s
+	TypeMask        uint16  `mavlink:"type_mask"`        // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
+	TargetSystem    uint8   `mavlink:"target_system"`    // System ID
+	TargetComponent uint8   `mavlink:"target_component"` // Component ID
+	CoordinateFrame uint8   `mavlink:"coordinate_frame"` // Valid options are: MAV_FRAME_GLOBAL_INT = 5, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT = 6, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT = 11
+}
+
+func (self *SetPositionTargetGlobalInt) MsgID() uint32 {
 	return 86
 }
 
@@ -3706,44 +6323,53 @@ func (self *SetPositionTargetGlobalInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 53 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.LatInt = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.LonInt = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.TypeMask = uint16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.TargetSystem = uint8(p.Payload[50])
-	self.TargetComponent = uint8(p.Payload[51])
-	self.CoordinateFrame = uint8(p.Payload[52])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.LatInt = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.LonInt = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.TypeMask = uint16(binary.LittleEndian.Uint16(payload[48:]))
+	self.TargetSystem = uint8(payload[50])
+	self.TargetComponent = uint8(payload[51])
+	self.CoordinateFrame = uint8(payload[52])
 	return nil
 }
 
+func (self *SetPositionTargetGlobalInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_POSITION_TARGET_GLOBAL_INT", self)
+}
+
+func (self *SetPositionTargetGlobalInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_POSITION_TARGET_GLOBAL_INT", self, data)
+}
+
 // Reports the current commanded vehicle position, velocity, and acceleration as specified by the autopilot. This should match the commands sent in SET_POSITION_TARGET_GLOBAL_INT if the vehicle is being controlled this way.
 type PositionTargetGlobalInt struct {
-	TimeBootMs      uint32  // Timestamp in milliseconds since system boot. The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency.
-	LatInt          int32   // X Position in WGS84 frame in 1e7 * meters
-	LonInt          int32   // Y Position in WGS84 frame in 1e7 * meters
-	Alt             float32 // Altitude in meters in AMSL altitude, not WGS84 if absolute or relative, above terrain if GLOBAL_TERRAIN_ALT_INT
-	Vx              float32 // X velocity in NED frame in meter / s
-	Vy              float32 // Y velocity in NED frame in meter / s
-	Vz              float32 // Z velocity in NED frame in meter / s
-	Afx             float32 // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afy             float32 // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Afz             float32 // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
-	Yaw             float32 // yaw setpoint in rad
-	YawRate         float32 // yaw rate setpoint in rad/s
-	TypeMask        uint16  // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
-	CoordinateFrame uint8   // Valid options are: MAV_FRAME_GLOBAL_INT = 5, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT = 6, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT = 11
-}
-
-func (self *PositionTargetGlobalInt) MsgID() uint8 {
+	TimeBootMs      uint32  `mavlink:"time_boot_ms"`     // Timestamp in milliseconds since system boot. The rationale for the timestamp in the setpoint is to allow the system to compensate for the transport delay of the setpoint. This allows the system to compensate processing latency.
+	LatInt          int32   `mavlink:"lat_int"`          // X Position in WGS84 frame in 1e7 * meters
+	LonInt          int32   `mavlink:"lon_int"`          // Y Position in WGS84 frame in 1e7 * meters
+	Alt             float32 `mavlink:"alt"`              // Altitude in meters in AMSL altitude, not WGS84 if absolute or relative, above terrain if GLOBAL_TERRAIN_ALT_INT
+	Vx              float32 `mavlink:"vx"`               // X velocity in NED frame in meter / s
+	Vy              float32 `mavlink:"vy"`               // Y velocity in NED frame in meter / s
+	Vz              float32 `mavlink:"vz"`               // Z velocity in NED frame in meter / s
+	Afx             float32 `mavlink:"afx"`              // X acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afy             float32 `mavlink:"afy"`              // Y acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Afz             float32 `mavlink:"afz"`              // Z acceleration or force (if bit 10 of type_mask is set) in NED frame in meter / s^2 or N
+	Yaw             float32 `mavlink:"yaw"`              // yaw setpoint in rad
+	YawRate         float32 `mavlink:"yaw_rate"`         // yaw rate setpoint in rad/s
+	TypeMask        uint16  `mavlink:"type_mask"`        // Bitmask to indicate which dimensions should be ignored by the vehicle: a value of 0b0000000000000000 or 0b0000001000000000 indicates that none of the setpoint dimensions should be ignored. If bit 10 is set the floats afx afy afz should be interpreted as force instead of acceleration. Mapping: bit 1: x, bit 2: y, bit 3: z, bit 4: vx, bit 5: vy, bit 6: vz, bit 7: ax, bit 8: ay, bit 9: az, bit 10: is force setpoint, bit 11: yaw, bit 12: yaw rate
+	CoordinateFrame uint8   `mavlink:"coordinate_frame"` // Valid options are: MAV_FRAME_GLOBAL_INT = 5, MAV_FRAME_GLOBAL_RELATIVE_ALT_INT = 6, MAV_FRAME_GLOBAL_TERRAIN_ALT_INT = 11
+}
+
+func (self *PositionTargetGlobalInt) MsgID() uint32 {
 	return 87
 }
 
@@ -3777,35 +6403,44 @@ func (self *PositionTargetGlobalInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 51 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.LatInt = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.LonInt = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.TypeMask = uint16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.CoordinateFrame = uint8(p.Payload[50])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.LatInt = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.LonInt = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Vx = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Vy = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Vz = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Afx = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Afy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Afz = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.TypeMask = uint16(binary.LittleEndian.Uint16(payload[48:]))
+	self.CoordinateFrame = uint8(payload[50])
 	return nil
 }
 
+func (self *PositionTargetGlobalInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("POSITION_TARGET_GLOBAL_INT", self)
+}
+
+func (self *PositionTargetGlobalInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("POSITION_TARGET_GLOBAL_INT", self, data)
+}
+
 // The offset in X, Y, Z and yaw between the LOCAL_POSITION_NED messages of MAV X and the global coordinate frame in NED coordinates. Coordinate frame is right-handed, Z-axis down (aeronautical frame, NED / north-east-down convention)
 type LocalPositionNedSystemGlobalOffset struct {
-	TimeBootMs uint32  // Timestamp (milliseconds since system boot)
-	X          float32 // X Position
-	Y          float32 // Y Position
-	Z          float32 // Z Position
-	Roll       float32 // Roll
-	Pitch      float32 // Pitch
-	Yaw        float32 // Yaw
+	TimeBootMs uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	X          float32 `mavlink:"x"`            // X Position
+	Y          float32 `mavlink:"y"`            // Y Position
+	Z          float32 `mavlink:"z"`            // Z Position
+	Roll       float32 `mavlink:"roll"`         // Roll
+	Pitch      float32 `mavlink:"pitch"`        // Pitch
+	Yaw        float32 `mavlink:"yaw"`          // Yaw
 }
 
-func (self *LocalPositionNedSystemGlobalOffset) MsgID() uint8 {
+func (self *LocalPositionNedSystemGlobalOffset) MsgID() uint32 {
 	return 89
 }
 
@@ -3832,37 +6467,46 @@ func (self *LocalPositionNedSystemGlobalOffset) Unpack(p *Packet) error {
 	if len(p.Payload) < 28 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
 	return nil
 }
 
+func (self *LocalPositionNedSystemGlobalOffset) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET", self)
+}
+
+func (self *LocalPositionNedSystemGlobalOffset) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET", self, data)
+}
+
 // DEPRECATED PACKET! Suffers from missing airspeed fields and singularities due to Euler angles. Please use HIL_STATE_QUATERNION instead. Sent from simulation to autopilot. This packet is useful for high throughput applications such as hardware in the loop simulations.
 type HilState struct {
-	TimeUsec   uint64  // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Roll       float32 // Roll angle (rad)
-	Pitch      float32 // Pitch angle (rad)
-	Yaw        float32 // Yaw angle (rad)
-	Rollspeed  float32 // Body frame roll / phi angular speed (rad/s)
-	Pitchspeed float32 // Body frame pitch / theta angular speed (rad/s)
-	Yawspeed   float32 // Body frame yaw / psi angular speed (rad/s)
-	Lat        int32   // Latitude, expressed as * 1E7
-	Lon        int32   // Longitude, expressed as * 1E7
-	Alt        int32   // Altitude in meters, expressed as * 1000 (millimeters)
-	Vx         int16   // Ground X Speed (Latitude), expressed as m/s * 100
-	Vy         int16   // Ground Y Speed (Longitude), expressed as m/s * 100
-	Vz         int16   // Ground Z Speed (Altitude), expressed as m/s * 100
-	Xacc       int16   // X acceleration (mg)
-	Yacc       int16   // Y acceleration (mg)
-	Zacc       int16   // Z acceleration (mg)
-}
-
-func (self *HilState) MsgID() uint8 {
+	TimeUsec   uint64  `mavlink:"time_usec"`  // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Roll       float32 `mavlink:"roll"`       // Roll angle (rad)
+	Pitch      float32 `mavlink:"pitch"`      // Pitch angle (rad)
+	Yaw        float32 `mavlink:"yaw"`        // Yaw angle (rad)
+	Rollspeed  float32 `mavlink:"rollspeed"`  // Body frame roll / phi angular speed (rad/s)
+	Pitchspeed float32 `mavlink:"pitchspeed"` // Body frame pitch / theta angular speed (rad/s)
+	Yawspeed   float32 `mavlink:"yawspeed"`   // Body frame yaw / psi angular speed (rad/s)
+	Lat        int32   `mavlink:"lat"`        // Latitude, expressed as * 1E7
+	Lon        int32   `mavlink:"lon"`        // Longitude, expressed as * 1E7
+	Alt        int32   `mavlink:"alt"`        // Altitude in meters, expressed as * 1000 (millimeters)
+	Vx         int16   `mavlink:"vx"`         // Ground X Speed (Latitude), expressed as m/s * 100
+	Vy         int16   `mavlink:"vy"`         // Ground Y Speed (Longitude), expressed as m/s * 100
+	Vz         int16   `mavlink:"vz"`         // Ground Z Speed (Altitude), expressed as m/s * 100
+	Xacc       int16   `mavlink:"xacc"`       // X acceleration (mg)
+	Yacc       int16   `mavlink:"yacc"`       // Y acceleration (mg)
+	Zacc       int16   `mavlink:"zacc"`       // Z acceleration (mg)
+}
+
+func (self *HilState) MsgID() uint32 {
 	return 90
 }
 
@@ -3898,41 +6542,50 @@ func (self *HilState) Unpack(p *Packet) error {
 	if len(p.Payload) < 56 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.Vx = int16(binary.LittleEndian.Uint16(p.Payload[44:]))
-	self.Vy = int16(binary.LittleEndian.Uint16(p.Payload[46:]))
-	self.Vz = int16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[50:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[52:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[54:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[32:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[36:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[40:]))
+	self.Vx = int16(binary.LittleEndian.Uint16(payload[44:]))
+	self.Vy = int16(binary.LittleEndian.Uint16(payload[46:]))
+	self.Vz = int16(binary.LittleEndian.Uint16(payload[48:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[50:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[52:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[54:]))
 	return nil
 }
 
+func (self *HilState) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_STATE", self)
+}
+
+func (self *HilState) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_STATE", self, data)
+}
+
 // Sent from autopilot to simulation. Hardware in the loop control outputs
 type HilControls struct {
-	TimeUsec      uint64  // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	RollAilerons  float32 // Control output -1 .. 1
-	PitchElevator float32 // Control output -1 .. 1
-	YawRudder     float32 // Control output -1 .. 1
-	Throttle      float32 // Throttle 0 .. 1
-	Aux1          float32 // Aux 1, -1 .. 1
-	Aux2          float32 // Aux 2, -1 .. 1
-	Aux3          float32 // Aux 3, -1 .. 1
-	Aux4          float32 // Aux 4, -1 .. 1
-	Mode          uint8   // System mode (MAV_MODE)
-	NavMode       uint8   // Navigation mode (MAV_NAV_MODE)
-}
-
-func (self *HilControls) MsgID() uint8 {
+	TimeUsec      uint64  `mavlink:"time_usec"`      // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	RollAilerons  float32 `mavlink:"roll_ailerons"`  // Control output -1 .. 1
+	PitchElevator float32 `mavlink:"pitch_elevator"` // Control output -1 .. 1
+	YawRudder     float32 `mavlink:"yaw_rudder"`     // Control output -1 .. 1
+	Throttle      float32 `mavlink:"throttle"`       // Throttle 0 .. 1
+	Aux1          float32 `mavlink:"aux1"`           // Aux 1, -1 .. 1
+	Aux2          float32 `mavlink:"aux2"`           // Aux 2, -1 .. 1
+	Aux3          float32 `mavlink:"aux3"`           // Aux 3, -1 .. 1
+	Aux4          float32 `mavlink:"aux4"`           // Aux 4, -1 .. 1
+	Mode          uint8   `mavlink:"mode"`           // System mode (MAV_MODE)
+	NavMode       uint8   `mavlink:"nav_mode"`       // Navigation mode (MAV_NAV_MODE)
+}
+
+func (self *HilControls) MsgID() uint32 {
 	return 91
 }
 
@@ -3963,39 +6616,48 @@ func (self *HilControls) Unpack(p *Packet) error {
 	if len(p.Payload) < 42 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.RollAilerons = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.PitchElevator = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.YawRudder = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Throttle = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Aux1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Aux2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Aux3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Aux4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Mode = uint8(p.Payload[40])
-	self.NavMode = uint8(p.Payload[41])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.RollAilerons = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.PitchElevator = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.YawRudder = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Throttle = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Aux1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Aux2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Aux3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Aux4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Mode = uint8(payload[40])
+	self.NavMode = uint8(payload[41])
 	return nil
 }
 
+func (self *HilControls) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_CONTROLS", self)
+}
+
+func (self *HilControls) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_CONTROLS", self, data)
+}
+
 // Sent from simulation to autopilot. The RAW values of the RC channels received. The standard PPM modulation is as follows: 1000 microseconds: 0%, 2000 microseconds: 100%. Individual receivers/transmitters might violate this specification.
 type HilRcInputsRaw struct {
-	TimeUsec  uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Chan1Raw  uint16 // RC channel 1 value, in microseconds
-	Chan2Raw  uint16 // RC channel 2 value, in microseconds
-	Chan3Raw  uint16 // RC channel 3 value, in microseconds
-	Chan4Raw  uint16 // RC channel 4 value, in microseconds
-	Chan5Raw  uint16 // RC channel 5 value, in microseconds
-	Chan6Raw  uint16 // RC channel 6 value, in microseconds
-	Chan7Raw  uint16 // RC channel 7 value, in microseconds
-	Chan8Raw  uint16 // RC channel 8 value, in microseconds
-	Chan9Raw  uint16 // RC channel 9 value, in microseconds
-	Chan10Raw uint16 // RC channel 10 value, in microseconds
-	Chan11Raw uint16 // RC channel 11 value, in microseconds
-	Chan12Raw uint16 // RC channel 12 value, in microseconds
-	Rssi      uint8  // Receive signal strength indicator, 0: 0%, 255: 100%
-}
-
-func (self *HilRcInputsRaw) MsgID() uint8 {
+	TimeUsec  uint64 `mavlink:"time_usec"`  // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Chan1Raw  uint16 `mavlink:"chan1_raw"`  // RC channel 1 value, in microseconds
+	Chan2Raw  uint16 `mavlink:"chan2_raw"`  // RC channel 2 value, in microseconds
+	Chan3Raw  uint16 `mavlink:"chan3_raw"`  // RC channel 3 value, in microseconds
+	Chan4Raw  uint16 `mavlink:"chan4_raw"`  // RC channel 4 value, in microseconds
+	Chan5Raw  uint16 `mavlink:"chan5_raw"`  // RC channel 5 value, in microseconds
+	Chan6Raw  uint16 `mavlink:"chan6_raw"`  // RC channel 6 value, in microseconds
+	Chan7Raw  uint16 `mavlink:"chan7_raw"`  // RC channel 7 value, in microseconds
+	Chan8Raw  uint16 `mavlink:"chan8_raw"`  // RC channel 8 value, in microseconds
+	Chan9Raw  uint16 `mavlink:"chan9_raw"`  // RC channel 9 value, in microseconds
+	Chan10Raw uint16 `mavlink:"chan10_raw"` // RC channel 10 value, in microseconds
+	Chan11Raw uint16 `mavlink:"chan11_raw"` // RC channel 11 value, in microseconds
+	Chan12Raw uint16 `mavlink:"chan12_raw"` // RC channel 12 value, in microseconds
+	Rssi      uint8  `mavlink:"rssi"`       // Receive signal strength indicator, 0: 0%, 255: 100%
+}
+
+func (self *HilRcInputsRaw) MsgID() uint32 {
 	return 92
 }
 
@@ -4029,36 +6691,45 @@ func (self *HilRcInputsRaw) Unpack(p *Packet) error {
 	if len(p.Payload) < 33 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Chan9Raw = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Chan10Raw = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.Chan11Raw = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Chan12Raw = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.Rssi = uint8(p.Payload[32])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Chan1Raw = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Chan2Raw = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Chan3Raw = uint16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Chan4Raw = uint16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Chan5Raw = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Chan6Raw = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Chan7Raw = uint16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Chan8Raw = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Chan9Raw = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Chan10Raw = uint16(binary.LittleEndian.Uint16(payload[26:]))
+	self.Chan11Raw = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Chan12Raw = uint16(binary.LittleEndian.Uint16(payload[30:]))
+	self.Rssi = uint8(payload[32])
 	return nil
 }
 
+func (self *HilRcInputsRaw) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_RC_INPUTS_RAW", self)
+}
+
+func (self *HilRcInputsRaw) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_RC_INPUTS_RAW", self, data)
+}
+
 // Optical flow from a flow sensor (e.g. optical mouse sensor)
 type OpticalFlow struct {
-	TimeUsec       uint64  // Timestamp (UNIX)
-	FlowCompMX     float32 // Flow in meters in x-sensor direction, angular-speed compensated
-	FlowCompMY     float32 // Flow in meters in y-sensor direction, angular-speed compensated
-	GroundDistance float32 // Ground distance in meters. Positive value: distance known. Negative value: Unknown distance
-	FlowX          int16   // Flow in pixels * 10 in x-sensor direction (dezi-pixels)
-	FlowY          int16   // Flow in pixels * 10 in y-sensor direction (dezi-pixels)
-	SensorId       uint8   // Sensor ID
-	Quality        uint8   // Optical flow quality / confidence. 0: bad, 255: maximum quality
+	TimeUsec       uint64  `mavlink:"time_usec"`       // Timestamp (UNIX)
+	FlowCompMX     float32 `mavlink:"flow_comp_m_x"`   // Flow in meters in x-sensor direction, angular-speed compensated
+	FlowCompMY     float32 `mavlink:"flow_comp_m_y"`   // Flow in meters in y-sensor direction, angular-speed compensated
+	GroundDistance float32 `mavlink:"ground_distance"` // Ground distance in meters. Positive value: distance known. Negative value: Unknown distance
+	FlowX          int16   `mavlink:"flow_x"`          // Flow in pixels * 10 in x-sensor direction (dezi-pixels)
+	FlowY          int16   `mavlink:"flow_y"`          // Flow in pixels * 10 in y-sensor direction (dezi-pixels)
+	SensorId       uint8   `mavlink:"sensor_id"`       // Sensor ID
+	Quality        uint8   `mavlink:"quality"`         // Optical flow quality / confidence. 0: bad, 255: maximum quality
 }
 
-func (self *OpticalFlow) MsgID() uint8 {
+func (self *OpticalFlow) MsgID() uint32 {
 	return 100
 }
 
@@ -4086,29 +6757,37 @@ func (self *OpticalFlow) Unpack(p *Packet) error {
 	if len(p.Payload) < 26 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.FlowCompMX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.FlowCompMY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.GroundDistance = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.FlowX = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.FlowY = int16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.SensorId = uint8(p.Payload[24])
-	self.Quality = uint8(p.Payload[25])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.FlowCompMX = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.FlowCompMY = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.GroundDistance = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.FlowX = int16(binary.LittleEndian.Uint16(payload[20:]))
+	self.FlowY = int16(binary.LittleEndian.Uint16(payload[22:]))
+	self.SensorId = uint8(payload[24])
+	self.Quality = uint8(payload[25])
 	return nil
 }
 
-//
+func (self *OpticalFlow) MarshalJSON() ([]byte, error) {
+	return marshalMessage("OPTICAL_FLOW", self)
+}
+
+func (self *OpticalFlow) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("OPTICAL_FLOW", self, data)
+}
+
 type GlobalVisionPositionEstimate struct {
-	Usec  uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	X     float32 // Global X position
-	Y     float32 // Global Y position
-	Z     float32 // Global Z position
-	Roll  float32 // Roll angle in rad
-	Pitch float32 // Pitch angle in rad
-	Yaw   float32 // Yaw angle in rad
+	Usec  uint64  `mavlink:"usec"`  // Timestamp (microseconds, synced to UNIX time or since system boot)
+	X     float32 `mavlink:"x"`     // Global X position
+	Y     float32 `mavlink:"y"`     // Global Y position
+	Z     float32 `mavlink:"z"`     // Global Z position
+	Roll  float32 `mavlink:"roll"`  // Roll angle in rad
+	Pitch float32 `mavlink:"pitch"` // Pitch angle in rad
+	Yaw   float32 `mavlink:"yaw"`   // Yaw angle in rad
 }
 
-func (self *GlobalVisionPositionEstimate) MsgID() uint8 {
+func (self *GlobalVisionPositionEstimate) MsgID() uint32 {
 	return 101
 }
 
@@ -4135,28 +6814,36 @@ func (self *GlobalVisionPositionEstimate) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Usec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.Usec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
-//
+func (self *GlobalVisionPositionEstimate) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GLOBAL_VISION_POSITION_ESTIMATE", self)
+}
+
+func (self *GlobalVisionPositionEstimate) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GLOBAL_VISION_POSITION_ESTIMATE", self, data)
+}
+
 type VisionPositionEstimate struct {
-	Usec  uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	X     float32 // Global X position
-	Y     float32 // Global Y position
-	Z     float32 // Global Z position
-	Roll  float32 // Roll angle in rad
-	Pitch float32 // Pitch angle in rad
-	Yaw   float32 // Yaw angle in rad
+	Usec  uint64  `mavlink:"usec"`  // Timestamp (microseconds, synced to UNIX time or since system boot)
+	X     float32 `mavlink:"x"`     // Global X position
+	Y     float32 `mavlink:"y"`     // Global Y position
+	Z     float32 `mavlink:"z"`     // Global Z position
+	Roll  float32 `mavlink:"roll"`  // Roll angle in rad
+	Pitch float32 `mavlink:"pitch"` // Pitch angle in rad
+	Yaw   float32 `mavlink:"yaw"`   // Yaw angle in rad
 }
 
-func (self *VisionPositionEstimate) MsgID() uint8 {
+func (self *VisionPositionEstimate) MsgID() uint32 {
 	return 102
 }
 
@@ -4183,25 +6870,33 @@ func (self *VisionPositionEstimate) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Usec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.Usec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
-//
+func (self *VisionPositionEstimate) MarshalJSON() ([]byte, error) {
+	return marshalMessage("VISION_POSITION_ESTIMATE", self)
+}
+
+func (self *VisionPositionEstimate) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("VISION_POSITION_ESTIMATE", self, data)
+}
+
 type VisionSpeedEstimate struct {
-	Usec uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	X    float32 // Global X speed
-	Y    float32 // Global Y speed
-	Z    float32 // Global Z speed
+	Usec uint64  `mavlink:"usec"` // Timestamp (microseconds, synced to UNIX time or since system boot)
+	X    float32 `mavlink:"x"`    // Global X speed
+	Y    float32 `mavlink:"y"`    // Global Y speed
+	Z    float32 `mavlink:"z"`    // Global Z speed
 }
 
-func (self *VisionSpeedEstimate) MsgID() uint8 {
+func (self *VisionSpeedEstimate) MsgID() uint32 {
 	return 103
 }
 
@@ -4225,25 +6920,33 @@ func (self *VisionSpeedEstimate) Unpack(p *Packet) error {
 	if len(p.Payload) < 20 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Usec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
+	payload := p.Payload
+	self.Usec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
 	return nil
 }
 
-//
+func (self *VisionSpeedEstimate) MarshalJSON() ([]byte, error) {
+	return marshalMessage("VISION_SPEED_ESTIMATE", self)
+}
+
+func (self *VisionSpeedEstimate) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("VISION_SPEED_ESTIMATE", self, data)
+}
+
 type ViconPositionEstimate struct {
-	Usec  uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	X     float32 // Global X position
-	Y     float32 // Global Y position
-	Z     float32 // Global Z position
-	Roll  float32 // Roll angle in rad
-	Pitch float32 // Pitch angle in rad
-	Yaw   float32 // Yaw angle in rad
+	Usec  uint64  `mavlink:"usec"`  // Timestamp (microseconds, synced to UNIX time or since system boot)
+	X     float32 `mavlink:"x"`     // Global X position
+	Y     float32 `mavlink:"y"`     // Global Y position
+	Z     float32 `mavlink:"z"`     // Global Z position
+	Roll  float32 `mavlink:"roll"`  // Roll angle in rad
+	Pitch float32 `mavlink:"pitch"` // Pitch angle in rad
+	Yaw   float32 `mavlink:"yaw"`   // Yaw angle in rad
 }
 
-func (self *ViconPositionEstimate) MsgID() uint8 {
+func (self *ViconPositionEstimate) MsgID() uint32 {
 	return 104
 }
 
@@ -4270,36 +6973,45 @@ func (self *ViconPositionEstimate) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Usec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.Usec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
+func (self *ViconPositionEstimate) MarshalJSON() ([]byte, error) {
+	return marshalMessage("VICON_POSITION_ESTIMATE", self)
+}
+
+func (self *ViconPositionEstimate) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("VICON_POSITION_ESTIMATE", self, data)
+}
+
 // The IMU readings in SI units in NED body frame
 type HighresImu struct {
-	TimeUsec      uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	Xacc          float32 // X acceleration (m/s^2)
-	Yacc          float32 // Y acceleration (m/s^2)
-	Zacc          float32 // Z acceleration (m/s^2)
-	Xgyro         float32 // Angular speed around X axis (rad / sec)
-	Ygyro         float32 // Angular speed around Y axis (rad / sec)
-	Zgyro         float32 // Angular speed around Z axis (rad / sec)
-	Xmag          float32 // X Magnetic field (Gauss)
-	Ymag          float32 // Y Magnetic field (Gauss)
-	Zmag          float32 // Z Magnetic field (Gauss)
-	AbsPressure   float32 // Absolute pressure in millibar
-	DiffPressure  float32 // Differential pressure in millibar
-	PressureAlt   float32 // Altitude calculated from pressure
-	Temperature   float32 // Temperature in degrees celsius
-	FieldsUpdated uint16  // Bitmask for fields that have updated since last message, bit 0 = xacc, bit 12: temperature
-}
-
-func (self *HighresImu) MsgID() uint8 {
+	TimeUsec      uint64  `mavlink:"time_usec"`      // Timestamp (microseconds, synced to UNIX time or since system boot)
+	Xacc          float32 `mavlink:"xacc"`           // X acceleration (m/s^2)
+	Yacc          float32 `mavlink:"yacc"`           // Y acceleration (m/s^2)
+	Zacc          float32 `mavlink:"zacc"`           // Z acceleration (m/s^2)
+	Xgyro         float32 `mavlink:"xgyro"`          // Angular speed around X axis (rad / sec)
+	Ygyro         float32 `mavlink:"ygyro"`          // Angular speed around Y axis (rad / sec)
+	Zgyro         float32 `mavlink:"zgyro"`          // Angular speed around Z axis (rad / sec)
+	Xmag          float32 `mavlink:"xmag"`           // X Magnetic field (Gauss)
+	Ymag          float32 `mavlink:"ymag"`           // Y Magnetic field (Gauss)
+	Zmag          float32 `mavlink:"zmag"`           // Z Magnetic field (Gauss)
+	AbsPressure   float32 `mavlink:"abs_pressure"`   // Absolute pressure in millibar
+	DiffPressure  float32 `mavlink:"diff_pressure"`  // Differential pressure in millibar
+	PressureAlt   float32 `mavlink:"pressure_alt"`   // Altitude calculated from pressure
+	Temperature   float32 `mavlink:"temperature"`    // Temperature in degrees celsius
+	FieldsUpdated uint16  `mavlink:"fields_updated"` // Bitmask for fields that have updated since last message, bit 0 = xacc, bit 12: temperature
+}
+
+func (self *HighresImu) MsgID() uint32 {
 	return 105
 }
 
@@ -4334,41 +7046,50 @@ func (self *HighresImu) Unpack(p *Packet) error {
 	if len(p.Payload) < 62 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Xmag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Ymag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Zmag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.AbsPressure = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.DiffPressure = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
-	self.PressureAlt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[52:]))
-	self.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[56:]))
-	self.FieldsUpdated = uint16(binary.LittleEndian.Uint16(p.Payload[60:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Xmag = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Ymag = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Zmag = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.AbsPressure = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.DiffPressure = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
+	self.PressureAlt = math.Float32frombits(binary.LittleEndian.Uint32(payload[52:]))
+	self.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(payload[56:]))
+	self.FieldsUpdated = uint16(binary.LittleEndian.Uint16(payload[60:]))
 	return nil
 }
 
+func (self *HighresImu) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIGHRES_IMU", self)
+}
+
+func (self *HighresImu) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIGHRES_IMU", self, data)
+}
+
 // Optical flow from an angular rate flow sensor (e.g. PX4FLOW or mouse sensor)
 type OpticalFlowRad struct {
-	TimeUsec            uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	IntegrationTimeUs   uint32  // Integration time in microseconds. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the.
-	IntegratedX         float32 // Flow in radians around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)
-	IntegratedY         float32 // Flow in radians around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)
-	IntegratedXgyro     float32 // RH rotation around X axis (rad)
-	IntegratedYgyro     float32 // RH rotation around Y axis (rad)
-	IntegratedZgyro     float32 // RH rotation around Z axis (rad)
-	TimeDeltaDistanceUs uint32  // Time in microseconds since the distance was sampled.
-	Distance            float32 // Distance to the center of the flow field in meters. Positive value (including zero): distance known. Negative value: Unknown distance.
-	Temperature         int16   // Temperature * 100 in centi-degrees Celsius
-	SensorId            uint8   // Sensor ID
-	Quality             uint8   // Optical flow quality / confidence. 0: no valid flow, 255: maximum quality
-}
-
-func (self *OpticalFlowRad) MsgID() uint8 {
+	TimeUsec            uint64  `mavlink:"time_usec"`              // Timestamp (microseconds, synced to UNIX time or since system boot)
+	IntegrationTimeUs   uint32  `mavlink:"integration_time_us"`    // Integration time in microseconds. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the.
+	IntegratedX         float32 `mavlink:"integrated_x"`           // Flow in radians around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)
+	IntegratedY         float32 `mavlink:"integrated_y"`           // Flow in radians around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)
+	IntegratedXgyro     float32 `mavlink:"integrated_xgyro"`       // RH rotation around X axis (rad)
+	IntegratedYgyro     float32 `mavlink:"integrated_ygyro"`       // RH rotation around Y axis (rad)
+	IntegratedZgyro     float32 `mavlink:"integrated_zgyro"`       // RH rotation around Z axis (rad)
+	TimeDeltaDistanceUs uint32  `mavlink:"time_delta_distance_us"` // Time in microseconds since the distance was sampled.
+	Distance            float32 `mavlink:"distance"`               // Distance to the center of the flow field in meters. Positive value (including zero): distance known. Negative value: Unknown distance.
+	Temperature         int16   `mavlink:"temperature"`            // Temperature * 100 in centi-degrees Celsius
+	SensorId            uint8   `mavlink:"sensor_id"`              // Sensor ID
+	Quality             uint8   `mavlink:"quality"`                // Optical flow quality / confidence. 0: no valid flow, 255: maximum quality
+}
+
+func (self *OpticalFlowRad) MsgID() uint32 {
 	return 106
 }
 
@@ -4400,41 +7121,50 @@ func (self *OpticalFlowRad) Unpack(p *Packet) error {
 	if len(p.Payload) < 44 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.IntegrationTimeUs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.IntegratedX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.IntegratedY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.IntegratedXgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.IntegratedYgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.IntegratedZgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.TimeDeltaDistanceUs = uint32(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[40:]))
-	self.SensorId = uint8(p.Payload[42])
-	self.Quality = uint8(p.Payload[43])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.IntegrationTimeUs = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.IntegratedX = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.IntegratedY = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.IntegratedXgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.IntegratedYgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.IntegratedZgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.TimeDeltaDistanceUs = uint32(binary.LittleEndian.Uint32(payload[32:]))
+	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[40:]))
+	self.SensorId = uint8(payload[42])
+	self.Quality = uint8(payload[43])
 	return nil
 }
 
+func (self *OpticalFlowRad) MarshalJSON() ([]byte, error) {
+	return marshalMessage("OPTICAL_FLOW_RAD", self)
+}
+
+func (self *OpticalFlowRad) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("OPTICAL_FLOW_RAD", self, data)
+}
+
 // The IMU readings in SI units in NED body frame
 type HilSensor struct {
-	TimeUsec      uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	Xacc          float32 // X acceleration (m/s^2)
-	Yacc          float32 // Y acceleration (m/s^2)
-	Zacc          float32 // Z acceleration (m/s^2)
-	Xgyro         float32 // Angular speed around X axis in body frame (rad / sec)
-	Ygyro         float32 // Angular speed around Y axis in body frame (rad / sec)
-	Zgyro         float32 // Angular speed around Z axis in body frame (rad / sec)
-	Xmag          float32 // X Magnetic field (Gauss)
-	Ymag          float32 // Y Magnetic field (Gauss)
-	Zmag          float32 // Z Magnetic field (Gauss)
-	AbsPressure   float32 // Absolute pressure in millibar
-	DiffPressure  float32 // Differential pressure (airspeed) in millibar
-	PressureAlt   float32 // Altitude calculated from pressure
-	Temperature   float32 // Temperature in degrees celsius
-	FieldsUpdated uint32  // Bitmask for fields that have updated since last message, bit 0 = xacc, bit 12: temperature, bit 31: full reset of attitude/position/velocities/etc was performed in sim.
-}
-
-func (self *HilSensor) MsgID() uint8 {
+	TimeUsec      uint64  `mavlink:"time_usec"`      // Timestamp (microseconds, synced to UNIX time or since system boot)
+	Xacc          float32 `mavlink:"xacc"`           // X acceleration (m/s^2)
+	Yacc          float32 `mavlink:"yacc"`           // Y acceleration (m/s^2)
+	Zacc          float32 `mavlink:"zacc"`           // Z acceleration (m/s^2)
+	Xgyro         float32 `mavlink:"xgyro"`          // Angular speed around X axis in body frame (rad / sec)
+	Ygyro         float32 `mavlink:"ygyro"`          // Angular speed around Y axis in body frame (rad / sec)
+	Zgyro         float32 `mavlink:"zgyro"`          // Angular speed around Z axis in body frame (rad / sec)
+	Xmag          float32 `mavlink:"xmag"`           // X Magnetic field (Gauss)
+	Ymag          float32 `mavlink:"ymag"`           // Y Magnetic field (Gauss)
+	Zmag          float32 `mavlink:"zmag"`           // Z Magnetic field (Gauss)
+	AbsPressure   float32 `mavlink:"abs_pressure"`   // Absolute pressure in millibar
+	DiffPressure  float32 `mavlink:"diff_pressure"`  // Differential pressure (airspeed) in millibar
+	PressureAlt   float32 `mavlink:"pressure_alt"`   // Altitude calculated from pressure
+	Temperature   float32 `mavlink:"temperature"`    // Temperature in degrees celsius
+	FieldsUpdated uint32  `mavlink:"fields_updated"` // Bitmask for fields that have updated since last message, bit 0 = xacc, bit 12: temperature, bit 31: full reset of attitude/position/velocities/etc was performed in sim.
+}
+
+func (self *HilSensor) MsgID() uint32 {
 	return 107
 }
 
@@ -4469,50 +7199,59 @@ func (self *HilSensor) Unpack(p *Packet) error {
 	if len(p.Payload) < 64 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Xmag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Ymag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Zmag = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.AbsPressure = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.DiffPressure = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
-	self.PressureAlt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[52:]))
-	self.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[56:]))
-	self.FieldsUpdated = uint32(binary.LittleEndian.Uint32(p.Payload[60:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Xmag = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Ymag = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Zmag = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.AbsPressure = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.DiffPressure = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
+	self.PressureAlt = math.Float32frombits(binary.LittleEndian.Uint32(payload[52:]))
+	self.Temperature = math.Float32frombits(binary.LittleEndian.Uint32(payload[56:]))
+	self.FieldsUpdated = uint32(binary.LittleEndian.Uint32(payload[60:]))
 	return nil
 }
 
+func (self *HilSensor) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_SENSOR", self)
+}
+
+func (self *HilSensor) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_SENSOR", self, data)
+}
+
 // Status of simulation environment, if used
 type SimState struct {
-	Q1         float32 // True attitude quaternion component 1, w (1 in null-rotation)
-	Q2         float32 // True attitude quaternion component 2, x (0 in null-rotation)
-	Q3         float32 // True attitude quaternion component 3, y (0 in null-rotation)
-	Q4         float32 // True attitude quaternion component 4, z (0 in null-rotation)
-	Roll       float32 // Attitude roll expressed as Euler angles, not recommended except for human-readable outputs
-	Pitch      float32 // Attitude pitch expressed as Euler angles, not recommended except for human-readable outputs
-	Yaw        float32 // Attitude yaw expressed as Euler angles, not recommended except for human-readable outputs
-	Xacc       float32 // X acceleration m/s/s
-	Yacc       float32 // Y acceleration m/s/s
-	Zacc       float32 // Z acceleration m/s/s
-	Xgyro      float32 // Angular speed around X axis rad/s
-	Ygyro      float32 // Angular speed around Y axis rad/s
-	Zgyro      float32 // Angular speed around Z axis rad/s
-	Lat        float32 // Latitude in degrees
-	Lon        float32 // Longitude in degrees
-	Alt        float32 // Altitude in meters
-	StdDevHorz float32 // Horizontal position standard deviation
-	StdDevVert float32 // Vertical position standard deviation
-	Vn         float32 // True velocity in m/s in NORTH direction in earth-fixed NED frame
-	Ve         float32 // True velocity in m/s in EAST direction in earth-fixed NED frame
-	Vd         float32 // True velocity in m/s in DOWN direction in earth-fixed NED frame
-}
-
-func (self *SimState) MsgID() uint8 {
+	Q1         float32 `mavlink:"q1"`           // True attitude quaternion component 1, w (1 in null-rotation)
+	Q2         float32 `mavlink:"q2"`           // True attitude quaternion component 2, x (0 in null-rotation)
+	Q3         float32 `mavlink:"q3"`           // True attitude quaternion component 3, y (0 in null-rotation)
+	Q4         float32 `mavlink:"q4"`           // True attitude quaternion component 4, z (0 in null-rotation)
+	Roll       float32 `mavlink:"roll"`         // Attitude roll expressed as Euler angles, not recommended except for human-readable outputs
+	Pitch      float32 `mavlink:"pitch"`        // Attitude pitch expressed as Euler angles, not recommended except for human-readable outputs
+	Yaw        float32 `mavlink:"yaw"`          // Attitude yaw expressed as Euler angles, not recommended except for human-readable outputs
+	Xacc       float32 `mavlink:"xacc"`         // X acceleration m/s/s
+	Yacc       float32 `mavlink:"yacc"`         // Y acceleration m/s/s
+	Zacc       float32 `mavlink:"zacc"`         // Z acceleration m/s/s
+	Xgyro      float32 `mavlink:"xgyro"`        // Angular speed around X axis rad/s
+	Ygyro      float32 `mavlink:"ygyro"`        // Angular speed around Y axis rad/s
+	Zgyro      float32 `mavlink:"zgyro"`        // Angular speed around Z axis rad/s
+	Lat        float32 `mavlink:"lat"`          // Latitude in degrees
+	Lon        float32 `mavlink:"lon"`          // Longitude in degrees
+	Alt        float32 `mavlink:"alt"`          // Altitude in meters
+	StdDevHorz float32 `mavlink:"std_dev_horz"` // Horizontal position standard deviation
+	StdDevVert float32 `mavlink:"std_dev_vert"` // Vertical position standard deviation
+	Vn         float32 `mavlink:"vn"`           // True velocity in m/s in NORTH direction in earth-fixed NED frame
+	Ve         float32 `mavlink:"ve"`           // True velocity in m/s in EAST direction in earth-fixed NED frame
+	Vd         float32 `mavlink:"vd"`           // True velocity in m/s in DOWN direction in earth-fixed NED frame
+}
+
+func (self *SimState) MsgID() uint32 {
 	return 108
 }
 
@@ -4553,42 +7292,51 @@ func (self *SimState) Unpack(p *Packet) error {
 	if len(p.Payload) < 84 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Q1 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Q2 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Q3 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Q4 = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
-	self.Lat = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[52:]))
-	self.Lon = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[56:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[60:]))
-	self.StdDevHorz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[64:]))
-	self.StdDevVert = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[68:]))
-	self.Vn = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[72:]))
-	self.Ve = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[76:]))
-	self.Vd = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[80:]))
+	payload := p.Payload
+	self.Q1 = math.Float32frombits(binary.LittleEndian.Uint32(payload[0:]))
+	self.Q2 = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Q3 = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Q4 = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Roll = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Pitch = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Yaw = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Xacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Yacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Zacc = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Xgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.Ygyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.Zgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
+	self.Lat = math.Float32frombits(binary.LittleEndian.Uint32(payload[52:]))
+	self.Lon = math.Float32frombits(binary.LittleEndian.Uint32(payload[56:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[60:]))
+	self.StdDevHorz = math.Float32frombits(binary.LittleEndian.Uint32(payload[64:]))
+	self.StdDevVert = math.Float32frombits(binary.LittleEndian.Uint32(payload[68:]))
+	self.Vn = math.Float32frombits(binary.LittleEndian.Uint32(payload[72:]))
+	self.Ve = math.Float32frombits(binary.LittleEndian.Uint32(payload[76:]))
+	self.Vd = math.Float32frombits(binary.LittleEndian.Uint32(payload[80:]))
 	return nil
 }
 
+func (self *SimState) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SIM_STATE", self)
+}
+
+func (self *SimState) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SIM_STATE", self, data)
+}
+
 // Status generated by radio and injected into MAVLink stream.
 type RadioStatus struct {
-	Rxerrors uint16 // Receive errors
-	Fixed    uint16 // Count of error corrected packets
-	Rssi     uint8  // Local signal strength
-	Remrssi  uint8  // Remote signal strength
-	Txbuf    uint8  // Remaining free buffer space in percent.
-	Noise    uint8  // Background noise level
-	Remnoise uint8  // Remote background noise level
+	Rxerrors uint16 `mavlink:"rxerrors"` // Receive errors
+	Fixed    uint16 `mavlink:"fixed"`    // Count of error corrected packets
+	Rssi     uint8  `mavlink:"rssi"`     // Local signal strength
+	Remrssi  uint8  `mavlink:"remrssi"`  // Remote signal strength
+	Txbuf    uint8  `mavlink:"txbuf"`    // Remaining free buffer space in percent.
+	Noise    uint8  `mavlink:"noise"`    // Background noise level
+	Remnoise uint8  `mavlink:"remnoise"` // Remote background noise level
 }
 
-func (self *RadioStatus) MsgID() uint8 {
+func (self *RadioStatus) MsgID() uint32 {
 	return 109
 }
 
@@ -4615,25 +7363,34 @@ func (self *RadioStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 9 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Rxerrors = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Fixed = uint16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.Rssi = uint8(p.Payload[4])
-	self.Remrssi = uint8(p.Payload[5])
-	self.Txbuf = uint8(p.Payload[6])
-	self.Noise = uint8(p.Payload[7])
-	self.Remnoise = uint8(p.Payload[8])
+	payload := p.Payload
+	self.Rxerrors = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Fixed = uint16(binary.LittleEndian.Uint16(payload[2:]))
+	self.Rssi = uint8(payload[4])
+	self.Remrssi = uint8(payload[5])
+	self.Txbuf = uint8(payload[6])
+	self.Noise = uint8(payload[7])
+	self.Remnoise = uint8(payload[8])
 	return nil
 }
 
+func (self *RadioStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RADIO_STATUS", self)
+}
+
+func (self *RadioStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RADIO_STATUS", self, data)
+}
+
 // File transfer message
 type FileTransferProtocol struct {
-	TargetNetwork   uint8      // Network ID (0 for broadcast)
-	TargetSystem    uint8      // System ID (0 for broadcast)
-	TargetComponent uint8      // Component ID (0 for broadcast)
-	Payload         [251]uint8 // Variable length payload. The length is defined by the remaining message length when subtracting the header and other fields.  The entire content of this block is opaque unless you understand any the encoding message_type.  The particular encoding used can be extension specific and might not always be documented as part of the mavlink specification.
+	TargetNetwork   uint8      `mavlink:"target_network"`   // Network ID (0 for broadcast)
+	TargetSystem    uint8      `mavlink:"target_system"`    // System ID (0 for broadcast)
+	TargetComponent uint8      `mavlink:"target_component"` // Component ID (0 for broadcast)
+	Payload         [251]uint8 `mavlink:"payload"`          // Variable length payload. The length is defined by the remaining message length when subtracting the header and other fields.  The entire content of this block is opaque unless you understand any the encoding message_type.  The particular encoding used can be extension specific and might not always be documented as part of the mavlink specification.
 }
 
-func (self *FileTransferProtocol) MsgID() uint8 {
+func (self *FileTransferProtocol) MsgID() uint32 {
 	return 110
 }
 
@@ -4657,20 +7414,29 @@ func (self *FileTransferProtocol) Unpack(p *Packet) error {
 	if len(p.Payload) < 254 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetNetwork = uint8(p.Payload[0])
-	self.TargetSystem = uint8(p.Payload[1])
-	self.TargetComponent = uint8(p.Payload[2])
-	copy(self.Payload[:], p.Payload[3:254])
+	payload := p.Payload
+	self.TargetNetwork = uint8(payload[0])
+	self.TargetSystem = uint8(payload[1])
+	self.TargetComponent = uint8(payload[2])
+	copy(self.Payload[:], payload[3:254])
 	return nil
 }
 
+func (self *FileTransferProtocol) MarshalJSON() ([]byte, error) {
+	return marshalMessage("FILE_TRANSFER_PROTOCOL", self)
+}
+
+func (self *FileTransferProtocol) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("FILE_TRANSFER_PROTOCOL", self, data)
+}
+
 // Time synchronization message.
 type Timesync struct {
-	Tc1 int64 // Time sync timestamp 1
-	Ts1 int64 // Time sync timestamp 2
+	Tc1 int64 `mavlink:"tc1"` // Time sync timestamp 1
+	Ts1 int64 `mavlink:"ts1"` // Time sync timestamp 2
 }
 
-func (self *Timesync) MsgID() uint8 {
+func (self *Timesync) MsgID() uint32 {
 	return 111
 }
 
@@ -4692,18 +7458,27 @@ func (self *Timesync) Unpack(p *Packet) error {
 	if len(p.Payload) < 16 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Tc1 = int64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Ts1 = int64(binary.LittleEndian.Uint64(p.Payload[8:]))
+	payload := p.Payload
+	self.Tc1 = int64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Ts1 = int64(binary.LittleEndian.Uint64(payload[8:]))
 	return nil
 }
 
+func (self *Timesync) MarshalJSON() ([]byte, error) {
+	return marshalMessage("TIMESYNC", self)
+}
+
+func (self *Timesync) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("TIMESYNC", self, data)
+}
+
 // Camera-IMU triggering and synchronisation message.
 type CameraTrigger struct {
-	TimeUsec uint64 // Timestamp for the image frame in microseconds
-	Seq      uint32 // Image frame sequence
+	TimeUsec uint64 `mavlink:"time_usec"` // Timestamp for the image frame in microseconds
+	Seq      uint32 `mavlink:"seq"`       // Image frame sequence
 }
 
-func (self *CameraTrigger) MsgID() uint8 {
+func (self *CameraTrigger) MsgID() uint32 {
 	return 112
 }
 
@@ -4725,30 +7500,40 @@ func (self *CameraTrigger) Unpack(p *Packet) error {
 	if len(p.Payload) < 12 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Seq = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Seq = uint32(binary.LittleEndian.Uint32(payload[8:]))
 	return nil
 }
 
+func (self *CameraTrigger) MarshalJSON() ([]byte, error) {
+	return marshalMessage("CAMERA_TRIGGER", self)
+}
+
+func (self *CameraTrigger) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("CAMERA_TRIGGER", self, data)
+}
+
 // The global position, as returned by the Global Positioning System (GPS). This is
-//                  NOT the global position estimate of the sytem, but rather a RAW sensor value. See message GLOBAL_POSITION for the global position estimate. Coordinate frame is right-handed, Z-axis up (GPS frame).
+//
+//	NOT the global position estimate of the sytem, but rather a RAW sensor value. See message GLOBAL_POSITION for the global position estimate. Coordinate frame is right-handed, Z-axis up (GPS frame).
 type HilGps struct {
-	TimeUsec          uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Lat               int32  // Latitude (WGS84), in degrees * 1E7
-	Lon               int32  // Longitude (WGS84), in degrees * 1E7
-	Alt               int32  // Altitude (AMSL, not WGS84), in meters * 1000 (positive for up)
-	Eph               uint16 // GPS HDOP horizontal dilution of position in cm (m*100). If unknown, set to: 65535
-	Epv               uint16 // GPS VDOP vertical dilution of position in cm (m*100). If unknown, set to: 65535
-	Vel               uint16 // GPS ground speed (m/s * 100). If unknown, set to: 65535
-	Vn                int16  // GPS velocity in cm/s in NORTH direction in earth-fixed NED frame
-	Ve                int16  // GPS velocity in cm/s in EAST direction in earth-fixed NED frame
-	Vd                int16  // GPS velocity in cm/s in DOWN direction in earth-fixed NED frame
-	Cog               uint16 // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: 65535
-	FixType           uint8  // 0-1: no fix, 2: 2D fix, 3: 3D fix. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
-	SatellitesVisible uint8  // Number of satellites visible. If unknown, set to 255
-}
-
-func (self *HilGps) MsgID() uint8 {
+	TimeUsec          uint64 `mavlink:"time_usec"`          // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Lat               int32  `mavlink:"lat"`                // Latitude (WGS84), in degrees * 1E7
+	Lon               int32  `mavlink:"lon"`                // Longitude (WGS84), in degrees * 1E7
+	Alt               int32  `mavlink:"alt"`                // Altitude (AMSL, not WGS84), in meters * 1000 (positive for up)
+	Eph               uint16 `mavlink:"eph"`                // GPS HDOP horizontal dilution of position in cm (m*100). If unknown, set to: 65535
+	Epv               uint16 `mavlink:"epv"`                // GPS VDOP vertical dilution of position in cm (m*100). If unknown, set to: 65535
+	Vel               uint16 `mavlink:"vel"`                // GPS ground speed (m/s * 100). If unknown, set to: 65535
+	Vn                int16  `mavlink:"vn"`                 // GPS velocity in cm/s in NORTH direction in earth-fixed NED frame
+	Ve                int16  `mavlink:"ve"`                 // GPS velocity in cm/s in EAST direction in earth-fixed NED frame
+	Vd                int16  `mavlink:"vd"`                 // GPS velocity in cm/s in DOWN direction in earth-fixed NED frame
+	Cog               uint16 `mavlink:"cog"`                // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: 65535
+	FixType           uint8  `mavlink:"fix_type"`           // 0-1: no fix, 2: 2D fix, 3: 3D fix. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
+	SatellitesVisible uint8  `mavlink:"satellites_visible"` // Number of satellites visible. If unknown, set to 255
+}
+
+func (self *HilGps) MsgID() uint32 {
 	return 113
 }
 
@@ -4781,39 +7566,48 @@ func (self *HilGps) Unpack(p *Packet) error {
 	if len(p.Payload) < 36 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Eph = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Epv = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Vel = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Vn = int16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.Ve = int16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Vd = int16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.Cog = uint16(binary.LittleEndian.Uint16(p.Payload[32:]))
-	self.FixType = uint8(p.Payload[34])
-	self.SatellitesVisible = uint8(p.Payload[35])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Eph = uint16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Epv = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Vel = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Vn = int16(binary.LittleEndian.Uint16(payload[26:]))
+	self.Ve = int16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Vd = int16(binary.LittleEndian.Uint16(payload[30:]))
+	self.Cog = uint16(binary.LittleEndian.Uint16(payload[32:]))
+	self.FixType = uint8(payload[34])
+	self.SatellitesVisible = uint8(payload[35])
 	return nil
 }
 
+func (self *HilGps) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_GPS", self)
+}
+
+func (self *HilGps) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_GPS", self, data)
+}
+
 // Simulated optical flow from a flow sensor (e.g. PX4FLOW or optical mouse sensor)
 type HilOpticalFlow struct {
-	TimeUsec            uint64  // Timestamp (microseconds, synced to UNIX time or since system boot)
-	IntegrationTimeUs   uint32  // Integration time in microseconds. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the.
-	IntegratedX         float32 // Flow in radians around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)
-	IntegratedY         float32 // Flow in radians around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)
-	IntegratedXgyro     float32 // RH rotation around X axis (rad)
-	IntegratedYgyro     float32 // RH rotation around Y axis (rad)
-	IntegratedZgyro     float32 // RH rotation around Z axis (rad)
-	TimeDeltaDistanceUs uint32  // Time in microseconds since the distance was sampled.
-	Distance            float32 // Distance to the center of the flow field in meters. Positive value (including zero): distance known. Negative value: Unknown distance.
-	Temperature         int16   // Temperature * 100 in centi-degrees Celsius
-	SensorId            uint8   // Sensor ID
-	Quality             uint8   // Optical flow quality / confidence. 0: no valid flow, 255: maximum quality
-}
-
-func (self *HilOpticalFlow) MsgID() uint8 {
+	TimeUsec            uint64  `mavlink:"time_usec"`              // Timestamp (microseconds, synced to UNIX time or since system boot)
+	IntegrationTimeUs   uint32  `mavlink:"integration_time_us"`    // Integration time in microseconds. Divide integrated_x and integrated_y by the integration time to obtain average flow. The integration time also indicates the.
+	IntegratedX         float32 `mavlink:"integrated_x"`           // Flow in radians around X axis (Sensor RH rotation about the X axis induces a positive flow. Sensor linear motion along the positive Y axis induces a negative flow.)
+	IntegratedY         float32 `mavlink:"integrated_y"`           // Flow in radians around Y axis (Sensor RH rotation about the Y axis induces a positive flow. Sensor linear motion along the positive X axis induces a positive flow.)
+	IntegratedXgyro     float32 `mavlink:"integrated_xgyro"`       // RH rotation around X axis (rad)
+	IntegratedYgyro     float32 `mavlink:"integrated_ygyro"`       // RH rotation around Y axis (rad)
+	IntegratedZgyro     float32 `mavlink:"integrated_zgyro"`       // RH rotation around Z axis (rad)
+	TimeDeltaDistanceUs uint32  `mavlink:"time_delta_distance_us"` // Time in microseconds since the distance was sampled.
+	Distance            float32 `mavlink:"distance"`               // Distance to the center of the flow field in meters. Positive value (including zero): distance known. Negative value: Unknown distance.
+	Temperature         int16   `mavlink:"temperature"`            // Temperature * 100 in centi-degrees Celsius
+	SensorId            uint8   `mavlink:"sensor_id"`              // Sensor ID
+	Quality             uint8   `mavlink:"quality"`                // Optical flow quality / confidence. 0: no valid flow, 255: maximum quality
+}
+
+func (self *HilOpticalFlow) MsgID() uint32 {
 	return 114
 }
 
@@ -4845,42 +7639,51 @@ func (self *HilOpticalFlow) Unpack(p *Packet) error {
 	if len(p.Payload) < 44 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.IntegrationTimeUs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.IntegratedX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.IntegratedY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.IntegratedXgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.IntegratedYgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.IntegratedZgyro = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.TimeDeltaDistanceUs = uint32(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[40:]))
-	self.SensorId = uint8(p.Payload[42])
-	self.Quality = uint8(p.Payload[43])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.IntegrationTimeUs = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.IntegratedX = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.IntegratedY = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.IntegratedXgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.IntegratedYgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.IntegratedZgyro = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.TimeDeltaDistanceUs = uint32(binary.LittleEndian.Uint32(payload[32:]))
+	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[40:]))
+	self.SensorId = uint8(payload[42])
+	self.Quality = uint8(payload[43])
 	return nil
 }
 
+func (self *HilOpticalFlow) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_OPTICAL_FLOW", self)
+}
+
+func (self *HilOpticalFlow) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_OPTICAL_FLOW", self, data)
+}
+
 // Sent from simulation to autopilot, avoids in contrast to HIL_STATE singularities. This packet is useful for high throughput applications such as hardware in the loop simulations.
 type HilStateQuaternion struct {
-	TimeUsec           uint64     // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	AttitudeQuaternion [4]float32 // Vehicle attitude expressed as normalized quaternion in w, x, y, z order (with 1 0 0 0 being the null-rotation)
-	Rollspeed          float32    // Body frame roll / phi angular speed (rad/s)
-	Pitchspeed         float32    // Body frame pitch / theta angular speed (rad/s)
-	Yawspeed           float32    // Body frame yaw / psi angular speed (rad/s)
-	Lat                int32      // Latitude, expressed as * 1E7
-	Lon                int32      // Longitude, expressed as * 1E7
-	Alt                int32      // Altitude in meters, expressed as * 1000 (millimeters)
-	Vx                 int16      // Ground X Speed (Latitude), expressed as m/s * 100
-	Vy                 int16      // Ground Y Speed (Longitude), expressed as m/s * 100
-	Vz                 int16      // Ground Z Speed (Altitude), expressed as m/s * 100
-	IndAirspeed        uint16     // Indicated airspeed, expressed as m/s * 100
-	TrueAirspeed       uint16     // True airspeed, expressed as m/s * 100
-	Xacc               int16      // X acceleration (mg)
-	Yacc               int16      // Y acceleration (mg)
-	Zacc               int16      // Z acceleration (mg)
-}
-
-func (self *HilStateQuaternion) MsgID() uint8 {
+	TimeUsec           uint64     `mavlink:"time_usec"`           // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	AttitudeQuaternion [4]float32 `mavlink:"attitude_quaternion"` // Vehicle attitude expressed as normalized quaternion in w, x, y, z order (with 1 0 0 0 being the null-rotation)
+	Rollspeed          float32    `mavlink:"rollspeed"`           // Body frame roll / phi angular speed (rad/s)
+	Pitchspeed         float32    `mavlink:"pitchspeed"`          // Body frame pitch / theta angular speed (rad/s)
+	Yawspeed           float32    `mavlink:"yawspeed"`            // Body frame yaw / psi angular speed (rad/s)
+	Lat                int32      `mavlink:"lat"`                 // Latitude, expressed as * 1E7
+	Lon                int32      `mavlink:"lon"`                 // Longitude, expressed as * 1E7
+	Alt                int32      `mavlink:"alt"`                 // Altitude in meters, expressed as * 1000 (millimeters)
+	Vx                 int16      `mavlink:"vx"`                  // Ground X Speed (Latitude), expressed as m/s * 100
+	Vy                 int16      `mavlink:"vy"`                  // Ground Y Speed (Longitude), expressed as m/s * 100
+	Vz                 int16      `mavlink:"vz"`                  // Ground Z Speed (Altitude), expressed as m/s * 100
+	IndAirspeed        uint16     `mavlink:"ind_airspeed"`        // Indicated airspeed, expressed as m/s * 100
+	TrueAirspeed       uint16     `mavlink:"true_airspeed"`       // True airspeed, expressed as m/s * 100
+	Xacc               int16      `mavlink:"xacc"`                // X acceleration (mg)
+	Yacc               int16      `mavlink:"yacc"`                // Y acceleration (mg)
+	Zacc               int16      `mavlink:"zacc"`                // Z acceleration (mg)
+}
+
+func (self *HilStateQuaternion) MsgID() uint32 {
 	return 115
 }
 
@@ -4918,42 +7721,51 @@ func (self *HilStateQuaternion) Unpack(p *Packet) error {
 	if len(p.Payload) < 64 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
 	for i := 0; i < len(self.AttitudeQuaternion); i++ {
-		self.AttitudeQuaternion[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8+i*4:]))
+		self.AttitudeQuaternion[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[8+i*4:]))
 	}
-	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.Vx = int16(binary.LittleEndian.Uint16(p.Payload[48:]))
-	self.Vy = int16(binary.LittleEndian.Uint16(p.Payload[50:]))
-	self.Vz = int16(binary.LittleEndian.Uint16(p.Payload[52:]))
-	self.IndAirspeed = uint16(binary.LittleEndian.Uint16(p.Payload[54:]))
-	self.TrueAirspeed = uint16(binary.LittleEndian.Uint16(p.Payload[56:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[58:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[60:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[62:]))
+	self.Rollspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Pitchspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Yawspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[36:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[40:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[44:]))
+	self.Vx = int16(binary.LittleEndian.Uint16(payload[48:]))
+	self.Vy = int16(binary.LittleEndian.Uint16(payload[50:]))
+	self.Vz = int16(binary.LittleEndian.Uint16(payload[52:]))
+	self.IndAirspeed = uint16(binary.LittleEndian.Uint16(payload[54:]))
+	self.TrueAirspeed = uint16(binary.LittleEndian.Uint16(payload[56:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[58:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[60:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[62:]))
 	return nil
 }
 
+func (self *HilStateQuaternion) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HIL_STATE_QUATERNION", self)
+}
+
+func (self *HilStateQuaternion) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HIL_STATE_QUATERNION", self, data)
+}
+
 // The RAW IMU readings for secondary 9DOF sensor setup. This message should contain the scaled values to the described units
 type ScaledImu2 struct {
-	TimeBootMs uint32 // Timestamp (milliseconds since system boot)
-	Xacc       int16  // X acceleration (mg)
-	Yacc       int16  // Y acceleration (mg)
-	Zacc       int16  // Z acceleration (mg)
-	Xgyro      int16  // Angular speed around X axis (millirad /sec)
-	Ygyro      int16  // Angular speed around Y axis (millirad /sec)
-	Zgyro      int16  // Angular speed around Z axis (millirad /sec)
-	Xmag       int16  // X Magnetic field (milli tesla)
-	Ymag       int16  // Y Magnetic field (milli tesla)
-	Zmag       int16  // Z Magnetic field (milli tesla)
-}
-
-func (self *ScaledImu2) MsgID() uint8 {
+	TimeBootMs uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Xacc       int16  `mavlink:"xacc"`         // X acceleration (mg)
+	Yacc       int16  `mavlink:"yacc"`         // Y acceleration (mg)
+	Zacc       int16  `mavlink:"zacc"`         // Z acceleration (mg)
+	Xgyro      int16  `mavlink:"xgyro"`        // Angular speed around X axis (millirad /sec)
+	Ygyro      int16  `mavlink:"ygyro"`        // Angular speed around Y axis (millirad /sec)
+	Zgyro      int16  `mavlink:"zgyro"`        // Angular speed around Z axis (millirad /sec)
+	Xmag       int16  `mavlink:"xmag"`         // X Magnetic field (milli tesla)
+	Ymag       int16  `mavlink:"ymag"`         // Y Magnetic field (milli tesla)
+	Zmag       int16  `mavlink:"zmag"`         // Z Magnetic field (milli tesla)
+}
+
+func (self *ScaledImu2) MsgID() uint32 {
 	return 116
 }
 
@@ -4983,28 +7795,37 @@ func (self *ScaledImu2) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Xgyro = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Ygyro = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Zgyro = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Xmag = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Ymag = int16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Zmag = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Xgyro = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Ygyro = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Zgyro = int16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Xmag = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Ymag = int16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Zmag = int16(binary.LittleEndian.Uint16(payload[20:]))
 	return nil
 }
 
+func (self *ScaledImu2) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_IMU2", self)
+}
+
+func (self *ScaledImu2) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_IMU2", self, data)
+}
+
 // Request a list of available logs. On some systems calling this may stop on-board logging until LOG_REQUEST_END is called.
 type LogRequestList struct {
-	Start           uint16 // First log id (0 for first available)
-	End             uint16 // Last log id (0xffff for last available)
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Start           uint16 `mavlink:"start"`            // First log id (0 for first available)
+	End             uint16 `mavlink:"end"`              // Last log id (0xffff for last available)
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
 }
 
-func (self *LogRequestList) MsgID() uint8 {
+func (self *LogRequestList) MsgID() uint32 {
 	return 117
 }
 
@@ -5028,23 +7849,32 @@ func (self *LogRequestList) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Start = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.End = uint16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.TargetSystem = uint8(p.Payload[4])
-	self.TargetComponent = uint8(p.Payload[5])
+	payload := p.Payload
+	self.Start = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.End = uint16(binary.LittleEndian.Uint16(payload[2:]))
+	self.TargetSystem = uint8(payload[4])
+	self.TargetComponent = uint8(payload[5])
 	return nil
 }
 
+func (self *LogRequestList) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_REQUEST_LIST", self)
+}
+
+func (self *LogRequestList) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_REQUEST_LIST", self, data)
+}
+
 // Reply to LOG_REQUEST_LIST
 type LogEntry struct {
-	TimeUtc    uint32 // UTC timestamp of log in seconds since 1970, or 0 if not available
-	Size       uint32 // Size of the log (may be approximate) in bytes
-	Id         uint16 // Log id
-	NumLogs    uint16 // Total number of logs
-	LastLogNum uint16 // High log number
+	TimeUtc    uint32 `mavlink:"time_utc"`     // UTC timestamp of log in seconds since 1970, or 0 if not available
+	Size       uint32 `mavlink:"size"`         // Size of the log (may be approximate) in bytes
+	Id         uint16 `mavlink:"id"`           // Log id
+	NumLogs    uint16 `mavlink:"num_logs"`     // Total number of logs
+	LastLogNum uint16 `mavlink:"last_log_num"` // High log number
 }
 
-func (self *LogEntry) MsgID() uint8 {
+func (self *LogEntry) MsgID() uint32 {
 	return 118
 }
 
@@ -5069,24 +7899,33 @@ func (self *LogEntry) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUtc = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Size = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Id = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.NumLogs = uint16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.LastLogNum = uint16(binary.LittleEndian.Uint16(p.Payload[12:]))
+	payload := p.Payload
+	self.TimeUtc = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Size = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Id = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.NumLogs = uint16(binary.LittleEndian.Uint16(payload[10:]))
+	self.LastLogNum = uint16(binary.LittleEndian.Uint16(payload[12:]))
 	return nil
 }
 
+func (self *LogEntry) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_ENTRY", self)
+}
+
+func (self *LogEntry) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_ENTRY", self, data)
+}
+
 // Request a chunk of a log
 type LogRequestData struct {
-	Ofs             uint32 // Offset into the log
-	Count           uint32 // Number of bytes
-	Id              uint16 // Log id (from LOG_ENTRY reply)
-	TargetSystem    uint8  // System ID
-	TargetComponent uint8  // Component ID
+	Ofs             uint32 `mavlink:"ofs"`              // Offset into the log
+	Count           uint32 `mavlink:"count"`            // Number of bytes
+	Id              uint16 `mavlink:"id"`               // Log id (from LOG_ENTRY reply)
+	TargetSystem    uint8  `mavlink:"target_system"`    // System ID
+	TargetComponent uint8  `mavlink:"target_component"` // Component ID
 }
 
-func (self *LogRequestData) MsgID() uint8 {
+func (self *LogRequestData) MsgID() uint32 {
 	return 119
 }
 
@@ -5111,23 +7950,32 @@ func (self *LogRequestData) Unpack(p *Packet) error {
 	if len(p.Payload) < 12 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Ofs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Count = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Id = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.TargetSystem = uint8(p.Payload[10])
-	self.TargetComponent = uint8(p.Payload[11])
+	payload := p.Payload
+	self.Ofs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Count = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Id = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.TargetSystem = uint8(payload[10])
+	self.TargetComponent = uint8(payload[11])
 	return nil
 }
 
+func (self *LogRequestData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_REQUEST_DATA", self)
+}
+
+func (self *LogRequestData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_REQUEST_DATA", self, data)
+}
+
 // Reply to LOG_REQUEST_DATA
 type LogData struct {
-	Ofs   uint32    // Offset into the log
-	Id    uint16    // Log id (from LOG_ENTRY reply)
-	Count uint8     // Number of bytes (zero for end of log)
-	Data  [90]uint8 // log data
+	Ofs   uint32    `mavlink:"ofs"`   // Offset into the log
+	Id    uint16    `mavlink:"id"`    // Log id (from LOG_ENTRY reply)
+	Count uint8     `mavlink:"count"` // Number of bytes (zero for end of log)
+	Data  [90]uint8 `mavlink:"data"`  // log data
 }
 
-func (self *LogData) MsgID() uint8 {
+func (self *LogData) MsgID() uint32 {
 	return 120
 }
 
@@ -5151,20 +7999,29 @@ func (self *LogData) Unpack(p *Packet) error {
 	if len(p.Payload) < 97 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Ofs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Id = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Count = uint8(p.Payload[6])
-	copy(self.Data[:], p.Payload[7:97])
+	payload := p.Payload
+	self.Ofs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Id = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Count = uint8(payload[6])
+	copy(self.Data[:], payload[7:97])
 	return nil
 }
 
+func (self *LogData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_DATA", self)
+}
+
+func (self *LogData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_DATA", self, data)
+}
+
 // Erase all logs
 type LogErase struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
 }
 
-func (self *LogErase) MsgID() uint8 {
+func (self *LogErase) MsgID() uint32 {
 	return 121
 }
 
@@ -5186,18 +8043,27 @@ func (self *LogErase) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
 	return nil
 }
 
+func (self *LogErase) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_ERASE", self)
+}
+
+func (self *LogErase) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_ERASE", self, data)
+}
+
 // Stop log transfer and resume normal logging
 type LogRequestEnd struct {
-	TargetSystem    uint8 // System ID
-	TargetComponent uint8 // Component ID
+	TargetSystem    uint8 `mavlink:"target_system"`    // System ID
+	TargetComponent uint8 `mavlink:"target_component"` // Component ID
 }
 
-func (self *LogRequestEnd) MsgID() uint8 {
+func (self *LogRequestEnd) MsgID() uint32 {
 	return 122
 }
 
@@ -5219,20 +8085,29 @@ func (self *LogRequestEnd) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
 	return nil
 }
 
+func (self *LogRequestEnd) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LOG_REQUEST_END", self)
+}
+
+func (self *LogRequestEnd) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LOG_REQUEST_END", self, data)
+}
+
 // data for injecting into the onboard GPS (used for DGPS)
 type GpsInjectData struct {
-	TargetSystem    uint8      // System ID
-	TargetComponent uint8      // Component ID
-	Len             uint8      // data length
-	Data            [110]uint8 // raw data (110 is enough for 12 satellites of RTCMv2)
+	TargetSystem    uint8      `mavlink:"target_system"`    // System ID
+	TargetComponent uint8      `mavlink:"target_component"` // Component ID
+	Len             uint8      `mavlink:"len"`              // data length
+	Data            [110]uint8 `mavlink:"data"`             // raw data (110 is enough for 12 satellites of RTCMv2)
 }
 
-func (self *GpsInjectData) MsgID() uint8 {
+func (self *GpsInjectData) MsgID() uint32 {
 	return 123
 }
 
@@ -5256,30 +8131,39 @@ func (self *GpsInjectData) Unpack(p *Packet) error {
 	if len(p.Payload) < 113 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
-	self.Len = uint8(p.Payload[2])
-	copy(self.Data[:], p.Payload[3:113])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
+	self.Len = uint8(payload[2])
+	copy(self.Data[:], payload[3:113])
 	return nil
 }
 
+func (self *GpsInjectData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_INJECT_DATA", self)
+}
+
+func (self *GpsInjectData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_INJECT_DATA", self, data)
+}
+
 // Second GPS data. Coordinate frame is right-handed, Z-axis up (GPS frame).
 type Gps2Raw struct {
-	TimeUsec          uint64 // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
-	Lat               int32  // Latitude (WGS84), in degrees * 1E7
-	Lon               int32  // Longitude (WGS84), in degrees * 1E7
-	Alt               int32  // Altitude (AMSL, not WGS84), in meters * 1000 (positive for up)
-	DgpsAge           uint32 // Age of DGPS info
-	Eph               uint16 // GPS HDOP horizontal dilution of position in cm (m*100). If unknown, set to: UINT16_MAX
-	Epv               uint16 // GPS VDOP vertical dilution of position in cm (m*100). If unknown, set to: UINT16_MAX
-	Vel               uint16 // GPS ground speed (m/s * 100). If unknown, set to: UINT16_MAX
-	Cog               uint16 // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
-	FixType           uint8  // 0-1: no fix, 2: 2D fix, 3: 3D fix, 4: DGPS fix, 5: RTK Fix. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
-	SatellitesVisible uint8  // Number of satellites visible. If unknown, set to 255
-	DgpsNumch         uint8  // Number of DGPS satellites
-}
-
-func (self *Gps2Raw) MsgID() uint8 {
+	TimeUsec          uint64 `mavlink:"time_usec"`          // Timestamp (microseconds since UNIX epoch or microseconds since system boot)
+	Lat               int32  `mavlink:"lat"`                // Latitude (WGS84), in degrees * 1E7
+	Lon               int32  `mavlink:"lon"`                // Longitude (WGS84), in degrees * 1E7
+	Alt               int32  `mavlink:"alt"`                // Altitude (AMSL, not WGS84), in meters * 1000 (positive for up)
+	DgpsAge           uint32 `mavlink:"dgps_age"`           // Age of DGPS info
+	Eph               uint16 `mavlink:"eph"`                // GPS HDOP horizontal dilution of position in cm (m*100). If unknown, set to: UINT16_MAX
+	Epv               uint16 `mavlink:"epv"`                // GPS VDOP vertical dilution of position in cm (m*100). If unknown, set to: UINT16_MAX
+	Vel               uint16 `mavlink:"vel"`                // GPS ground speed (m/s * 100). If unknown, set to: UINT16_MAX
+	Cog               uint16 `mavlink:"cog"`                // Course over ground (NOT heading, but direction of movement) in degrees * 100, 0.0..359.99 degrees. If unknown, set to: UINT16_MAX
+	FixType           uint8  `mavlink:"fix_type"`           // 0-1: no fix, 2: 2D fix, 3: 3D fix, 4: DGPS fix, 5: RTK Fix. Some applications will not use the value of this field unless it is at least two, so always correctly fill in the fix.
+	SatellitesVisible uint8  `mavlink:"satellites_visible"` // Number of satellites visible. If unknown, set to 255
+	DgpsNumch         uint8  `mavlink:"dgps_numch"`         // Number of DGPS satellites
+}
+
+func (self *Gps2Raw) MsgID() uint32 {
 	return 124
 }
 
@@ -5311,29 +8195,38 @@ func (self *Gps2Raw) Unpack(p *Packet) error {
 	if len(p.Payload) < 35 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Alt = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.DgpsAge = uint32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Eph = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.Epv = uint16(binary.LittleEndian.Uint16(p.Payload[26:]))
-	self.Vel = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.Cog = uint16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.FixType = uint8(p.Payload[32])
-	self.SatellitesVisible = uint8(p.Payload[33])
-	self.DgpsNumch = uint8(p.Payload[34])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Alt = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.DgpsAge = uint32(binary.LittleEndian.Uint32(payload[20:]))
+	self.Eph = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.Epv = uint16(binary.LittleEndian.Uint16(payload[26:]))
+	self.Vel = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.Cog = uint16(binary.LittleEndian.Uint16(payload[30:]))
+	self.FixType = uint8(payload[32])
+	self.SatellitesVisible = uint8(payload[33])
+	self.DgpsNumch = uint8(payload[34])
 	return nil
 }
 
+func (self *Gps2Raw) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS2_RAW", self)
+}
+
+func (self *Gps2Raw) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS2_RAW", self, data)
+}
+
 // Power supply status
 type PowerStatus struct {
-	Vcc    uint16 // 5V rail voltage in millivolts
-	Vservo uint16 // servo rail voltage in millivolts
-	Flags  uint16 // power supply status flags (see MAV_POWER_STATUS enum)
+	Vcc    uint16 `mavlink:"Vcc"`    // 5V rail voltage in millivolts
+	Vservo uint16 `mavlink:"Vservo"` // servo rail voltage in millivolts
+	Flags  uint16 `mavlink:"flags"`  // power supply status flags (see MAV_POWER_STATUS enum)
 }
 
-func (self *PowerStatus) MsgID() uint8 {
+func (self *PowerStatus) MsgID() uint32 {
 	return 125
 }
 
@@ -5356,23 +8249,32 @@ func (self *PowerStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Vcc = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Vservo = uint16(binary.LittleEndian.Uint16(p.Payload[2:]))
-	self.Flags = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
+	payload := p.Payload
+	self.Vcc = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Vservo = uint16(binary.LittleEndian.Uint16(payload[2:]))
+	self.Flags = uint16(binary.LittleEndian.Uint16(payload[4:]))
 	return nil
 }
 
+func (self *PowerStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("POWER_STATUS", self)
+}
+
+func (self *PowerStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("POWER_STATUS", self, data)
+}
+
 // Control a serial port. This can be used for raw access to an onboard serial peripheral such as a GPS or telemetry radio. It is designed to make it possible to update the devices firmware via MAVLink messages or change the devices settings. A message with zero bytes can be used to change just the baudrate.
 type SerialControl struct {
-	Baudrate uint32    // Baudrate of transfer. Zero means no change.
-	Timeout  uint16    // Timeout for reply data in milliseconds
-	Device   uint8     // See SERIAL_CONTROL_DEV enum
-	Flags    uint8     // See SERIAL_CONTROL_FLAG enum
-	Count    uint8     // how many bytes in this transfer
-	Data     [70]uint8 // serial data
+	Baudrate uint32    `mavlink:"baudrate"` // Baudrate of transfer. Zero means no change.
+	Timeout  uint16    `mavlink:"timeout"`  // Timeout for reply data in milliseconds
+	Device   uint8     `mavlink:"device"`   // See SERIAL_CONTROL_DEV enum
+	Flags    uint8     `mavlink:"flags"`    // See SERIAL_CONTROL_FLAG enum
+	Count    uint8     `mavlink:"count"`    // how many bytes in this transfer
+	Data     [70]uint8 `mavlink:"data"`     // serial data
 }
 
-func (self *SerialControl) MsgID() uint8 {
+func (self *SerialControl) MsgID() uint32 {
 	return 126
 }
 
@@ -5398,33 +8300,42 @@ func (self *SerialControl) Unpack(p *Packet) error {
 	if len(p.Payload) < 79 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Baudrate = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Timeout = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Device = uint8(p.Payload[6])
-	self.Flags = uint8(p.Payload[7])
-	self.Count = uint8(p.Payload[8])
-	copy(self.Data[:], p.Payload[9:79])
+	payload := p.Payload
+	self.Baudrate = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Timeout = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Device = uint8(payload[6])
+	self.Flags = uint8(payload[7])
+	self.Count = uint8(payload[8])
+	copy(self.Data[:], payload[9:79])
 	return nil
 }
 
+func (self *SerialControl) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SERIAL_CONTROL", self)
+}
+
+func (self *SerialControl) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SERIAL_CONTROL", self, data)
+}
+
 // RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting
 type GpsRtk struct {
-	TimeLastBaselineMs uint32 // Time since boot of last baseline message received in ms.
-	Tow                uint32 // GPS Time of Week of last baseline
-	BaselineAMm        int32  // Current baseline in ECEF x or NED north component in mm.
-	BaselineBMm        int32  // Current baseline in ECEF y or NED east component in mm.
-	BaselineCMm        int32  // Current baseline in ECEF z or NED down component in mm.
-	Accuracy           uint32 // Current estimate of baseline accuracy.
-	IarNumHypotheses   int32  // Current number of integer ambiguity hypotheses.
-	Wn                 uint16 // GPS Week Number of last baseline
-	RtkReceiverId      uint8  // Identification of connected RTK receiver.
-	RtkHealth          uint8  // GPS-specific health report for RTK data.
-	RtkRate            uint8  // Rate of baseline messages being received by GPS, in HZ
-	Nsats              uint8  // Current number of sats used for RTK calculation.
-	BaselineCoordsType uint8  // Coordinate system of baseline. 0 == ECEF, 1 == NED
-}
-
-func (self *GpsRtk) MsgID() uint8 {
+	TimeLastBaselineMs uint32 `mavlink:"time_last_baseline_ms"` // Time since boot of last baseline message received in ms.
+	Tow                uint32 `mavlink:"tow"`                   // GPS Time of Week of last baseline
+	BaselineAMm        int32  `mavlink:"baseline_a_mm"`         // Current baseline in ECEF x or NED north component in mm.
+	BaselineBMm        int32  `mavlink:"baseline_b_mm"`         // Current baseline in ECEF y or NED east component in mm.
+	BaselineCMm        int32  `mavlink:"baseline_c_mm"`         // Current baseline in ECEF z or NED down component in mm.
+	Accuracy           uint32 `mavlink:"accuracy"`              // Current estimate of baseline accuracy.
+	IarNumHypotheses   int32  `mavlink:"iar_num_hypotheses"`    // Current number of integer ambiguity hypotheses.
+	Wn                 uint16 `mavlink:"wn"`                    // GPS Week Number of last baseline
+	RtkReceiverId      uint8  `mavlink:"rtk_receiver_id"`       // Identification of connected RTK receiver.
+	RtkHealth          uint8  `mavlink:"rtk_health"`            // GPS-specific health report for RTK data.
+	RtkRate            uint8  `mavlink:"rtk_rate"`              // Rate of baseline messages being received by GPS, in HZ
+	Nsats              uint8  `mavlink:"nsats"`                 // Current number of sats used for RTK calculation.
+	BaselineCoordsType uint8  `mavlink:"baseline_coords_type"`  // Coordinate system of baseline. 0 == ECEF, 1 == NED
+}
+
+func (self *GpsRtk) MsgID() uint32 {
 	return 127
 }
 
@@ -5457,40 +8368,49 @@ func (self *GpsRtk) Unpack(p *Packet) error {
 	if len(p.Payload) < 35 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeLastBaselineMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Tow = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.BaselineAMm = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.BaselineBMm = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.BaselineCMm = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Accuracy = uint32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.IarNumHypotheses = int32(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Wn = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.RtkReceiverId = uint8(p.Payload[30])
-	self.RtkHealth = uint8(p.Payload[31])
-	self.RtkRate = uint8(p.Payload[32])
-	self.Nsats = uint8(p.Payload[33])
-	self.BaselineCoordsType = uint8(p.Payload[34])
+	payload := p.Payload
+	self.TimeLastBaselineMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Tow = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.BaselineAMm = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.BaselineBMm = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.BaselineCMm = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Accuracy = uint32(binary.LittleEndian.Uint32(payload[20:]))
+	self.IarNumHypotheses = int32(binary.LittleEndian.Uint32(payload[24:]))
+	self.Wn = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.RtkReceiverId = uint8(payload[30])
+	self.RtkHealth = uint8(payload[31])
+	self.RtkRate = uint8(payload[32])
+	self.Nsats = uint8(payload[33])
+	self.BaselineCoordsType = uint8(payload[34])
 	return nil
 }
 
+func (self *GpsRtk) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_RTK", self)
+}
+
+func (self *GpsRtk) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_RTK", self, data)
+}
+
 // RTK GPS data. Gives information on the relative baseline calculation the GPS is reporting
 type Gps2Rtk struct {
-	TimeLastBaselineMs uint32 // Time since boot of last baseline message received in ms.
-	Tow                uint32 // GPS Time of Week of last baseline
-	BaselineAMm        int32  // Current baseline in ECEF x or NED north component in mm.
-	BaselineBMm        int32  // Current baseline in ECEF y or NED east component in mm.
-	BaselineCMm        int32  // Current baseline in ECEF z or NED down component in mm.
-	Accuracy           uint32 // Current estimate of baseline accuracy.
-	IarNumHypotheses   int32  // Current number of integer ambiguity hypotheses.
-	Wn                 uint16 // GPS Week Number of last baseline
-	RtkReceiverId      uint8  // Identification of connected RTK receiver.
-	RtkHealth          uint8  // GPS-specific health report for RTK data.
-	RtkRate            uint8  // Rate of baseline messages being received by GPS, in HZ
-	Nsats              uint8  // Current number of sats used for RTK calculation.
-	BaselineCoordsType uint8  // Coordinate system of baseline. 0 == ECEF, 1 == NED
-}
-
-func (self *Gps2Rtk) MsgID() uint8 {
+	TimeLastBaselineMs uint32 `mavlink:"time_last_baseline_ms"` // Time since boot of last baseline message received in ms.
+	Tow                uint32 `mavlink:"tow"`                   // GPS Time of Week of last baseline
+	BaselineAMm        int32  `mavlink:"baseline_a_mm"`         // Current baseline in ECEF x or NED north component in mm.
+	BaselineBMm        int32  `mavlink:"baseline_b_mm"`         // Current baseline in ECEF y or NED east component in mm.
+	BaselineCMm        int32  `mavlink:"baseline_c_mm"`         // Current baseline in ECEF z or NED down component in mm.
+	Accuracy           uint32 `mavlink:"accuracy"`              // Current estimate of baseline accuracy.
+	IarNumHypotheses   int32  `mavlink:"iar_num_hypotheses"`    // Current number of integer ambiguity hypotheses.
+	Wn                 uint16 `mavlink:"wn"`                    // GPS Week Number of last baseline
+	RtkReceiverId      uint8  `mavlink:"rtk_receiver_id"`       // Identification of connected RTK receiver.
+	RtkHealth          uint8  `mavlink:"rtk_health"`            // GPS-specific health report for RTK data.
+	RtkRate            uint8  `mavlink:"rtk_rate"`              // Rate of baseline messages being received by GPS, in HZ
+	Nsats              uint8  `mavlink:"nsats"`                 // Current number of sats used for RTK calculation.
+	BaselineCoordsType uint8  `mavlink:"baseline_coords_type"`  // Coordinate system of baseline. 0 == ECEF, 1 == NED
+}
+
+func (self *Gps2Rtk) MsgID() uint32 {
 	return 128
 }
 
@@ -5523,37 +8443,46 @@ func (self *Gps2Rtk) Unpack(p *Packet) error {
 	if len(p.Payload) < 35 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeLastBaselineMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Tow = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.BaselineAMm = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.BaselineBMm = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.BaselineCMm = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Accuracy = uint32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.IarNumHypotheses = int32(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Wn = uint16(binary.LittleEndian.Uint16(p.Payload[28:]))
-	self.RtkReceiverId = uint8(p.Payload[30])
-	self.RtkHealth = uint8(p.Payload[31])
-	self.RtkRate = uint8(p.Payload[32])
-	self.Nsats = uint8(p.Payload[33])
-	self.BaselineCoordsType = uint8(p.Payload[34])
+	payload := p.Payload
+	self.TimeLastBaselineMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Tow = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.BaselineAMm = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.BaselineBMm = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.BaselineCMm = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Accuracy = uint32(binary.LittleEndian.Uint32(payload[20:]))
+	self.IarNumHypotheses = int32(binary.LittleEndian.Uint32(payload[24:]))
+	self.Wn = uint16(binary.LittleEndian.Uint16(payload[28:]))
+	self.RtkReceiverId = uint8(payload[30])
+	self.RtkHealth = uint8(payload[31])
+	self.RtkRate = uint8(payload[32])
+	self.Nsats = uint8(payload[33])
+	self.BaselineCoordsType = uint8(payload[34])
 	return nil
 }
 
+func (self *Gps2Rtk) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS2_RTK", self)
+}
+
+func (self *Gps2Rtk) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS2_RTK", self, data)
+}
+
 // The RAW IMU readings for 3rd 9DOF sensor setup. This message should contain the scaled values to the described units
 type ScaledImu3 struct {
-	TimeBootMs uint32 // Timestamp (milliseconds since system boot)
-	Xacc       int16  // X acceleration (mg)
-	Yacc       int16  // Y acceleration (mg)
-	Zacc       int16  // Z acceleration (mg)
-	Xgyro      int16  // Angular speed around X axis (millirad /sec)
-	Ygyro      int16  // Angular speed around Y axis (millirad /sec)
-	Zgyro      int16  // Angular speed around Z axis (millirad /sec)
-	Xmag       int16  // X Magnetic field (milli tesla)
-	Ymag       int16  // Y Magnetic field (milli tesla)
-	Zmag       int16  // Z Magnetic field (milli tesla)
-}
-
-func (self *ScaledImu3) MsgID() uint8 {
+	TimeBootMs uint32 `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Xacc       int16  `mavlink:"xacc"`         // X acceleration (mg)
+	Yacc       int16  `mavlink:"yacc"`         // Y acceleration (mg)
+	Zacc       int16  `mavlink:"zacc"`         // Z acceleration (mg)
+	Xgyro      int16  `mavlink:"xgyro"`        // Angular speed around X axis (millirad /sec)
+	Ygyro      int16  `mavlink:"ygyro"`        // Angular speed around Y axis (millirad /sec)
+	Zgyro      int16  `mavlink:"zgyro"`        // Angular speed around Z axis (millirad /sec)
+	Xmag       int16  `mavlink:"xmag"`         // X Magnetic field (milli tesla)
+	Ymag       int16  `mavlink:"ymag"`         // Y Magnetic field (milli tesla)
+	Zmag       int16  `mavlink:"zmag"`         // Z Magnetic field (milli tesla)
+}
+
+func (self *ScaledImu3) MsgID() uint32 {
 	return 129
 }
 
@@ -5583,31 +8512,39 @@ func (self *ScaledImu3) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Xacc = int16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Yacc = int16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Zacc = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Xgyro = int16(binary.LittleEndian.Uint16(p.Payload[10:]))
-	self.Ygyro = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
-	self.Zgyro = int16(binary.LittleEndian.Uint16(p.Payload[14:]))
-	self.Xmag = int16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Ymag = int16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Zmag = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Xacc = int16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Yacc = int16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Zacc = int16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Xgyro = int16(binary.LittleEndian.Uint16(payload[10:]))
+	self.Ygyro = int16(binary.LittleEndian.Uint16(payload[12:]))
+	self.Zgyro = int16(binary.LittleEndian.Uint16(payload[14:]))
+	self.Xmag = int16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Ymag = int16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Zmag = int16(binary.LittleEndian.Uint16(payload[20:]))
 	return nil
 }
 
-//
+func (self *ScaledImu3) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_IMU3", self)
+}
+
+func (self *ScaledImu3) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_IMU3", self, data)
+}
+
 type DataTransmissionHandshake struct {
-	Size       uint32 // total data size in bytes (set on ACK only)
-	Width      uint16 // Width of a matrix or image
-	Height     uint16 // Height of a matrix or image
-	Packets    uint16 // number of packets beeing sent (set on ACK only)
-	Type       uint8  // type of requested/acknowledged data (as defined in ENUM DATA_TYPES in mavlink/include/mavlink_types.h)
-	Payload    uint8  // payload size per packet (normally 253 byte, see DATA field size in message ENCAPSULATED_DATA) (set on ACK only)
-	JpgQuality uint8  // JPEG quality out of [1,100]
+	Size       uint32 `mavlink:"size"`        // total data size in bytes (set on ACK only)
+	Width      uint16 `mavlink:"width"`       // Width of a matrix or image
+	Height     uint16 `mavlink:"height"`      // Height of a matrix or image
+	Packets    uint16 `mavlink:"packets"`     // number of packets beeing sent (set on ACK only)
+	Type       uint8  `mavlink:"type"`        // type of requested/acknowledged data (as defined in ENUM DATA_TYPES in mavlink/include/mavlink_types.h)
+	Payload    uint8  `mavlink:"payload"`     // payload size per packet (normally 253 byte, see DATA field size in message ENCAPSULATED_DATA) (set on ACK only)
+	JpgQuality uint8  `mavlink:"jpg_quality"` // JPEG quality out of [1,100]
 }
 
-func (self *DataTransmissionHandshake) MsgID() uint8 {
+func (self *DataTransmissionHandshake) MsgID() uint32 {
 	return 130
 }
 
@@ -5634,23 +8571,31 @@ func (self *DataTransmissionHandshake) Unpack(p *Packet) error {
 	if len(p.Payload) < 13 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Size = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Width = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.Height = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.Packets = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Type = uint8(p.Payload[10])
-	self.Payload = uint8(p.Payload[11])
-	self.JpgQuality = uint8(p.Payload[12])
+	payload := p.Payload
+	self.Size = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Width = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.Height = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.Packets = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Type = uint8(payload[10])
+	self.Payload = uint8(payload[11])
+	self.JpgQuality = uint8(payload[12])
 	return nil
 }
 
-//
+func (self *DataTransmissionHandshake) MarshalJSON() ([]byte, error) {
+	return marshalMessage("DATA_TRANSMISSION_HANDSHAKE", self)
+}
+
+func (self *DataTransmissionHandshake) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("DATA_TRANSMISSION_HANDSHAKE", self, data)
+}
+
 type EncapsulatedData struct {
-	Seqnr uint16     // sequence number (starting with 0 on every transmission)
-	Data  [253]uint8 // image data bytes
+	Seqnr uint16     `mavlink:"seqnr"` // sequence number (starting with 0 on every transmission)
+	Data  [253]uint8 `mavlink:"data"`  // image data bytes
 }
 
-func (self *EncapsulatedData) MsgID() uint8 {
+func (self *EncapsulatedData) MsgID() uint32 {
 	return 131
 }
 
@@ -5672,24 +8617,32 @@ func (self *EncapsulatedData) Unpack(p *Packet) error {
 	if len(p.Payload) < 255 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Seqnr = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	copy(self.Data[:], p.Payload[2:255])
+	payload := p.Payload
+	self.Seqnr = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	copy(self.Data[:], payload[2:255])
 	return nil
 }
 
-//
+func (self *EncapsulatedData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ENCAPSULATED_DATA", self)
+}
+
+func (self *EncapsulatedData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ENCAPSULATED_DATA", self, data)
+}
+
 type DistanceSensor struct {
-	TimeBootMs      uint32 // Time since system boot
-	MinDistance     uint16 // Minimum distance the sensor can measure in centimeters
-	MaxDistance     uint16 // Maximum distance the sensor can measure in centimeters
-	CurrentDistance uint16 // Current distance reading
-	Type            uint8  // Type from MAV_DISTANCE_SENSOR enum.
-	Id              uint8  // Onboard ID of the sensor
-	Orientation     uint8  // Direction the sensor faces from MAV_SENSOR_ORIENTATION enum.
-	Covariance      uint8  // Measurement covariance in centimeters, 0 for unknown / invalid readings
+	TimeBootMs      uint32 `mavlink:"time_boot_ms"`     // Time since system boot
+	MinDistance     uint16 `mavlink:"min_distance"`     // Minimum distance the sensor can measure in centimeters
+	MaxDistance     uint16 `mavlink:"max_distance"`     // Maximum distance the sensor can measure in centimeters
+	CurrentDistance uint16 `mavlink:"current_distance"` // Current distance reading
+	Type            uint8  `mavlink:"type"`             // Type from MAV_DISTANCE_SENSOR enum.
+	Id              uint8  `mavlink:"id"`               // Onboard ID of the sensor
+	Orientation     uint8  `mavlink:"orientation"`      // Direction the sensor faces from MAV_SENSOR_ORIENTATION enum.
+	Covariance      uint8  `mavlink:"covariance"`       // Measurement covariance in centimeters, 0 for unknown / invalid readings
 }
 
-func (self *DistanceSensor) MsgID() uint8 {
+func (self *DistanceSensor) MsgID() uint32 {
 	return 132
 }
 
@@ -5717,26 +8670,35 @@ func (self *DistanceSensor) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.MinDistance = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
-	self.MaxDistance = uint16(binary.LittleEndian.Uint16(p.Payload[6:]))
-	self.CurrentDistance = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
-	self.Type = uint8(p.Payload[10])
-	self.Id = uint8(p.Payload[11])
-	self.Orientation = uint8(p.Payload[12])
-	self.Covariance = uint8(p.Payload[13])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.MinDistance = uint16(binary.LittleEndian.Uint16(payload[4:]))
+	self.MaxDistance = uint16(binary.LittleEndian.Uint16(payload[6:]))
+	self.CurrentDistance = uint16(binary.LittleEndian.Uint16(payload[8:]))
+	self.Type = uint8(payload[10])
+	self.Id = uint8(payload[11])
+	self.Orientation = uint8(payload[12])
+	self.Covariance = uint8(payload[13])
 	return nil
 }
 
+func (self *DistanceSensor) MarshalJSON() ([]byte, error) {
+	return marshalMessage("DISTANCE_SENSOR", self)
+}
+
+func (self *DistanceSensor) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("DISTANCE_SENSOR", self, data)
+}
+
 // Request for terrain data and terrain status
 type TerrainRequest struct {
-	Mask        uint64 // Bitmask of requested 4x4 grids (row major 8x7 array of grids, 56 bits)
-	Lat         int32  // Latitude of SW corner of first grid (degrees *10^7)
-	Lon         int32  // Longitude of SW corner of first grid (in degrees *10^7)
-	GridSpacing uint16 // Grid spacing in meters
+	Mask        uint64 `mavlink:"mask"`         // Bitmask of requested 4x4 grids (row major 8x7 array of grids, 56 bits)
+	Lat         int32  `mavlink:"lat"`          // Latitude of SW corner of first grid (degrees *10^7)
+	Lon         int32  `mavlink:"lon"`          // Longitude of SW corner of first grid (in degrees *10^7)
+	GridSpacing uint16 `mavlink:"grid_spacing"` // Grid spacing in meters
 }
 
-func (self *TerrainRequest) MsgID() uint8 {
+func (self *TerrainRequest) MsgID() uint32 {
 	return 133
 }
 
@@ -5760,23 +8722,32 @@ func (self *TerrainRequest) Unpack(p *Packet) error {
 	if len(p.Payload) < 18 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Mask = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.GridSpacing = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
+	payload := p.Payload
+	self.Mask = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.GridSpacing = uint16(binary.LittleEndian.Uint16(payload[16:]))
 	return nil
 }
 
+func (self *TerrainRequest) MarshalJSON() ([]byte, error) {
+	return marshalMessage("TERRAIN_REQUEST", self)
+}
+
+func (self *TerrainRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("TERRAIN_REQUEST", self, data)
+}
+
 // Terrain data sent from GCS. The lat/lon and grid_spacing must be the same as a lat/lon from a TERRAIN_REQUEST
 type TerrainData struct {
-	Lat         int32     // Latitude of SW corner of first grid (degrees *10^7)
-	Lon         int32     // Longitude of SW corner of first grid (in degrees *10^7)
-	GridSpacing uint16    // Grid spacing in meters
-	Data        [16]int16 // Terrain data in meters AMSL
-	Gridbit     uint8     // bit within the terrain request mask
+	Lat         int32     `mavlink:"lat"`          // Latitude of SW corner of first grid (degrees *10^7)
+	Lon         int32     `mavlink:"lon"`          // Longitude of SW corner of first grid (in degrees *10^7)
+	GridSpacing uint16    `mavlink:"grid_spacing"` // Grid spacing in meters
+	Data        [16]int16 `mavlink:"data"`         // Terrain data in meters AMSL
+	Gridbit     uint8     `mavlink:"gridbit"`      // bit within the terrain request mask
 }
 
-func (self *TerrainData) MsgID() uint8 {
+func (self *TerrainData) MsgID() uint32 {
 	return 134
 }
 
@@ -5803,23 +8774,32 @@ func (self *TerrainData) Unpack(p *Packet) error {
 	if len(p.Payload) < 43 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.GridSpacing = uint16(binary.LittleEndian.Uint16(p.Payload[8:]))
+	payload := p.Payload
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.GridSpacing = uint16(binary.LittleEndian.Uint16(payload[8:]))
 	for i := 0; i < len(self.Data); i++ {
-		self.Data[i] = int16(binary.LittleEndian.Uint16(p.Payload[10+i*2:]))
+		self.Data[i] = int16(binary.LittleEndian.Uint16(payload[10+i*2:]))
 	}
-	self.Gridbit = uint8(p.Payload[42])
+	self.Gridbit = uint8(payload[42])
 	return nil
 }
 
+func (self *TerrainData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("TERRAIN_DATA", self)
+}
+
+func (self *TerrainData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("TERRAIN_DATA", self, data)
+}
+
 // Request that the vehicle report terrain height at the given location. Used by GCS to check if vehicle has all terrain data needed for a mission.
 type TerrainCheck struct {
-	Lat int32 // Latitude (degrees *10^7)
-	Lon int32 // Longitude (degrees *10^7)
+	Lat int32 `mavlink:"lat"` // Latitude (degrees *10^7)
+	Lon int32 `mavlink:"lon"` // Longitude (degrees *10^7)
 }
 
-func (self *TerrainCheck) MsgID() uint8 {
+func (self *TerrainCheck) MsgID() uint32 {
 	return 135
 }
 
@@ -5841,23 +8821,32 @@ func (self *TerrainCheck) Unpack(p *Packet) error {
 	if len(p.Payload) < 8 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
+	payload := p.Payload
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[4:]))
 	return nil
 }
 
+func (self *TerrainCheck) MarshalJSON() ([]byte, error) {
+	return marshalMessage("TERRAIN_CHECK", self)
+}
+
+func (self *TerrainCheck) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("TERRAIN_CHECK", self, data)
+}
+
 // Response from a TERRAIN_CHECK request
 type TerrainReport struct {
-	Lat           int32   // Latitude (degrees *10^7)
-	Lon           int32   // Longitude (degrees *10^7)
-	TerrainHeight float32 // Terrain height in meters AMSL
-	CurrentHeight float32 // Current vehicle height above lat/lon terrain height (meters)
-	Spacing       uint16  // grid spacing (zero if terrain at this location unavailable)
-	Pending       uint16  // Number of 4x4 terrain blocks waiting to be received or read from disk
-	Loaded        uint16  // Number of 4x4 terrain blocks in memory
+	Lat           int32   `mavlink:"lat"`            // Latitude (degrees *10^7)
+	Lon           int32   `mavlink:"lon"`            // Longitude (degrees *10^7)
+	TerrainHeight float32 `mavlink:"terrain_height"` // Terrain height in meters AMSL
+	CurrentHeight float32 `mavlink:"current_height"` // Current vehicle height above lat/lon terrain height (meters)
+	Spacing       uint16  `mavlink:"spacing"`        // grid spacing (zero if terrain at this location unavailable)
+	Pending       uint16  `mavlink:"pending"`        // Number of 4x4 terrain blocks waiting to be received or read from disk
+	Loaded        uint16  `mavlink:"loaded"`         // Number of 4x4 terrain blocks in memory
 }
 
-func (self *TerrainReport) MsgID() uint8 {
+func (self *TerrainReport) MsgID() uint32 {
 	return 136
 }
 
@@ -5884,25 +8873,34 @@ func (self *TerrainReport) Unpack(p *Packet) error {
 	if len(p.Payload) < 22 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.TerrainHeight = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.CurrentHeight = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Spacing = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.Pending = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.Loaded = uint16(binary.LittleEndian.Uint16(p.Payload[20:]))
+	payload := p.Payload
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.TerrainHeight = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.CurrentHeight = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Spacing = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.Pending = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.Loaded = uint16(binary.LittleEndian.Uint16(payload[20:]))
 	return nil
 }
 
+func (self *TerrainReport) MarshalJSON() ([]byte, error) {
+	return marshalMessage("TERRAIN_REPORT", self)
+}
+
+func (self *TerrainReport) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("TERRAIN_REPORT", self, data)
+}
+
 // Barometer readings for 2nd barometer
 type ScaledPressure2 struct {
-	TimeBootMs  uint32  // Timestamp (milliseconds since system boot)
-	PressAbs    float32 // Absolute pressure (hectopascal)
-	PressDiff   float32 // Differential pressure 1 (hectopascal)
-	Temperature int16   // Temperature measurement (0.01 degrees celsius)
+	TimeBootMs  uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	PressAbs    float32 `mavlink:"press_abs"`    // Absolute pressure (hectopascal)
+	PressDiff   float32 `mavlink:"press_diff"`   // Differential pressure 1 (hectopascal)
+	Temperature int16   `mavlink:"temperature"`  // Temperature measurement (0.01 degrees celsius)
 }
 
-func (self *ScaledPressure2) MsgID() uint8 {
+func (self *ScaledPressure2) MsgID() uint32 {
 	return 137
 }
 
@@ -5926,23 +8924,32 @@ func (self *ScaledPressure2) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[12:]))
 	return nil
 }
 
+func (self *ScaledPressure2) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_PRESSURE2", self)
+}
+
+func (self *ScaledPressure2) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_PRESSURE2", self, data)
+}
+
 // Motion capture attitude and position
 type AttPosMocap struct {
-	TimeUsec uint64     // Timestamp (micros since boot or Unix epoch)
-	Q        [4]float32 // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
-	X        float32    // X position in meters (NED)
-	Y        float32    // Y position in meters (NED)
-	Z        float32    // Z position in meters (NED)
+	TimeUsec uint64     `mavlink:"time_usec"` // Timestamp (micros since boot or Unix epoch)
+	Q        [4]float32 `mavlink:"q"`         // Attitude quaternion (w, x, y, z order, zero-rotation is 1, 0, 0, 0)
+	X        float32    `mavlink:"x"`         // X position in meters (NED)
+	Y        float32    `mavlink:"y"`         // Y position in meters (NED)
+	Z        float32    `mavlink:"z"`         // Z position in meters (NED)
 }
 
-func (self *AttPosMocap) MsgID() uint8 {
+func (self *AttPosMocap) MsgID() uint32 {
 	return 138
 }
 
@@ -5969,26 +8976,35 @@ func (self *AttPosMocap) Unpack(p *Packet) error {
 	if len(p.Payload) < 36 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[8+i*4:]))
 	}
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
 	return nil
 }
 
+func (self *AttPosMocap) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ATT_POS_MOCAP", self)
+}
+
+func (self *AttPosMocap) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ATT_POS_MOCAP", self, data)
+}
+
 // Set the vehicle attitude and body angular rates.
 type SetActuatorControlTarget struct {
-	TimeUsec        uint64     // Timestamp (micros since boot or Unix epoch)
-	Controls        [8]float32 // Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs.
-	GroupMlx        uint8      // Actuator group. The "_mlx" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances.
-	TargetSystem    uint8      // System ID
-	TargetComponent uint8      // Component ID
+	TimeUsec        uint64     `mavlink:"time_usec"`        // Timestamp (micros since boot or Unix epoch)
+	Controls        [8]float32 `mavlink:"controls"`         // Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs.
+	GroupMlx        uint8      `mavlink:"group_mlx"`        // Actuator group. The "_mlx" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances.
+	TargetSystem    uint8      `mavlink:"target_system"`    // System ID
+	TargetComponent uint8      `mavlink:"target_component"` // Component ID
 }
 
-func (self *SetActuatorControlTarget) MsgID() uint8 {
+func (self *SetActuatorControlTarget) MsgID() uint32 {
 	return 139
 }
 
@@ -6015,24 +9031,33 @@ func (self *SetActuatorControlTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 43 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
 	for i := 0; i < len(self.Controls); i++ {
-		self.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8+i*4:]))
+		self.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[8+i*4:]))
 	}
-	self.GroupMlx = uint8(p.Payload[40])
-	self.TargetSystem = uint8(p.Payload[41])
-	self.TargetComponent = uint8(p.Payload[42])
+	self.GroupMlx = uint8(payload[40])
+	self.TargetSystem = uint8(payload[41])
+	self.TargetComponent = uint8(payload[42])
 	return nil
 }
 
+func (self *SetActuatorControlTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_ACTUATOR_CONTROL_TARGET", self)
+}
+
+func (self *SetActuatorControlTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_ACTUATOR_CONTROL_TARGET", self, data)
+}
+
 // Set the vehicle attitude and body angular rates.
 type ActuatorControlTarget struct {
-	TimeUsec uint64     // Timestamp (micros since boot or Unix epoch)
-	Controls [8]float32 // Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs.
-	GroupMlx uint8      // Actuator group. The "_mlx" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances.
+	TimeUsec uint64     `mavlink:"time_usec"` // Timestamp (micros since boot or Unix epoch)
+	Controls [8]float32 `mavlink:"controls"`  // Actuator controls. Normed to -1..+1 where 0 is neutral position. Throttle for single rotation direction motors is 0..1, negative range for reverse direction. Standard mapping for attitude controls (group 0): (index 0-7): roll, pitch, yaw, throttle, flaps, spoilers, airbrakes, landing gear. Load a pass-through mixer to repurpose them as generic outputs.
+	GroupMlx uint8      `mavlink:"group_mlx"` // Actuator group. The "_mlx" indicates this is a multi-instance message and a MAVLink parser should use this field to difference between instances.
 }
 
-func (self *ActuatorControlTarget) MsgID() uint8 {
+func (self *ActuatorControlTarget) MsgID() uint32 {
 	return 140
 }
 
@@ -6057,26 +9082,35 @@ func (self *ActuatorControlTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 41 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
 	for i := 0; i < len(self.Controls); i++ {
-		self.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8+i*4:]))
+		self.Controls[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[8+i*4:]))
 	}
-	self.GroupMlx = uint8(p.Payload[40])
+	self.GroupMlx = uint8(payload[40])
 	return nil
 }
 
+func (self *ActuatorControlTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ACTUATOR_CONTROL_TARGET", self)
+}
+
+func (self *ActuatorControlTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ACTUATOR_CONTROL_TARGET", self, data)
+}
+
 // The current system altitude.
 type Altitude struct {
-	TimeUsec          uint64  // Timestamp (micros since boot or Unix epoch)
-	AltitudeMonotonic float32 // This altitude measure is initialized on system boot and monotonic (it is never reset, but represents the local altitude change). The only guarantee on this field is that it will never be reset and is consistent within a flight. The recommended value for this field is the uncorrected barometric altitude at boot time. This altitude will also drift and vary between flights.
-	AltitudeAmsl      float32 // This altitude measure is strictly above mean sea level and might be non-monotonic (it might reset on events like GPS lock or when a new QNH value is set). It should be the altitude to which global altitude waypoints are compared to. Note that it is *not* the GPS altitude, however, most GPS modules already output AMSL by default and not the WGS84 altitude.
-	AltitudeLocal     float32 // This is the local altitude in the local coordinate frame. It is not the altitude above home, but in reference to the coordinate origin (0, 0, 0). It is up-positive.
-	AltitudeRelative  float32 // This is the altitude above the home position. It resets on each change of the current home position.
-	AltitudeTerrain   float32 // This is the altitude above terrain. It might be fed by a terrain database or an altimeter. Values smaller than -1000 should be interpreted as unknown.
-	BottomClearance   float32 // This is not the altitude, but the clear space below the system according to the fused clearance estimate. It generally should max out at the maximum range of e.g. the laser altimeter. It is generally a moving target. A negative value indicates no measurement available.
+	TimeUsec          uint64  `mavlink:"time_usec"`          // Timestamp (micros since boot or Unix epoch)
+	AltitudeMonotonic float32 `mavlink:"altitude_monotonic"` // This altitude measure is initialized on system boot and monotonic (it is never reset, but represents the local altitude change). The only guarantee on this field is that it will never be reset and is consistent within a flight. The recommended value for this field is the uncorrected barometric altitude at boot time. This altitude will also drift and vary between flights.
+	AltitudeAmsl      float32 `mavlink:"altitude_amsl"`      // This altitude measure is strictly above mean sea level and might be non-monotonic (it might reset on events like GPS lock or when a new QNH value is set). It should be the altitude to which global altitude waypoints are compared to. Note that it is *not* the GPS altitude, however, most GPS modules already output AMSL by default and not the WGS84 altitude.
+	AltitudeLocal     float32 `mavlink:"altitude_local"`     // This is the local altitude in the local coordinate frame. It is not the altitude above home, but in reference to the coordinate origin (0, 0, 0). It is up-positive.
+	AltitudeRelative  float32 `mavlink:"altitude_relative"`  // This is the altitude above the home position. It resets on each change of the current home position.
+	AltitudeTerrain   float32 `mavlink:"altitude_terrain"`   // This is the altitude above terrain. It might be fed by a terrain database or an altimeter. Values smaller than -1000 should be interpreted as unknown.
+	BottomClearance   float32 `mavlink:"bottom_clearance"`   // This is not the altitude, but the clear space below the system according to the fused clearance estimate. It generally should max out at the maximum range of e.g. the laser altimeter. It is generally a moving target. A negative value indicates no measurement available.
 }
 
-func (self *Altitude) MsgID() uint8 {
+func (self *Altitude) MsgID() uint32 {
 	return 141
 }
 
@@ -6103,26 +9137,35 @@ func (self *Altitude) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.AltitudeMonotonic = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.AltitudeAmsl = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.AltitudeLocal = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.AltitudeRelative = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.AltitudeTerrain = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.BottomClearance = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.AltitudeMonotonic = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.AltitudeAmsl = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.AltitudeLocal = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.AltitudeRelative = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.AltitudeTerrain = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.BottomClearance = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
+func (self *Altitude) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ALTITUDE", self)
+}
+
+func (self *Altitude) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ALTITUDE", self, data)
+}
+
 // The autopilot is requesting a resource (file, binary, other type of data)
 type ResourceRequest struct {
-	RequestId    uint8      // Request ID. This ID should be re-used when sending back URI contents
-	UriType      uint8      // The type of requested URI. 0 = a file via URL. 1 = a UAVCAN binary
-	Uri          [120]uint8 // The requested unique resource identifier (URI). It is not necessarily a straight domain name (depends on the URI type enum)
-	TransferType uint8      // The way the autopilot wants to receive the URI. 0 = MAVLink FTP. 1 = binary stream.
-	Storage      [120]uint8 // The storage path the autopilot wants the URI to be stored in. Will only be valid if the transfer_type has a storage associated (e.g. MAVLink FTP).
+	RequestId    uint8      `mavlink:"request_id"`    // Request ID. This ID should be re-used when sending back URI contents
+	UriType      uint8      `mavlink:"uri_type"`      // The type of requested URI. 0 = a file via URL. 1 = a UAVCAN binary
+	Uri          [120]uint8 `mavlink:"uri"`           // The requested unique resource identifier (URI). It is not necessarily a straight domain name (depends on the URI type enum)
+	TransferType uint8      `mavlink:"transfer_type"` // The way the autopilot wants to receive the URI. 0 = MAVLink FTP. 1 = binary stream.
+	Storage      [120]uint8 `mavlink:"storage"`       // The storage path the autopilot wants the URI to be stored in. Will only be valid if the transfer_type has a storage associated (e.g. MAVLink FTP).
 }
 
-func (self *ResourceRequest) MsgID() uint8 {
+func (self *ResourceRequest) MsgID() uint32 {
 	return 142
 }
 
@@ -6147,23 +9190,32 @@ func (self *ResourceRequest) Unpack(p *Packet) error {
 	if len(p.Payload) < 243 {
 		return fmt.Errorf("payload too small")
 	}
-	self.RequestId = uint8(p.Payload[0])
-	self.UriType = uint8(p.Payload[1])
-	copy(self.Uri[:], p.Payload[2:122])
-	self.TransferType = uint8(p.Payload[122])
-	copy(self.Storage[:], p.Payload[123:243])
+	payload := p.Payload
+	self.RequestId = uint8(payload[0])
+	self.UriType = uint8(payload[1])
+	copy(self.Uri[:], payload[2:122])
+	self.TransferType = uint8(payload[122])
+	copy(self.Storage[:], payload[123:243])
 	return nil
 }
 
+func (self *ResourceRequest) MarshalJSON() ([]byte, error) {
+	return marshalMessage("RESOURCE_REQUEST", self)
+}
+
+func (self *ResourceRequest) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("RESOURCE_REQUEST", self, data)
+}
+
 // Barometer readings for 3rd barometer
 type ScaledPressure3 struct {
-	TimeBootMs  uint32  // Timestamp (milliseconds since system boot)
-	PressAbs    float32 // Absolute pressure (hectopascal)
-	PressDiff   float32 // Differential pressure 1 (hectopascal)
-	Temperature int16   // Temperature measurement (0.01 degrees celsius)
+	TimeBootMs  uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	PressAbs    float32 `mavlink:"press_abs"`    // Absolute pressure (hectopascal)
+	PressDiff   float32 `mavlink:"press_diff"`   // Differential pressure 1 (hectopascal)
+	Temperature int16   `mavlink:"temperature"`  // Temperature measurement (0.01 degrees celsius)
 }
 
-func (self *ScaledPressure3) MsgID() uint8 {
+func (self *ScaledPressure3) MsgID() uint32 {
 	return 143
 }
 
@@ -6187,29 +9239,38 @@ func (self *ScaledPressure3) Unpack(p *Packet) error {
 	if len(p.Payload) < 14 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[12:]))
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.PressAbs = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.PressDiff = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[12:]))
 	return nil
 }
 
+func (self *ScaledPressure3) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SCALED_PRESSURE3", self)
+}
+
+func (self *ScaledPressure3) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SCALED_PRESSURE3", self, data)
+}
+
 // current motion information from a designated system
 type FollowTarget struct {
-	Timestamp       uint64     // Timestamp in milliseconds since system boot
-	CustomState     uint64     // button states or switches of a tracker device
-	Lat             int32      // Latitude (WGS84), in degrees * 1E7
-	Lon             int32      // Longitude (WGS84), in degrees * 1E7
-	Alt             float32    // AMSL, in meters
-	Vel             [3]float32 // target velocity (0,0,0) for unknown
-	Acc             [3]float32 // linear target acceleration (0,0,0) for unknown
-	AttitudeQ       [4]float32 // (1 0 0 0 for unknown)
-	Rates           [3]float32 // (0 0 0 for unknown)
-	PositionCov     [3]float32 // eph epv
-	EstCapabilities uint8      // bit positions for tracker reporting capabilities (POS = 0, VEL = 1, ACCEL = 2, ATT + RATES = 3)
-}
-
-func (self *FollowTarget) MsgID() uint8 {
+	Timestamp       uint64     `mavlink:"timestamp"`        // Timestamp in milliseconds since system boot
+	CustomState     uint64     `mavlink:"custom_state"`     // button states or switches of a tracker device
+	Lat             int32      `mavlink:"lat"`              // Latitude (WGS84), in degrees * 1E7
+	Lon             int32      `mavlink:"lon"`              // Longitude (WGS84), in degrees * 1E7
+	Alt             float32    `mavlink:"alt"`              // AMSL, in meters
+	Vel             [3]float32 `mavlink:"vel"`              // target velocity (0,0,0) for unknown
+	Acc             [3]float32 `mavlink:"acc"`              // linear target acceleration (0,0,0) for unknown
+	AttitudeQ       [4]float32 `mavlink:"attitude_q"`       // (1 0 0 0 for unknown)
+	Rates           [3]float32 `mavlink:"rates"`            // (0 0 0 for unknown)
+	PositionCov     [3]float32 `mavlink:"position_cov"`     // eph epv
+	EstCapabilities uint8      `mavlink:"est_capabilities"` // bit positions for tracker reporting capabilities (POS = 0, VEL = 1, ACCEL = 2, ATT + RATES = 3)
+}
+
+func (self *FollowTarget) MsgID() uint32 {
 	return 144
 }
 
@@ -6250,52 +9311,61 @@ func (self *FollowTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 93 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Timestamp = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.CustomState = uint64(binary.LittleEndian.Uint64(p.Payload[8:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
+	payload := p.Payload
+	self.Timestamp = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.CustomState = uint64(binary.LittleEndian.Uint64(payload[8:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[20:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
 	for i := 0; i < len(self.Vel); i++ {
-		self.Vel[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28+i*4:]))
+		self.Vel[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[28+i*4:]))
 	}
 	for i := 0; i < len(self.Acc); i++ {
-		self.Acc[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40+i*4:]))
+		self.Acc[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[40+i*4:]))
 	}
 	for i := 0; i < len(self.AttitudeQ); i++ {
-		self.AttitudeQ[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[52+i*4:]))
+		self.AttitudeQ[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[52+i*4:]))
 	}
 	for i := 0; i < len(self.Rates); i++ {
-		self.Rates[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[68+i*4:]))
+		self.Rates[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[68+i*4:]))
 	}
 	for i := 0; i < len(self.PositionCov); i++ {
-		self.PositionCov[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[80+i*4:]))
+		self.PositionCov[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[80+i*4:]))
 	}
-	self.EstCapabilities = uint8(p.Payload[92])
+	self.EstCapabilities = uint8(payload[92])
 	return nil
 }
 
+func (self *FollowTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("FOLLOW_TARGET", self)
+}
+
+func (self *FollowTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("FOLLOW_TARGET", self, data)
+}
+
 // The smoothed, monotonic system state used to feed the control loops of the system.
 type ControlSystemState struct {
-	TimeUsec    uint64     // Timestamp (micros since boot or Unix epoch)
-	XAcc        float32    // X acceleration in body frame
-	YAcc        float32    // Y acceleration in body frame
-	ZAcc        float32    // Z acceleration in body frame
-	XVel        float32    // X velocity in body frame
-	YVel        float32    // Y velocity in body frame
-	ZVel        float32    // Z velocity in body frame
-	XPos        float32    // X position in local frame
-	YPos        float32    // Y position in local frame
-	ZPos        float32    // Z position in local frame
-	Airspeed    float32    // Airspeed, set to -1 if unknown
-	VelVariance [3]float32 // Variance of body velocity estimate
-	PosVariance [3]float32 // Variance in local position
-	Q           [4]float32 // The attitude, represented as Quaternion
-	RollRate    float32    // Angular rate in roll axis
-	PitchRate   float32    // Angular rate in pitch axis
-	YawRate     float32    // Angular rate in yaw axis
-}
-
-func (self *ControlSystemState) MsgID() uint8 {
+	TimeUsec    uint64     `mavlink:"time_usec"`    // Timestamp (micros since boot or Unix epoch)
+	XAcc        float32    `mavlink:"x_acc"`        // X acceleration in body frame
+	YAcc        float32    `mavlink:"y_acc"`        // Y acceleration in body frame
+	ZAcc        float32    `mavlink:"z_acc"`        // Z acceleration in body frame
+	XVel        float32    `mavlink:"x_vel"`        // X velocity in body frame
+	YVel        float32    `mavlink:"y_vel"`        // Y velocity in body frame
+	ZVel        float32    `mavlink:"z_vel"`        // Z velocity in body frame
+	XPos        float32    `mavlink:"x_pos"`        // X position in local frame
+	YPos        float32    `mavlink:"y_pos"`        // Y position in local frame
+	ZPos        float32    `mavlink:"z_pos"`        // Z position in local frame
+	Airspeed    float32    `mavlink:"airspeed"`     // Airspeed, set to -1 if unknown
+	VelVariance [3]float32 `mavlink:"vel_variance"` // Variance of body velocity estimate
+	PosVariance [3]float32 `mavlink:"pos_variance"` // Variance in local position
+	Q           [4]float32 `mavlink:"q"`            // The attitude, represented as Quaternion
+	RollRate    float32    `mavlink:"roll_rate"`    // Angular rate in roll axis
+	PitchRate   float32    `mavlink:"pitch_rate"`   // Angular rate in pitch axis
+	YawRate     float32    `mavlink:"yaw_rate"`     // Angular rate in yaw axis
+}
+
+func (self *ControlSystemState) MsgID() uint32 {
 	return 146
 }
 
@@ -6338,46 +9408,55 @@ func (self *ControlSystemState) Unpack(p *Packet) error {
 	if len(p.Payload) < 100 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.XAcc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.YAcc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.ZAcc = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.XVel = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.YVel = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.ZVel = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.XPos = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.YPos = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.ZPos = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.XAcc = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.YAcc = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.ZAcc = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.XVel = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.YVel = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.ZVel = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.XPos = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.YPos = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.ZPos = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.Airspeed = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
 	for i := 0; i < len(self.VelVariance); i++ {
-		self.VelVariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48+i*4:]))
+		self.VelVariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[48+i*4:]))
 	}
 	for i := 0; i < len(self.PosVariance); i++ {
-		self.PosVariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[60+i*4:]))
+		self.PosVariance[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[60+i*4:]))
 	}
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[72+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[72+i*4:]))
 	}
-	self.RollRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[88:]))
-	self.PitchRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[92:]))
-	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[96:]))
+	self.RollRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[88:]))
+	self.PitchRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[92:]))
+	self.YawRate = math.Float32frombits(binary.LittleEndian.Uint32(payload[96:]))
 	return nil
 }
 
+func (self *ControlSystemState) MarshalJSON() ([]byte, error) {
+	return marshalMessage("CONTROL_SYSTEM_STATE", self)
+}
+
+func (self *ControlSystemState) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("CONTROL_SYSTEM_STATE", self, data)
+}
+
 // Battery information
 type BatteryStatus struct {
-	CurrentConsumed  int32      // Consumed charge, in milliampere hours (1 = 1 mAh), -1: autopilot does not provide mAh consumption estimate
-	EnergyConsumed   int32      // Consumed energy, in 100*Joules (intergrated U*I*dt)  (1 = 100 Joule), -1: autopilot does not provide energy consumption estimate
-	Temperature      int16      // Temperature of the battery in centi-degrees celsius. INT16_MAX for unknown temperature.
-	Voltages         [10]uint16 // Battery voltage of cells, in millivolts (1 = 1 millivolt). Cells above the valid cell count for this battery should have the UINT16_MAX value.
-	CurrentBattery   int16      // Battery current, in 10*milliamperes (1 = 10 milliampere), -1: autopilot does not measure the current
-	Id               uint8      // Battery ID
-	BatteryFunction  uint8      // Function of the battery
-	Type             uint8      // Type (chemistry) of the battery
-	BatteryRemaining int8       // Remaining battery energy: (0%: 0, 100%: 100), -1: autopilot does not estimate the remaining battery
-}
-
-func (self *BatteryStatus) MsgID() uint8 {
+	CurrentConsumed  int32      `mavlink:"current_consumed"`  // Consumed charge, in milliampere hours (1 = 1 mAh), -1: autopilot does not provide mAh consumption estimate
+	EnergyConsumed   int32      `mavlink:"energy_consumed"`   // Consumed energy, in 100*Joules (intergrated U*I*dt)  (1 = 100 Joule), -1: autopilot does not provide energy consumption estimate
+	Temperature      int16      `mavlink:"temperature"`       // Temperature of the battery in centi-degrees celsius. INT16_MAX for unknown temperature.
+	Voltages         [10]uint16 `mavlink:"voltages"`          // Battery voltage of cells, in millivolts (1 = 1 millivolt). Cells above the valid cell count for this battery should have the UINT16_MAX value.
+	CurrentBattery   int16      `mavlink:"current_battery"`   // Battery current, in 10*milliamperes (1 = 10 milliampere), -1: autopilot does not measure the current
+	Id               uint8      `mavlink:"id"`                // Battery ID
+	BatteryFunction  uint8      `mavlink:"battery_function"`  // Function of the battery
+	Type             uint8      `mavlink:"type"`              // Type (chemistry) of the battery
+	BatteryRemaining int8       `mavlink:"battery_remaining"` // Remaining battery energy: (0%: 0, 100%: 100), -1: autopilot does not estimate the remaining battery
+}
+
+func (self *BatteryStatus) MsgID() uint32 {
 	return 147
 }
 
@@ -6408,36 +9487,45 @@ func (self *BatteryStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 36 {
 		return fmt.Errorf("payload too small")
 	}
-	self.CurrentConsumed = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.EnergyConsumed = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Temperature = int16(binary.LittleEndian.Uint16(p.Payload[8:]))
+	payload := p.Payload
+	self.CurrentConsumed = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.EnergyConsumed = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Temperature = int16(binary.LittleEndian.Uint16(payload[8:]))
 	for i := 0; i < len(self.Voltages); i++ {
-		self.Voltages[i] = uint16(binary.LittleEndian.Uint16(p.Payload[10+i*2:]))
+		self.Voltages[i] = uint16(binary.LittleEndian.Uint16(payload[10+i*2:]))
 	}
-	self.CurrentBattery = int16(binary.LittleEndian.Uint16(p.Payload[30:]))
-	self.Id = uint8(p.Payload[32])
-	self.BatteryFunction = uint8(p.Payload[33])
-	self.Type = uint8(p.Payload[34])
-	self.BatteryRemaining = int8(p.Payload[35])
+	self.CurrentBattery = int16(binary.LittleEndian.Uint16(payload[30:]))
+	self.Id = uint8(payload[32])
+	self.BatteryFunction = uint8(payload[33])
+	self.Type = uint8(payload[34])
+	self.BatteryRemaining = int8(payload[35])
 	return nil
 }
 
+func (self *BatteryStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("BATTERY_STATUS", self)
+}
+
+func (self *BatteryStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("BATTERY_STATUS", self, data)
+}
+
 // Version and capability of autopilot software
 type AutopilotVersion struct {
-	Capabilities            uint64   // bitmask of capabilities (see MAV_PROTOCOL_CAPABILITY enum)
-	Uid                     uint64   // UID if provided by hardware
-	FlightSwVersion         uint32   // Firmware version number
-	MiddlewareSwVersion     uint32   // Middleware version number
-	OsSwVersion             uint32   // Operating system version number
-	BoardVersion            uint32   // HW / board version (last 8 bytes should be silicon ID, if any)
-	VendorId                uint16   // ID of the board vendor
-	ProductId               uint16   // ID of the product
-	FlightCustomVersion     [8]uint8 // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
-	MiddlewareCustomVersion [8]uint8 // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
-	OsCustomVersion         [8]uint8 // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
-}
-
-func (self *AutopilotVersion) MsgID() uint8 {
+	Capabilities            uint64   `mavlink:"capabilities"`              // bitmask of capabilities (see MAV_PROTOCOL_CAPABILITY enum)
+	Uid                     uint64   `mavlink:"uid"`                       // UID if provided by hardware
+	FlightSwVersion         uint32   `mavlink:"flight_sw_version"`         // Firmware version number
+	MiddlewareSwVersion     uint32   `mavlink:"middleware_sw_version"`     // Middleware version number
+	OsSwVersion             uint32   `mavlink:"os_sw_version"`             // Operating system version number
+	BoardVersion            uint32   `mavlink:"board_version"`             // HW / board version (last 8 bytes should be silicon ID, if any)
+	VendorId                uint16   `mavlink:"vendor_id"`                 // ID of the board vendor
+	ProductId               uint16   `mavlink:"product_id"`                // ID of the product
+	FlightCustomVersion     [8]uint8 `mavlink:"flight_custom_version"`     // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
+	MiddlewareCustomVersion [8]uint8 `mavlink:"middleware_custom_version"` // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
+	OsCustomVersion         [8]uint8 `mavlink:"os_custom_version"`         // Custom version field, commonly the first 8 bytes of the git hash. This is not an unique identifier, but should allow to identify the commit using the main version number even for very large code bases.
+}
+
+func (self *AutopilotVersion) MsgID() uint32 {
 	return 148
 }
 
@@ -6468,33 +9556,42 @@ func (self *AutopilotVersion) Unpack(p *Packet) error {
 	if len(p.Payload) < 60 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Capabilities = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.Uid = uint64(binary.LittleEndian.Uint64(p.Payload[8:]))
-	self.FlightSwVersion = uint32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.MiddlewareSwVersion = uint32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.OsSwVersion = uint32(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.BoardVersion = uint32(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.VendorId = uint16(binary.LittleEndian.Uint16(p.Payload[32:]))
-	self.ProductId = uint16(binary.LittleEndian.Uint16(p.Payload[34:]))
-	copy(self.FlightCustomVersion[:], p.Payload[36:44])
-	copy(self.MiddlewareCustomVersion[:], p.Payload[44:52])
-	copy(self.OsCustomVersion[:], p.Payload[52:60])
+	payload := p.Payload
+	self.Capabilities = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.Uid = uint64(binary.LittleEndian.Uint64(payload[8:]))
+	self.FlightSwVersion = uint32(binary.LittleEndian.Uint32(payload[16:]))
+	self.MiddlewareSwVersion = uint32(binary.LittleEndian.Uint32(payload[20:]))
+	self.OsSwVersion = uint32(binary.LittleEndian.Uint32(payload[24:]))
+	self.BoardVersion = uint32(binary.LittleEndian.Uint32(payload[28:]))
+	self.VendorId = uint16(binary.LittleEndian.Uint16(payload[32:]))
+	self.ProductId = uint16(binary.LittleEndian.Uint16(payload[34:]))
+	copy(self.FlightCustomVersion[:], payload[36:44])
+	copy(self.MiddlewareCustomVersion[:], payload[44:52])
+	copy(self.OsCustomVersion[:], payload[52:60])
 	return nil
 }
 
+func (self *AutopilotVersion) MarshalJSON() ([]byte, error) {
+	return marshalMessage("AUTOPILOT_VERSION", self)
+}
+
+func (self *AutopilotVersion) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("AUTOPILOT_VERSION", self, data)
+}
+
 // The location of a landing area captured from a downward facing camera
 type LandingTarget struct {
-	TimeUsec  uint64  // Timestamp (micros since boot or Unix epoch)
-	AngleX    float32 // X-axis angular offset (in radians) of the target from the center of the image
-	AngleY    float32 // Y-axis angular offset (in radians) of the target from the center of the image
-	Distance  float32 // Distance to the target from the vehicle in meters
-	SizeX     float32 // Size in radians of target along x-axis
-	SizeY     float32 // Size in radians of target along y-axis
-	TargetNum uint8   // The ID of the target if multiple targets are present
-	Frame     uint8   // MAV_FRAME enum specifying the whether the following feilds are earth-frame, body-frame, etc.
+	TimeUsec  uint64  `mavlink:"time_usec"`  // Timestamp (micros since boot or Unix epoch)
+	AngleX    float32 `mavlink:"angle_x"`    // X-axis angular offset (in radians) of the target from the center of the image
+	AngleY    float32 `mavlink:"angle_y"`    // Y-axis angular offset (in radians) of the target from the center of the image
+	Distance  float32 `mavlink:"distance"`   // Distance to the target from the vehicle in meters
+	SizeX     float32 `mavlink:"size_x"`     // Size in radians of target along x-axis
+	SizeY     float32 `mavlink:"size_y"`     // Size in radians of target along y-axis
+	TargetNum uint8   `mavlink:"target_num"` // The ID of the target if multiple targets are present
+	Frame     uint8   `mavlink:"frame"`      // MAV_FRAME enum specifying the whether the following feilds are earth-frame, body-frame, etc.
 }
 
-func (self *LandingTarget) MsgID() uint8 {
+func (self *LandingTarget) MsgID() uint32 {
 	return 149
 }
 
@@ -6522,32 +9619,41 @@ func (self *LandingTarget) Unpack(p *Packet) error {
 	if len(p.Payload) < 30 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.AngleX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.AngleY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.SizeX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.SizeY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.TargetNum = uint8(p.Payload[28])
-	self.Frame = uint8(p.Payload[29])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.AngleX = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.AngleY = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Distance = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.SizeX = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.SizeY = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.TargetNum = uint8(payload[28])
+	self.Frame = uint8(payload[29])
 	return nil
 }
 
+func (self *LandingTarget) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LANDING_TARGET", self)
+}
+
+func (self *LandingTarget) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LANDING_TARGET", self, data)
+}
+
 // Estimator status message including flags, innovation test ratios and estimated accuracies. The flags message is an integer bitmask containing information on which EKF outputs are valid. See the ESTIMATOR_STATUS_FLAGS enum definition for further information. The innovaton test ratios show the magnitude of the sensor innovation divided by the innovation check threshold. Under normal operation the innovaton test ratios should be below 0.5 with occasional values up to 1.0. Values greater than 1.0 should be rare under normal operation and indicate that a measurement has been rejected by the filter. The user should be notified if an innovation test ratio greater than 1.0 is recorded. Notifications for values in the range between 0.5 and 1.0 should be optional and controllable by the user.
 type EstimatorStatus struct {
-	TimeUsec         uint64  // Timestamp (micros since boot or Unix epoch)
-	VelRatio         float32 // Velocity innovation test ratio
-	PosHorizRatio    float32 // Horizontal position innovation test ratio
-	PosVertRatio     float32 // Vertical position innovation test ratio
-	MagRatio         float32 // Magnetometer innovation test ratio
-	HaglRatio        float32 // Height above terrain innovation test ratio
-	TasRatio         float32 // True airspeed innovation test ratio
-	PosHorizAccuracy float32 // Horizontal position 1-STD accuracy relative to the EKF local origin (m)
-	PosVertAccuracy  float32 // Vertical position 1-STD accuracy relative to the EKF local origin (m)
-	Flags            uint16  // Integer bitmask indicating which EKF outputs are valid. See definition for ESTIMATOR_STATUS_FLAGS.
-}
-
-func (self *EstimatorStatus) MsgID() uint8 {
+	TimeUsec         uint64  `mavlink:"time_usec"`          // Timestamp (micros since boot or Unix epoch)
+	VelRatio         float32 `mavlink:"vel_ratio"`          // Velocity innovation test ratio
+	PosHorizRatio    float32 `mavlink:"pos_horiz_ratio"`    // Horizontal position innovation test ratio
+	PosVertRatio     float32 `mavlink:"pos_vert_ratio"`     // Vertical position innovation test ratio
+	MagRatio         float32 `mavlink:"mag_ratio"`          // Magnetometer innovation test ratio
+	HaglRatio        float32 `mavlink:"hagl_ratio"`         // Height above terrain innovation test ratio
+	TasRatio         float32 `mavlink:"tas_ratio"`          // True airspeed innovation test ratio
+	PosHorizAccuracy float32 `mavlink:"pos_horiz_accuracy"` // Horizontal position 1-STD accuracy relative to the EKF local origin (m)
+	PosVertAccuracy  float32 `mavlink:"pos_vert_accuracy"`  // Vertical position 1-STD accuracy relative to the EKF local origin (m)
+	Flags            uint16  `mavlink:"flags"`              // Integer bitmask indicating which EKF outputs are valid. See definition for ESTIMATOR_STATUS_FLAGS.
+}
+
+func (self *EstimatorStatus) MsgID() uint32 {
 	return 230
 }
 
@@ -6577,33 +9683,41 @@ func (self *EstimatorStatus) Unpack(p *Packet) error {
 	if len(p.Payload) < 42 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.VelRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.PosHorizRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.PosVertRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.MagRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.HaglRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.TasRatio = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.PosHorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.PosVertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Flags = uint16(binary.LittleEndian.Uint16(p.Payload[40:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.VelRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.PosHorizRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.PosVertRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.MagRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.HaglRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.TasRatio = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.PosHorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.PosVertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Flags = uint16(binary.LittleEndian.Uint16(payload[40:]))
 	return nil
 }
 
-//
+func (self *EstimatorStatus) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ESTIMATOR_STATUS", self)
+}
+
+func (self *EstimatorStatus) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ESTIMATOR_STATUS", self, data)
+}
+
 type WindCov struct {
-	TimeUsec      uint64  // Timestamp (micros since boot or Unix epoch)
-	WindX         float32 // Wind in X (NED) direction in m/s
-	WindY         float32 // Wind in Y (NED) direction in m/s
-	WindZ         float32 // Wind in Z (NED) direction in m/s
-	VarHoriz      float32 // Variability of the wind in XY. RMS of a 1 Hz lowpassed wind estimate.
-	VarVert       float32 // Variability of the wind in Z. RMS of a 1 Hz lowpassed wind estimate.
-	WindAlt       float32 // AMSL altitude (m) this measurement was taken at
-	HorizAccuracy float32 // Horizontal speed 1-STD accuracy
-	VertAccuracy  float32 // Vertical speed 1-STD accuracy
-}
-
-func (self *WindCov) MsgID() uint8 {
+	TimeUsec      uint64  `mavlink:"time_usec"`      // Timestamp (micros since boot or Unix epoch)
+	WindX         float32 `mavlink:"wind_x"`         // Wind in X (NED) direction in m/s
+	WindY         float32 `mavlink:"wind_y"`         // Wind in Y (NED) direction in m/s
+	WindZ         float32 `mavlink:"wind_z"`         // Wind in Z (NED) direction in m/s
+	VarHoriz      float32 `mavlink:"var_horiz"`      // Variability of the wind in XY. RMS of a 1 Hz lowpassed wind estimate.
+	VarVert       float32 `mavlink:"var_vert"`       // Variability of the wind in Z. RMS of a 1 Hz lowpassed wind estimate.
+	WindAlt       float32 `mavlink:"wind_alt"`       // AMSL altitude (m) this measurement was taken at
+	HorizAccuracy float32 `mavlink:"horiz_accuracy"` // Horizontal speed 1-STD accuracy
+	VertAccuracy  float32 `mavlink:"vert_accuracy"`  // Vertical speed 1-STD accuracy
+}
+
+func (self *WindCov) MsgID() uint32 {
 	return 231
 }
 
@@ -6632,41 +9746,50 @@ func (self *WindCov) Unpack(p *Packet) error {
 	if len(p.Payload) < 40 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.WindX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.WindY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.WindZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.VarHoriz = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.VarVert = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.WindAlt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.HorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.VertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.WindX = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.WindY = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.WindZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.VarHoriz = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.VarVert = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.WindAlt = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.HorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.VertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
 	return nil
 }
 
+func (self *WindCov) MarshalJSON() ([]byte, error) {
+	return marshalMessage("WIND_COV", self)
+}
+
+func (self *WindCov) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("WIND_COV", self, data)
+}
+
 // GPS sensor input message.  This is a raw sensor value sent by the GPS. This is NOT the global position estimate of the sytem.
 type GpsInput struct {
-	TimeUsec          uint64  // Timestamp (micros since boot or Unix epoch)
-	TimeWeekMs        uint32  // GPS time (milliseconds from start of GPS week)
-	Lat               int32   // Latitude (WGS84), in degrees * 1E7
-	Lon               int32   // Longitude (WGS84), in degrees * 1E7
-	Alt               float32 // Altitude (AMSL, not WGS84), in m (positive for up)
-	Hdop              float32 // GPS HDOP horizontal dilution of position in m
-	Vdop              float32 // GPS VDOP vertical dilution of position in m
-	Vn                float32 // GPS velocity in m/s in NORTH direction in earth-fixed NED frame
-	Ve                float32 // GPS velocity in m/s in EAST direction in earth-fixed NED frame
-	Vd                float32 // GPS velocity in m/s in DOWN direction in earth-fixed NED frame
-	SpeedAccuracy     float32 // GPS speed accuracy in m/s
-	HorizAccuracy     float32 // GPS horizontal accuracy in m
-	VertAccuracy      float32 // GPS vertical accuracy in m
-	IgnoreFlags       uint16  // Flags indicating which fields to ignore (see GPS_INPUT_IGNORE_FLAGS enum).  All other fields must be provided.
-	TimeWeek          uint16  // GPS week number
-	GpsId             uint8   // ID of the GPS for multiple GPS inputs
-	FixType           uint8   // 0-1: no fix, 2: 2D fix, 3: 3D fix. 4: 3D with DGPS. 5: 3D with RTK
-	SatellitesVisible uint8   // Number of satellites visible.
-}
-
-func (self *GpsInput) MsgID() uint8 {
+	TimeUsec          uint64  `mavlink:"time_usec"`          // Timestamp (micros since boot or Unix epoch)
+	TimeWeekMs        uint32  `mavlink:"time_week_ms"`       // GPS time (milliseconds from start of GPS week)
+	Lat               int32   `mavlink:"lat"`                // Latitude (WGS84), in degrees * 1E7
+	Lon               int32   `mavlink:"lon"`                // Longitude (WGS84), in degrees * 1E7
+	Alt               float32 `mavlink:"alt"`                // Altitude (AMSL, not WGS84), in m (positive for up)
+	Hdop              float32 `mavlink:"hdop"`               // GPS HDOP horizontal dilution of position in m
+	Vdop              float32 `mavlink:"vdop"`               // GPS VDOP vertical dilution of position in m
+	Vn                float32 `mavlink:"vn"`                 // GPS velocity in m/s in NORTH direction in earth-fixed NED frame
+	Ve                float32 `mavlink:"ve"`                 // GPS velocity in m/s in EAST direction in earth-fixed NED frame
+	Vd                float32 `mavlink:"vd"`                 // GPS velocity in m/s in DOWN direction in earth-fixed NED frame
+	SpeedAccuracy     float32 `mavlink:"speed_accuracy"`     // GPS speed accuracy in m/s
+	HorizAccuracy     float32 `mavlink:"horiz_accuracy"`     // GPS horizontal accuracy in m
+	VertAccuracy      float32 `mavlink:"vert_accuracy"`      // GPS vertical accuracy in m
+	IgnoreFlags       uint16  `mavlink:"ignore_flags"`       // Flags indicating which fields to ignore (see GPS_INPUT_IGNORE_FLAGS enum).  All other fields must be provided.
+	TimeWeek          uint16  `mavlink:"time_week"`          // GPS week number
+	GpsId             uint8   `mavlink:"gps_id"`             // ID of the GPS for multiple GPS inputs
+	FixType           uint8   `mavlink:"fix_type"`           // 0-1: no fix, 2: 2D fix, 3: 3D fix. 4: 3D with DGPS. 5: 3D with RTK
+	SatellitesVisible uint8   `mavlink:"satellites_visible"` // Number of satellites visible.
+}
+
+func (self *GpsInput) MsgID() uint32 {
 	return 232
 }
 
@@ -6704,35 +9827,44 @@ func (self *GpsInput) Unpack(p *Packet) error {
 	if len(p.Payload) < 63 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.TimeWeekMs = uint32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Hdop = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Vdop = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	self.Vn = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[32:]))
-	self.Ve = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[36:]))
-	self.Vd = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.SpeedAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.HorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
-	self.VertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[52:]))
-	self.IgnoreFlags = uint16(binary.LittleEndian.Uint16(p.Payload[56:]))
-	self.TimeWeek = uint16(binary.LittleEndian.Uint16(p.Payload[58:]))
-	self.GpsId = uint8(p.Payload[60])
-	self.FixType = uint8(p.Payload[61])
-	self.SatellitesVisible = uint8(p.Payload[62])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.TimeWeekMs = uint32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[16:]))
+	self.Alt = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.Hdop = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.Vdop = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	self.Vn = math.Float32frombits(binary.LittleEndian.Uint32(payload[32:]))
+	self.Ve = math.Float32frombits(binary.LittleEndian.Uint32(payload[36:]))
+	self.Vd = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.SpeedAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.HorizAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
+	self.VertAccuracy = math.Float32frombits(binary.LittleEndian.Uint32(payload[52:]))
+	self.IgnoreFlags = uint16(binary.LittleEndian.Uint16(payload[56:]))
+	self.TimeWeek = uint16(binary.LittleEndian.Uint16(payload[58:]))
+	self.GpsId = uint8(payload[60])
+	self.FixType = uint8(payload[61])
+	self.SatellitesVisible = uint8(payload[62])
 	return nil
 }
 
+func (self *GpsInput) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_INPUT", self)
+}
+
+func (self *GpsInput) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_INPUT", self, data)
+}
+
 // WORK IN PROGRESS! RTCM message for injecting into the onboard GPS (used for DGPS)
 type GpsRtcmData struct {
-	Flags uint8      // LSB: 1 means message is fragmented
-	Len   uint8      // data length
-	Data  [180]uint8 // RTCM message (may be fragmented)
+	Flags uint8      `mavlink:"flags"` // LSB: 1 means message is fragmented
+	Len   uint8      `mavlink:"len"`   // data length
+	Data  [180]uint8 `mavlink:"data"`  // RTCM message (may be fragmented)
 }
 
-func (self *GpsRtcmData) MsgID() uint8 {
+func (self *GpsRtcmData) MsgID() uint32 {
 	return 233
 }
 
@@ -6755,25 +9887,34 @@ func (self *GpsRtcmData) Unpack(p *Packet) error {
 	if len(p.Payload) < 182 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Flags = uint8(p.Payload[0])
-	self.Len = uint8(p.Payload[1])
-	copy(self.Data[:], p.Payload[2:182])
+	payload := p.Payload
+	self.Flags = uint8(payload[0])
+	self.Len = uint8(payload[1])
+	copy(self.Data[:], payload[2:182])
 	return nil
 }
 
+func (self *GpsRtcmData) MarshalJSON() ([]byte, error) {
+	return marshalMessage("GPS_RTCM_DATA", self)
+}
+
+func (self *GpsRtcmData) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("GPS_RTCM_DATA", self, data)
+}
+
 // Quality data about specific landing positions
 type LandingMap struct {
-	TimeUsec uint64     // Timestamp (micros since boot or Unix epoch)
-	BestX    float32    // Best landing position on X-axis
-	BestY    float32    // Best landing position on Y-axis
-	BestZ    float32    // Best landing position on Z-axis
-	LocalX   float32    // Position on X-axis
-	LocalY   float32    // Position on Y-axis
-	LocalZ   float32    // Position on Z-axis
-	Factors  [169]uint8 // LSB 0-2: Score between 0 and 7, LSB 3-7: Distance to the vehicle in meters.
+	TimeUsec uint64     `mavlink:"time_usec"` // Timestamp (micros since boot or Unix epoch)
+	BestX    float32    `mavlink:"best_x"`    // Best landing position on X-axis
+	BestY    float32    `mavlink:"best_y"`    // Best landing position on Y-axis
+	BestZ    float32    `mavlink:"best_z"`    // Best landing position on Z-axis
+	LocalX   float32    `mavlink:"local_x"`   // Position on X-axis
+	LocalY   float32    `mavlink:"local_y"`   // Position on Y-axis
+	LocalZ   float32    `mavlink:"local_z"`   // Position on Z-axis
+	Factors  [169]uint8 `mavlink:"factors"`   // LSB 0-2: Score between 0 and 7, LSB 3-7: Distance to the vehicle in meters.
 }
 
-func (self *LandingMap) MsgID() uint8 {
+func (self *LandingMap) MsgID() uint32 {
 	return 240
 }
 
@@ -6801,29 +9942,38 @@ func (self *LandingMap) Unpack(p *Packet) error {
 	if len(p.Payload) < 201 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.BestX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.BestY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.BestZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.LocalX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.LocalY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.LocalZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[28:]))
-	copy(self.Factors[:], p.Payload[32:201])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.BestX = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.BestY = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.BestZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.LocalX = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
+	self.LocalY = math.Float32frombits(binary.LittleEndian.Uint32(payload[24:]))
+	self.LocalZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[28:]))
+	copy(self.Factors[:], payload[32:201])
 	return nil
 }
 
+func (self *LandingMap) MarshalJSON() ([]byte, error) {
+	return marshalMessage("LANDING_MAP", self)
+}
+
+func (self *LandingMap) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("LANDING_MAP", self, data)
+}
+
 // Vibration levels and accelerometer clipping
 type Vibration struct {
-	TimeUsec   uint64  // Timestamp (micros since boot or Unix epoch)
-	VibrationX float32 // Vibration levels on X-axis
-	VibrationY float32 // Vibration levels on Y-axis
-	VibrationZ float32 // Vibration levels on Z-axis
-	Clipping0  uint32  // first accelerometer clipping count
-	Clipping1  uint32  // second accelerometer clipping count
-	Clipping2  uint32  // third accelerometer clipping count
+	TimeUsec   uint64  `mavlink:"time_usec"`   // Timestamp (micros since boot or Unix epoch)
+	VibrationX float32 `mavlink:"vibration_x"` // Vibration levels on X-axis
+	VibrationY float32 `mavlink:"vibration_y"` // Vibration levels on Y-axis
+	VibrationZ float32 `mavlink:"vibration_z"` // Vibration levels on Z-axis
+	Clipping0  uint32  `mavlink:"clipping_0"`  // first accelerometer clipping count
+	Clipping1  uint32  `mavlink:"clipping_1"`  // second accelerometer clipping count
+	Clipping2  uint32  `mavlink:"clipping_2"`  // third accelerometer clipping count
 }
 
-func (self *Vibration) MsgID() uint8 {
+func (self *Vibration) MsgID() uint32 {
 	return 241
 }
 
@@ -6850,31 +10000,40 @@ func (self *Vibration) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.VibrationX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.VibrationY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.VibrationZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Clipping0 = uint32(binary.LittleEndian.Uint32(p.Payload[20:]))
-	self.Clipping1 = uint32(binary.LittleEndian.Uint32(p.Payload[24:]))
-	self.Clipping2 = uint32(binary.LittleEndian.Uint32(p.Payload[28:]))
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.VibrationX = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.VibrationY = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.VibrationZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Clipping0 = uint32(binary.LittleEndian.Uint32(payload[20:]))
+	self.Clipping1 = uint32(binary.LittleEndian.Uint32(payload[24:]))
+	self.Clipping2 = uint32(binary.LittleEndian.Uint32(payload[28:]))
 	return nil
 }
 
+func (self *Vibration) MarshalJSON() ([]byte, error) {
+	return marshalMessage("VIBRATION", self)
+}
+
+func (self *Vibration) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("VIBRATION", self, data)
+}
+
 // This message can be requested by sending the MAV_CMD_GET_HOME_POSITION command. The position the system will return to and land on. The position is set automatically by the system during the takeoff in case it was not explicitely set by the operator before or after. The position the system will return to and land on. The global and local positions encode the position in the respective coordinate frames, while the q parameter encodes the orientation of the surface. Under normal conditions it describes the heading and terrain slope, which can be used by the aircraft to adjust the approach. The approach 3D vector describes the point to which the system should fly in normal flight mode and then perform a landing sequence along the vector.
 type HomePosition struct {
-	Latitude  int32      // Latitude (WGS84), in degrees * 1E7
-	Longitude int32      // Longitude (WGS84, in degrees * 1E7
-	Altitude  int32      // Altitude (AMSL), in meters * 1000 (positive for up)
-	X         float32    // Local X position of this position in the local coordinate frame
-	Y         float32    // Local Y position of this position in the local coordinate frame
-	Z         float32    // Local Z position of this position in the local coordinate frame
-	Q         [4]float32 // World to surface normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground
-	ApproachX float32    // Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-	ApproachY float32    // Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-	ApproachZ float32    // Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-}
-
-func (self *HomePosition) MsgID() uint8 {
+	Latitude  int32      `mavlink:"latitude"`   // Latitude (WGS84), in degrees * 1E7
+	Longitude int32      `mavlink:"longitude"`  // Longitude (WGS84, in degrees * 1E7
+	Altitude  int32      `mavlink:"altitude"`   // Altitude (AMSL), in meters * 1000 (positive for up)
+	X         float32    `mavlink:"x"`          // Local X position of this position in the local coordinate frame
+	Y         float32    `mavlink:"y"`          // Local Y position of this position in the local coordinate frame
+	Z         float32    `mavlink:"z"`          // Local Z position of this position in the local coordinate frame
+	Q         [4]float32 `mavlink:"q"`          // World to surface normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground
+	ApproachX float32    `mavlink:"approach_x"` // Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+	ApproachY float32    `mavlink:"approach_y"` // Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+	ApproachZ float32    `mavlink:"approach_z"` // Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+}
+
+func (self *HomePosition) MsgID() uint32 {
 	return 242
 }
 
@@ -6906,37 +10065,46 @@ func (self *HomePosition) Unpack(p *Packet) error {
 	if len(p.Payload) < 52 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Latitude = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Longitude = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Altitude = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
+	payload := p.Payload
+	self.Latitude = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Longitude = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Altitude = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[24+i*4:]))
 	}
-	self.ApproachX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.ApproachY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.ApproachZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
+	self.ApproachX = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.ApproachY = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.ApproachZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
 	return nil
 }
 
+func (self *HomePosition) MarshalJSON() ([]byte, error) {
+	return marshalMessage("HOME_POSITION", self)
+}
+
+func (self *HomePosition) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("HOME_POSITION", self, data)
+}
+
 // The position the system will return to and land on. The position is set automatically by the system during the takeoff in case it was not explicitely set by the operator before or after. The global and local positions encode the position in the respective coordinate frames, while the q parameter encodes the orientation of the surface. Under normal conditions it describes the heading and terrain slope, which can be used by the aircraft to adjust the approach. The approach 3D vector describes the point to which the system should fly in normal flight mode and then perform a landing sequence along the vector.
 type SetHomePosition struct {
-	Latitude     int32      // Latitude (WGS84), in degrees * 1E7
-	Longitude    int32      // Longitude (WGS84, in degrees * 1E7
-	Altitude     int32      // Altitude (AMSL), in meters * 1000 (positive for up)
-	X            float32    // Local X position of this position in the local coordinate frame
-	Y            float32    // Local Y position of this position in the local coordinate frame
-	Z            float32    // Local Z position of this position in the local coordinate frame
-	Q            [4]float32 // World to surface normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground
-	ApproachX    float32    // Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-	ApproachY    float32    // Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-	ApproachZ    float32    // Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
-	TargetSystem uint8      // System ID.
-}
-
-func (self *SetHomePosition) MsgID() uint8 {
+	Latitude     int32      `mavlink:"latitude"`      // Latitude (WGS84), in degrees * 1E7
+	Longitude    int32      `mavlink:"longitude"`     // Longitude (WGS84, in degrees * 1E7
+	Altitude     int32      `mavlink:"altitude"`      // Altitude (AMSL), in meters * 1000 (positive for up)
+	X            float32    `mavlink:"x"`             // Local X position of this position in the local coordinate frame
+	Y            float32    `mavlink:"y"`             // Local Y position of this position in the local coordinate frame
+	Z            float32    `mavlink:"z"`             // Local Z position of this position in the local coordinate frame
+	Q            [4]float32 `mavlink:"q"`             // World to surface normal and heading transformation of the takeoff position. Used to indicate the heading and slope of the ground
+	ApproachX    float32    `mavlink:"approach_x"`    // Local X position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+	ApproachY    float32    `mavlink:"approach_y"`    // Local Y position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+	ApproachZ    float32    `mavlink:"approach_z"`    // Local Z position of the end of the approach vector. Multicopters should set this position based on their takeoff path. Grass-landing fixed wing aircraft should set it the same way as multicopters. Runway-landing fixed wing aircraft should set it to the opposite direction of the takeoff, assuming the takeoff happened from the threshold / touchdown zone.
+	TargetSystem uint8      `mavlink:"target_system"` // System ID.
+}
+
+func (self *SetHomePosition) MsgID() uint32 {
 	return 243
 }
 
@@ -6969,29 +10137,38 @@ func (self *SetHomePosition) Unpack(p *Packet) error {
 	if len(p.Payload) < 53 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Latitude = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Longitude = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Altitude = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[20:]))
+	payload := p.Payload
+	self.Latitude = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Longitude = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Altitude = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[20:]))
 	for i := 0; i < len(self.Q); i++ {
-		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[24+i*4:]))
+		self.Q[i] = math.Float32frombits(binary.LittleEndian.Uint32(payload[24+i*4:]))
 	}
-	self.ApproachX = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[40:]))
-	self.ApproachY = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[44:]))
-	self.ApproachZ = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[48:]))
-	self.TargetSystem = uint8(p.Payload[52])
+	self.ApproachX = math.Float32frombits(binary.LittleEndian.Uint32(payload[40:]))
+	self.ApproachY = math.Float32frombits(binary.LittleEndian.Uint32(payload[44:]))
+	self.ApproachZ = math.Float32frombits(binary.LittleEndian.Uint32(payload[48:]))
+	self.TargetSystem = uint8(payload[52])
 	return nil
 }
 
+func (self *SetHomePosition) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SET_HOME_POSITION", self)
+}
+
+func (self *SetHomePosition) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SET_HOME_POSITION", self, data)
+}
+
 // This interface replaces DATA_STREAM
 type MessageInterval struct {
-	IntervalUs int32  // The interval between two messages, in microseconds. A value of -1 indicates this stream is disabled, 0 indicates it is not available, > 0 indicates the interval at which it is sent.
-	MessageId  uint16 // The ID of the requested MAVLink message. v1.0 is limited to 254 messages.
+	IntervalUs int32  `mavlink:"interval_us"` // The interval between two messages, in microseconds. A value of -1 indicates this stream is disabled, 0 indicates it is not available, > 0 indicates the interval at which it is sent.
+	MessageId  uint16 `mavlink:"message_id"`  // The ID of the requested MAVLink message. v1.0 is limited to 254 messages.
 }
 
-func (self *MessageInterval) MsgID() uint8 {
+func (self *MessageInterval) MsgID() uint32 {
 	return 244
 }
 
@@ -7013,18 +10190,27 @@ func (self *MessageInterval) Unpack(p *Packet) error {
 	if len(p.Payload) < 6 {
 		return fmt.Errorf("payload too small")
 	}
-	self.IntervalUs = int32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.MessageId = uint16(binary.LittleEndian.Uint16(p.Payload[4:]))
+	payload := p.Payload
+	self.IntervalUs = int32(binary.LittleEndian.Uint32(payload[0:]))
+	self.MessageId = uint16(binary.LittleEndian.Uint16(payload[4:]))
 	return nil
 }
 
+func (self *MessageInterval) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MESSAGE_INTERVAL", self)
+}
+
+func (self *MessageInterval) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MESSAGE_INTERVAL", self, data)
+}
+
 // Provides state for additional features
 type ExtendedSysState struct {
-	VtolState   uint8 // The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration.
-	LandedState uint8 // The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown.
+	VtolState   uint8 `mavlink:"vtol_state"`   // The VTOL state if applicable. Is set to MAV_VTOL_STATE_UNDEFINED if UAV is not in VTOL configuration.
+	LandedState uint8 `mavlink:"landed_state"` // The landed state. Is set to MAV_LANDED_STATE_UNDEFINED if landed state is unknown.
 }
 
-func (self *ExtendedSysState) MsgID() uint8 {
+func (self *ExtendedSysState) MsgID() uint32 {
 	return 245
 }
 
@@ -7046,29 +10232,38 @@ func (self *ExtendedSysState) Unpack(p *Packet) error {
 	if len(p.Payload) < 2 {
 		return fmt.Errorf("payload too small")
 	}
-	self.VtolState = uint8(p.Payload[0])
-	self.LandedState = uint8(p.Payload[1])
+	payload := p.Payload
+	self.VtolState = uint8(payload[0])
+	self.LandedState = uint8(payload[1])
 	return nil
 }
 
+func (self *ExtendedSysState) MarshalJSON() ([]byte, error) {
+	return marshalMessage("EXTENDED_SYS_STATE", self)
+}
+
+func (self *ExtendedSysState) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("EXTENDED_SYS_STATE", self, data)
+}
+
 // The location and information of an ADSB vehicle
 type AdsbVehicle struct {
-	IcaoAddress  uint32  // ICAO address
-	Lat          int32   // Latitude, expressed as degrees * 1E7
-	Lon          int32   // Longitude, expressed as degrees * 1E7
-	Altitude     int32   // Altitude(ASL) in millimeters
-	Heading      uint16  // Course over ground in centidegrees
-	HorVelocity  uint16  // The horizontal velocity in centimeters/second
-	VerVelocity  int16   // The vertical velocity in centimeters/second, positive is up
-	Flags        uint16  // Flags to indicate various statuses including valid data fields
-	Squawk       uint16  // Squawk code
-	AltitudeType uint8   // Type from ADSB_ALTITUDE_TYPE enum
-	Callsign     [9]byte // The callsign, 8+null
-	EmitterType  uint8   // Type from ADSB_EMITTER_TYPE enum
-	Tslc         uint8   // Time since last communication in seconds
-}
-
-func (self *AdsbVehicle) MsgID() uint8 {
+	IcaoAddress  uint32  `mavlink:"ICAO_address"`  // ICAO address
+	Lat          int32   `mavlink:"lat"`           // Latitude, expressed as degrees * 1E7
+	Lon          int32   `mavlink:"lon"`           // Longitude, expressed as degrees * 1E7
+	Altitude     int32   `mavlink:"altitude"`      // Altitude(ASL) in millimeters
+	Heading      uint16  `mavlink:"heading"`       // Course over ground in centidegrees
+	HorVelocity  uint16  `mavlink:"hor_velocity"`  // The horizontal velocity in centimeters/second
+	VerVelocity  int16   `mavlink:"ver_velocity"`  // The vertical velocity in centimeters/second, positive is up
+	Flags        uint16  `mavlink:"flags"`         // Flags to indicate various statuses including valid data fields
+	Squawk       uint16  `mavlink:"squawk"`        // Squawk code
+	AltitudeType uint8   `mavlink:"altitude_type"` // Type from ADSB_ALTITUDE_TYPE enum
+	Callsign     [9]byte `mavlink:"callsign,char"` // The callsign, 8+null
+	EmitterType  uint8   `mavlink:"emitter_type"`  // Type from ADSB_EMITTER_TYPE enum
+	Tslc         uint8   `mavlink:"tslc"`          // Time since last communication in seconds
+}
+
+func (self *AdsbVehicle) MsgID() uint32 {
 	return 246
 }
 
@@ -7101,34 +10296,43 @@ func (self *AdsbVehicle) Unpack(p *Packet) error {
 	if len(p.Payload) < 38 {
 		return fmt.Errorf("payload too small")
 	}
-	self.IcaoAddress = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Lat = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Lon = int32(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Altitude = int32(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Heading = uint16(binary.LittleEndian.Uint16(p.Payload[16:]))
-	self.HorVelocity = uint16(binary.LittleEndian.Uint16(p.Payload[18:]))
-	self.VerVelocity = int16(binary.LittleEndian.Uint16(p.Payload[20:]))
-	self.Flags = uint16(binary.LittleEndian.Uint16(p.Payload[22:]))
-	self.Squawk = uint16(binary.LittleEndian.Uint16(p.Payload[24:]))
-	self.AltitudeType = uint8(p.Payload[26])
-	copy(self.Callsign[:], p.Payload[27:36])
-	self.EmitterType = uint8(p.Payload[36])
-	self.Tslc = uint8(p.Payload[37])
+	payload := p.Payload
+	self.IcaoAddress = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Lat = int32(binary.LittleEndian.Uint32(payload[4:]))
+	self.Lon = int32(binary.LittleEndian.Uint32(payload[8:]))
+	self.Altitude = int32(binary.LittleEndian.Uint32(payload[12:]))
+	self.Heading = uint16(binary.LittleEndian.Uint16(payload[16:]))
+	self.HorVelocity = uint16(binary.LittleEndian.Uint16(payload[18:]))
+	self.VerVelocity = int16(binary.LittleEndian.Uint16(payload[20:]))
+	self.Flags = uint16(binary.LittleEndian.Uint16(payload[22:]))
+	self.Squawk = uint16(binary.LittleEndian.Uint16(payload[24:]))
+	self.AltitudeType = uint8(payload[26])
+	copy(self.Callsign[:], payload[27:36])
+	self.EmitterType = uint8(payload[36])
+	self.Tslc = uint8(payload[37])
 	return nil
 }
 
+func (self *AdsbVehicle) MarshalJSON() ([]byte, error) {
+	return marshalMessage("ADSB_VEHICLE", self)
+}
+
+func (self *AdsbVehicle) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("ADSB_VEHICLE", self, data)
+}
+
 // Information about a potential collision
 type Collision struct {
-	Id                     uint32  // Unique identifier, domain based on src field
-	TimeToMinimumDelta     float32 // Estimated time until collision occurs (seconds)
-	AltitudeMinimumDelta   float32 // Closest vertical distance in meters between vehicle and object
-	HorizontalMinimumDelta float32 // Closest horizontal distance in meteres between vehicle and object
-	Src                    uint8   // Collision data source
-	Action                 uint8   // Action that is being taken to avoid this collision
-	ThreatLevel            uint8   // How concerned the aircraft is about this collision
+	Id                     uint32  `mavlink:"id"`                       // Unique identifier, domain based on src field
+	TimeToMinimumDelta     float32 `mavlink:"time_to_minimum_delta"`    // Estimated time until collision occurs (seconds)
+	AltitudeMinimumDelta   float32 `mavlink:"altitude_minimum_delta"`   // Closest vertical distance in meters between vehicle and object
+	HorizontalMinimumDelta float32 `mavlink:"horizontal_minimum_delta"` // Closest horizontal distance in meteres between vehicle and object
+	Src                    uint8   `mavlink:"src"`                      // Collision data source
+	Action                 uint8   `mavlink:"action"`                   // Action that is being taken to avoid this collision
+	ThreatLevel            uint8   `mavlink:"threat_level"`             // How concerned the aircraft is about this collision
 }
 
-func (self *Collision) MsgID() uint8 {
+func (self *Collision) MsgID() uint32 {
 	return 247
 }
 
@@ -7155,26 +10359,35 @@ func (self *Collision) Unpack(p *Packet) error {
 	if len(p.Payload) < 19 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Id = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.TimeToMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.AltitudeMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.HorizontalMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Src = uint8(p.Payload[16])
-	self.Action = uint8(p.Payload[17])
-	self.ThreatLevel = uint8(p.Payload[18])
+	payload := p.Payload
+	self.Id = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.TimeToMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.AltitudeMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.HorizontalMinimumDelta = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Src = uint8(payload[16])
+	self.Action = uint8(payload[17])
+	self.ThreatLevel = uint8(payload[18])
 	return nil
 }
 
+func (self *Collision) MarshalJSON() ([]byte, error) {
+	return marshalMessage("COLLISION", self)
+}
+
+func (self *Collision) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("COLLISION", self, data)
+}
+
 // Message implementing parts of the V2 payload specs in V1 frames for transitional support.
 type V2Extension struct {
-	MessageType     uint16     // A code that identifies the software component that understands this message (analogous to usb device classes or mime type strings).  If this code is less than 32768, it is considered a 'registered' protocol extension and the corresponding entry should be added to https://github.com/mavlink/mavlink/extension-message-ids.xml.  Software creators can register blocks of message IDs as needed (useful for GCS specific metadata, etc...). Message_types greater than 32767 are considered local experiments and should not be checked in to any widely distributed codebase.
-	TargetNetwork   uint8      // Network ID (0 for broadcast)
-	TargetSystem    uint8      // System ID (0 for broadcast)
-	TargetComponent uint8      // Component ID (0 for broadcast)
-	Payload         [249]uint8 // Variable length payload. The length is defined by the remaining message length when subtracting the header and other fields.  The entire content of this block is opaque unless you understand any the encoding message_type.  The particular encoding used can be extension specific and might not always be documented as part of the mavlink specification.
+	MessageType     uint16     `mavlink:"message_type"`     // A code that identifies the software component that understands this message (analogous to usb device classes or mime type strings).  If this code is less than 32768, it is considered a 'registered' protocol extension and the corresponding entry should be added to https://github.com/mavlink/mavlink/extension-message-ids.xml.  Software creators can register blocks of message IDs as needed (useful for GCS specific metadata, etc...). Message_types greater than 32767 are considered local experiments and should not be checked in to any widely distributed codebase.
+	TargetNetwork   uint8      `mavlink:"target_network"`   // Network ID (0 for broadcast)
+	TargetSystem    uint8      `mavlink:"target_system"`    // System ID (0 for broadcast)
+	TargetComponent uint8      `mavlink:"target_component"` // Component ID (0 for broadcast)
+	Payload         [249]uint8 `mavlink:"payload"`          // Variable length payload. The length is defined by the remaining message length when subtracting the header and other fields.  The entire content of this block is opaque unless you understand any the encoding message_type.  The particular encoding used can be extension specific and might not always be documented as part of the mavlink specification.
 }
 
-func (self *V2Extension) MsgID() uint8 {
+func (self *V2Extension) MsgID() uint32 {
 	return 248
 }
 
@@ -7199,23 +10412,32 @@ func (self *V2Extension) Unpack(p *Packet) error {
 	if len(p.Payload) < 254 {
 		return fmt.Errorf("payload too small")
 	}
-	self.MessageType = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.TargetNetwork = uint8(p.Payload[2])
-	self.TargetSystem = uint8(p.Payload[3])
-	self.TargetComponent = uint8(p.Payload[4])
-	copy(self.Payload[:], p.Payload[5:254])
+	payload := p.Payload
+	self.MessageType = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.TargetNetwork = uint8(payload[2])
+	self.TargetSystem = uint8(payload[3])
+	self.TargetComponent = uint8(payload[4])
+	copy(self.Payload[:], payload[5:254])
 	return nil
 }
 
+func (self *V2Extension) MarshalJSON() ([]byte, error) {
+	return marshalMessage("V2_EXTENSION", self)
+}
+
+func (self *V2Extension) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("V2_EXTENSION", self, data)
+}
+
 // Send raw controller memory. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type MemoryVect struct {
-	Address uint16   // Starting address of the debug variables
-	Ver     uint8    // Version code of the type variable. 0=unknown, type ignored and assumed int16_t. 1=as below
-	Type    uint8    // Type code of the memory variables. for ver = 1: 0=16 x int16_t, 1=16 x uint16_t, 2=16 x Q15, 3=16 x 1Q14
-	Value   [32]int8 // Memory contents at specified address
+	Address uint16   `mavlink:"address"` // Starting address of the debug variables
+	Ver     uint8    `mavlink:"ver"`     // Version code of the type variable. 0=unknown, type ignored and assumed int16_t. 1=as below
+	Type    uint8    `mavlink:"type"`    // Type code of the memory variables. for ver = 1: 0=16 x int16_t, 1=16 x uint16_t, 2=16 x Q15, 3=16 x 1Q14
+	Value   [32]int8 `mavlink:"value"`   // Memory contents at specified address
 }
 
-func (self *MemoryVect) MsgID() uint8 {
+func (self *MemoryVect) MsgID() uint32 {
 	return 249
 }
 
@@ -7241,25 +10463,33 @@ func (self *MemoryVect) Unpack(p *Packet) error {
 	if len(p.Payload) < 36 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Address = uint16(binary.LittleEndian.Uint16(p.Payload[0:]))
-	self.Ver = uint8(p.Payload[2])
-	self.Type = uint8(p.Payload[3])
+	payload := p.Payload
+	self.Address = uint16(binary.LittleEndian.Uint16(payload[0:]))
+	self.Ver = uint8(payload[2])
+	self.Type = uint8(payload[3])
 	for i := 0; i < len(self.Value); i++ {
-		self.Value[i] = int8(p.Payload[4+i*1])
+		self.Value[i] = int8(payload[4+i*1])
 	}
 	return nil
 }
 
-//
+func (self *MemoryVect) MarshalJSON() ([]byte, error) {
+	return marshalMessage("MEMORY_VECT", self)
+}
+
+func (self *MemoryVect) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("MEMORY_VECT", self, data)
+}
+
 type DebugVect struct {
-	TimeUsec uint64   // Timestamp
-	X        float32  // x
-	Y        float32  // y
-	Z        float32  // z
-	Name     [10]byte // Name
+	TimeUsec uint64   `mavlink:"time_usec"` // Timestamp
+	X        float32  `mavlink:"x"`         // x
+	Y        float32  `mavlink:"y"`         // y
+	Z        float32  `mavlink:"z"`         // z
+	Name     [10]byte `mavlink:"name,char"` // Name
 }
 
-func (self *DebugVect) MsgID() uint8 {
+func (self *DebugVect) MsgID() uint32 {
 	return 250
 }
 
@@ -7284,22 +10514,31 @@ func (self *DebugVect) Unpack(p *Packet) error {
 	if len(p.Payload) < 30 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeUsec = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.X = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[8:]))
-	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[12:]))
-	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[16:]))
-	copy(self.Name[:], p.Payload[20:30])
+	payload := p.Payload
+	self.TimeUsec = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.X = math.Float32frombits(binary.LittleEndian.Uint32(payload[8:]))
+	self.Y = math.Float32frombits(binary.LittleEndian.Uint32(payload[12:]))
+	self.Z = math.Float32frombits(binary.LittleEndian.Uint32(payload[16:]))
+	copy(self.Name[:], payload[20:30])
 	return nil
 }
 
+func (self *DebugVect) MarshalJSON() ([]byte, error) {
+	return marshalMessage("DEBUG_VECT", self)
+}
+
+func (self *DebugVect) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("DEBUG_VECT", self, data)
+}
+
 // Send a key-value pair as float. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type NamedValueFloat struct {
-	TimeBootMs uint32   // Timestamp (milliseconds since system boot)
-	Value      float32  // Floating point value
-	Name       [10]byte // Name of the debug variable
+	TimeBootMs uint32   `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Value      float32  `mavlink:"value"`        // Floating point value
+	Name       [10]byte `mavlink:"name,char"`    // Name of the debug variable
 }
 
-func (self *NamedValueFloat) MsgID() uint8 {
+func (self *NamedValueFloat) MsgID() uint32 {
 	return 251
 }
 
@@ -7322,20 +10561,29 @@ func (self *NamedValueFloat) Unpack(p *Packet) error {
 	if len(p.Payload) < 18 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Value = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	copy(self.Name[:], p.Payload[8:18])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Value = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	copy(self.Name[:], payload[8:18])
 	return nil
 }
 
+func (self *NamedValueFloat) MarshalJSON() ([]byte, error) {
+	return marshalMessage("NAMED_VALUE_FLOAT", self)
+}
+
+func (self *NamedValueFloat) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("NAMED_VALUE_FLOAT", self, data)
+}
+
 // Send a key-value pair as integer. The use of this message is discouraged for normal packets, but a quite efficient way for testing new messages and getting experimental debug output.
 type NamedValueInt struct {
-	TimeBootMs uint32   // Timestamp (milliseconds since system boot)
-	Value      int32    // Signed integer value
-	Name       [10]byte // Name of the debug variable
+	TimeBootMs uint32   `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Value      int32    `mavlink:"value"`        // Signed integer value
+	Name       [10]byte `mavlink:"name,char"`    // Name of the debug variable
 }
 
-func (self *NamedValueInt) MsgID() uint8 {
+func (self *NamedValueInt) MsgID() uint32 {
 	return 252
 }
 
@@ -7358,19 +10606,28 @@ func (self *NamedValueInt) Unpack(p *Packet) error {
 	if len(p.Payload) < 18 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Value = int32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	copy(self.Name[:], p.Payload[8:18])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Value = int32(binary.LittleEndian.Uint32(payload[4:]))
+	copy(self.Name[:], payload[8:18])
 	return nil
 }
 
+func (self *NamedValueInt) MarshalJSON() ([]byte, error) {
+	return marshalMessage("NAMED_VALUE_INT", self)
+}
+
+func (self *NamedValueInt) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("NAMED_VALUE_INT", self, data)
+}
+
 // Status text message. These messages are printed in yellow in the COMM console of QGroundControl. WARNING: They consume quite some bandwidth, so use only for important status and error messages. If implemented wisely, these messages are buffered on the MCU and sent only at a limited rate (e.g. 10 Hz).
 type Statustext struct {
-	Severity uint8    // Severity of status. Relies on the definitions within RFC-5424. See enum MAV_SEVERITY.
-	Text     [50]byte // Status text message, without null termination character
+	Severity uint8    `mavlink:"severity"`  // Severity of status. Relies on the definitions within RFC-5424. See enum MAV_SEVERITY.
+	Text     [50]byte `mavlink:"text,char"` // Status text message, without null termination character
 }
 
-func (self *Statustext) MsgID() uint8 {
+func (self *Statustext) MsgID() uint32 {
 	return 253
 }
 
@@ -7392,19 +10649,28 @@ func (self *Statustext) Unpack(p *Packet) error {
 	if len(p.Payload) < 51 {
 		return fmt.Errorf("payload too small")
 	}
-	self.Severity = uint8(p.Payload[0])
-	copy(self.Text[:], p.Payload[1:51])
+	payload := p.Payload
+	self.Severity = uint8(payload[0])
+	copy(self.Text[:], payload[1:51])
 	return nil
 }
 
+func (self *Statustext) MarshalJSON() ([]byte, error) {
+	return marshalMessage("STATUSTEXT", self)
+}
+
+func (self *Statustext) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("STATUSTEXT", self, data)
+}
+
 // Send a debug value. The index is used to discriminate between values. These values show up in the plot of QGroundControl as DEBUG N.
 type Debug struct {
-	TimeBootMs uint32  // Timestamp (milliseconds since system boot)
-	Value      float32 // DEBUG value
-	Ind        uint8   // index of debug variable
+	TimeBootMs uint32  `mavlink:"time_boot_ms"` // Timestamp (milliseconds since system boot)
+	Value      float32 `mavlink:"value"`        // DEBUG value
+	Ind        uint8   `mavlink:"ind"`          // index of debug variable
 }
 
-func (self *Debug) MsgID() uint8 {
+func (self *Debug) MsgID() uint32 {
 	return 254
 }
 
@@ -7427,22 +10693,31 @@ func (self *Debug) Unpack(p *Packet) error {
 	if len(p.Payload) < 9 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.Value = math.Float32frombits(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.Ind = uint8(p.Payload[8])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.Value = math.Float32frombits(binary.LittleEndian.Uint32(payload[4:]))
+	self.Ind = uint8(payload[8])
 	return nil
 }
 
+func (self *Debug) MarshalJSON() ([]byte, error) {
+	return marshalMessage("DEBUG", self)
+}
+
+func (self *Debug) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("DEBUG", self, data)
+}
+
 // Setup a MAVLink2 signing key. If called with secret_key of all zero and zero initial_timestamp will disable signing
 type SetupSigning struct {
-	InitialTimestamp uint64    // initial timestamp
-	TargetSystem     uint8     // system id of the target
-	TargetComponent  uint8     // component ID of the target
-	SecretKey        [32]uint8 // signing key
+	InitialTimestamp uint64    `mavlink:"initial_timestamp"` // initial timestamp
+	TargetSystem     uint8     `mavlink:"target_system"`     // system id of the target
+	TargetComponent  uint8     `mavlink:"target_component"`  // component ID of the target
+	SecretKey        [32]uint8 `mavlink:"secret_key"`        // signing key
 }
 
-func (self *SetupSigning) MsgID() uint8 {
-	return 0
+func (self *SetupSigning) MsgID() uint32 {
+	return 256
 }
 
 func (self *SetupSigning) MsgName() string {
@@ -7465,22 +10740,31 @@ func (self *SetupSigning) Unpack(p *Packet) error {
 	if len(p.Payload) < 42 {
 		return fmt.Errorf("payload too small")
 	}
-	self.InitialTimestamp = uint64(binary.LittleEndian.Uint64(p.Payload[0:]))
-	self.TargetSystem = uint8(p.Payload[8])
-	self.TargetComponent = uint8(p.Payload[9])
-	copy(self.SecretKey[:], p.Payload[10:42])
+	payload := p.Payload
+	self.InitialTimestamp = uint64(binary.LittleEndian.Uint64(payload[0:]))
+	self.TargetSystem = uint8(payload[8])
+	self.TargetComponent = uint8(payload[9])
+	copy(self.SecretKey[:], payload[10:42])
 	return nil
 }
 
+func (self *SetupSigning) MarshalJSON() ([]byte, error) {
+	return marshalMessage("SETUP_SIGNING", self)
+}
+
+func (self *SetupSigning) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("SETUP_SIGNING", self, data)
+}
+
 // Report button state change
 type ButtonChange struct {
-	TimeBootMs   uint32 // Timestamp (milliseconds since system boot)
-	LastChangeMs uint32 // Time of last change of button state
-	State        uint8  // Bitmap state of buttons
+	TimeBootMs   uint32 `mavlink:"time_boot_ms"`   // Timestamp (milliseconds since system boot)
+	LastChangeMs uint32 `mavlink:"last_change_ms"` // Time of last change of button state
+	State        uint8  `mavlink:"state"`          // Bitmap state of buttons
 }
 
-func (self *ButtonChange) MsgID() uint8 {
-	return 0
+func (self *ButtonChange) MsgID() uint32 {
+	return 257
 }
 
 func (self *ButtonChange) MsgName() string {
@@ -7502,21 +10786,30 @@ func (self *ButtonChange) Unpack(p *Packet) error {
 	if len(p.Payload) < 9 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(p.Payload[0:]))
-	self.LastChangeMs = uint32(binary.LittleEndian.Uint32(p.Payload[4:]))
-	self.State = uint8(p.Payload[8])
+	payload := p.Payload
+	self.TimeBootMs = uint32(binary.LittleEndian.Uint32(payload[0:]))
+	self.LastChangeMs = uint32(binary.LittleEndian.Uint32(payload[4:]))
+	self.State = uint8(payload[8])
 	return nil
 }
 
+func (self *ButtonChange) MarshalJSON() ([]byte, error) {
+	return marshalMessage("BUTTON_CHANGE", self)
+}
+
+func (self *ButtonChange) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("BUTTON_CHANGE", self, data)
+}
+
 // Control vehicle tone generation (buzzer)
 type PlayTune struct {
-	TargetSystem    uint8    // System ID
-	TargetComponent uint8    // Component ID
-	Tune            [30]byte // tune in board specific format
+	TargetSystem    uint8    `mavlink:"target_system"`    // System ID
+	TargetComponent uint8    `mavlink:"target_component"` // Component ID
+	Tune            [30]byte `mavlink:"tune,char"`        // tune in board specific format
 }
 
-func (self *PlayTune) MsgID() uint8 {
-	return 0
+func (self *PlayTune) MsgID() uint32 {
+	return 258
 }
 
 func (self *PlayTune) MsgName() string {
@@ -7538,12 +10831,21 @@ func (self *PlayTune) Unpack(p *Packet) error {
 	if len(p.Payload) < 32 {
 		return fmt.Errorf("payload too small")
 	}
-	self.TargetSystem = uint8(p.Payload[0])
-	self.TargetComponent = uint8(p.Payload[1])
-	copy(self.Tune[:], p.Payload[2:32])
+	payload := p.Payload
+	self.TargetSystem = uint8(payload[0])
+	self.TargetComponent = uint8(payload[1])
+	copy(self.Tune[:], payload[2:32])
 	return nil
 }
 
+func (self *PlayTune) MarshalJSON() ([]byte, error) {
+	return marshalMessage("PLAY_TUNE", self)
+}
+
+func (self *PlayTune) UnmarshalJSON(data []byte) error {
+	return unmarshalMessage("PLAY_TUNE", self, data)
+}
+
 // Message IDs
 const (
 	MSG_ID_HEARTBEAT                               = 0
@@ -7681,152 +10983,156 @@ const (
 	MSG_ID_NAMED_VALUE_INT                         = 252
 	MSG_ID_STATUSTEXT                              = 253
 	MSG_ID_DEBUG                                   = 254
-	MSG_ID_SETUP_SIGNING                           = 0
-	MSG_ID_BUTTON_CHANGE                           = 0
-	MSG_ID_PLAY_TUNE                               = 0
+	MSG_ID_SETUP_SIGNING                           = 256
+	MSG_ID_BUTTON_CHANGE                           = 257
+	MSG_ID_PLAY_TUNE                               = 258
 )
 
 // DialectCommon is the dialect represented by common.xml
 var DialectCommon *Dialect = &Dialect{
 	Name: "common",
-	crcExtras: map[uint8]uint8{
-		0:   50,  // MSG_ID_HEARTBEAT
-		1:   124, // MSG_ID_SYS_STATUS
-		2:   137, // MSG_ID_SYSTEM_TIME
-		4:   237, // MSG_ID_PING
-		5:   217, // MSG_ID_CHANGE_OPERATOR_CONTROL
-		6:   104, // MSG_ID_CHANGE_OPERATOR_CONTROL_ACK
-		7:   119, // MSG_ID_AUTH_KEY
-		11:  89,  // MSG_ID_SET_MODE
-		20:  214, // MSG_ID_PARAM_REQUEST_READ
-		21:  159, // MSG_ID_PARAM_REQUEST_LIST
-		22:  220, // MSG_ID_PARAM_VALUE
-		23:  168, // MSG_ID_PARAM_SET
-		24:  24,  // MSG_ID_GPS_RAW_INT
-		25:  23,  // MSG_ID_GPS_STATUS
-		26:  170, // MSG_ID_SCALED_IMU
-		27:  144, // MSG_ID_RAW_IMU
-		28:  67,  // MSG_ID_RAW_PRESSURE
-		29:  115, // MSG_ID_SCALED_PRESSURE
-		30:  39,  // MSG_ID_ATTITUDE
-		31:  246, // MSG_ID_ATTITUDE_QUATERNION
-		32:  185, // MSG_ID_LOCAL_POSITION_NED
-		33:  104, // MSG_ID_GLOBAL_POSITION_INT
-		34:  237, // MSG_ID_RC_CHANNELS_SCALED
-		35:  244, // MSG_ID_RC_CHANNELS_RAW
-		36:  222, // MSG_ID_SERVO_OUTPUT_RAW
-		37:  212, // MSG_ID_MISSION_REQUEST_PARTIAL_LIST
-		38:  9,   // MSG_ID_MISSION_WRITE_PARTIAL_LIST
-		39:  254, // MSG_ID_MISSION_ITEM
-		40:  230, // MSG_ID_MISSION_REQUEST
-		41:  28,  // MSG_ID_MISSION_SET_CURRENT
-		42:  28,  // MSG_ID_MISSION_CURRENT
-		43:  132, // MSG_ID_MISSION_REQUEST_LIST
-		44:  221, // MSG_ID_MISSION_COUNT
-		45:  232, // MSG_ID_MISSION_CLEAR_ALL
-		46:  11,  // MSG_ID_MISSION_ITEM_REACHED
-		47:  153, // MSG_ID_MISSION_ACK
-		48:  41,  // MSG_ID_SET_GPS_GLOBAL_ORIGIN
-		49:  39,  // MSG_ID_GPS_GLOBAL_ORIGIN
-		50:  78,  // MSG_ID_PARAM_MAP_RC
-		51:  196, // MSG_ID_MISSION_REQUEST_INT
-		54:  15,  // MSG_ID_SAFETY_SET_ALLOWED_AREA
-		55:  3,   // MSG_ID_SAFETY_ALLOWED_AREA
-		61:  153, // MSG_ID_ATTITUDE_QUATERNION_COV
-		62:  183, // MSG_ID_NAV_CONTROLLER_OUTPUT
-		63:  51,  // MSG_ID_GLOBAL_POSITION_INT_COV
-		64:  59,  // MSG_ID_LOCAL_POSITION_NED_COV
-		65:  118, // MSG_ID_RC_CHANNELS
-		66:  148, // MSG_ID_REQUEST_DATA_STREAM
-		67:  21,  // MSG_ID_DATA_STREAM
-		69:  243, // MSG_ID_MANUAL_CONTROL
-		70:  124, // MSG_ID_RC_CHANNELS_OVERRIDE
-		73:  38,  // MSG_ID_MISSION_ITEM_INT
-		74:  20,  // MSG_ID_VFR_HUD
-		75:  158, // MSG_ID_COMMAND_INT
-		76:  152, // MSG_ID_COMMAND_LONG
-		77:  143, // MSG_ID_COMMAND_ACK
-		81:  106, // MSG_ID_MANUAL_SETPOINT
-		82:  49,  // MSG_ID_SET_ATTITUDE_TARGET
-		83:  22,  // MSG_ID_ATTITUDE_TARGET
-		84:  143, // MSG_ID_SET_POSITION_TARGET_LOCAL_NED
-		85:  140, // MSG_ID_POSITION_TARGET_LOCAL_NED
-		86:  5,   // MSG_ID_SET_POSITION_TARGET_GLOBAL_INT
-		87:  150, // MSG_ID_POSITION_TARGET_GLOBAL_INT
-		89:  231, // MSG_ID_LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET
-		90:  183, // MSG_ID_HIL_STATE
-		91:  63,  // MSG_ID_HIL_CONTROLS
-		92:  54,  // MSG_ID_HIL_RC_INPUTS_RAW
-		100: 175, // MSG_ID_OPTICAL_FLOW
-		101: 102, // MSG_ID_GLOBAL_VISION_POSITION_ESTIMATE
-		102: 158, // MSG_ID_VISION_POSITION_ESTIMATE
-		103: 208, // MSG_ID_VISION_SPEED_ESTIMATE
-		104: 56,  // MSG_ID_VICON_POSITION_ESTIMATE
-		105: 93,  // MSG_ID_HIGHRES_IMU
-		106: 138, // MSG_ID_OPTICAL_FLOW_RAD
-		107: 108, // MSG_ID_HIL_SENSOR
-		108: 32,  // MSG_ID_SIM_STATE
-		109: 185, // MSG_ID_RADIO_STATUS
-		110: 84,  // MSG_ID_FILE_TRANSFER_PROTOCOL
-		111: 34,  // MSG_ID_TIMESYNC
-		112: 174, // MSG_ID_CAMERA_TRIGGER
-		113: 124, // MSG_ID_HIL_GPS
-		114: 237, // MSG_ID_HIL_OPTICAL_FLOW
-		115: 4,   // MSG_ID_HIL_STATE_QUATERNION
-		116: 76,  // MSG_ID_SCALED_IMU2
-		117: 128, // MSG_ID_LOG_REQUEST_LIST
-		118: 56,  // MSG_ID_LOG_ENTRY
-		119: 116, // MSG_ID_LOG_REQUEST_DATA
-		120: 134, // MSG_ID_LOG_DATA
-		121: 237, // MSG_ID_LOG_ERASE
-		122: 203, // MSG_ID_LOG_REQUEST_END
-		123: 250, // MSG_ID_GPS_INJECT_DATA
-		124: 87,  // MSG_ID_GPS2_RAW
-		125: 203, // MSG_ID_POWER_STATUS
-		126: 220, // MSG_ID_SERIAL_CONTROL
-		127: 25,  // MSG_ID_GPS_RTK
-		128: 226, // MSG_ID_GPS2_RTK
-		129: 46,  // MSG_ID_SCALED_IMU3
-		130: 29,  // MSG_ID_DATA_TRANSMISSION_HANDSHAKE
-		131: 223, // MSG_ID_ENCAPSULATED_DATA
-		132: 85,  // MSG_ID_DISTANCE_SENSOR
-		133: 6,   // MSG_ID_TERRAIN_REQUEST
-		134: 229, // MSG_ID_TERRAIN_DATA
-		135: 203, // MSG_ID_TERRAIN_CHECK
-		136: 1,   // MSG_ID_TERRAIN_REPORT
-		137: 195, // MSG_ID_SCALED_PRESSURE2
-		138: 109, // MSG_ID_ATT_POS_MOCAP
-		139: 168, // MSG_ID_SET_ACTUATOR_CONTROL_TARGET
-		140: 181, // MSG_ID_ACTUATOR_CONTROL_TARGET
-		141: 47,  // MSG_ID_ALTITUDE
-		142: 72,  // MSG_ID_RESOURCE_REQUEST
-		143: 131, // MSG_ID_SCALED_PRESSURE3
-		144: 127, // MSG_ID_FOLLOW_TARGET
-		146: 103, // MSG_ID_CONTROL_SYSTEM_STATE
-		147: 154, // MSG_ID_BATTERY_STATUS
-		148: 178, // MSG_ID_AUTOPILOT_VERSION
-		149: 200, // MSG_ID_LANDING_TARGET
-		230: 163, // MSG_ID_ESTIMATOR_STATUS
-		231: 105, // MSG_ID_WIND_COV
-		232: 151, // MSG_ID_GPS_INPUT
-		233: 35,  // MSG_ID_GPS_RTCM_DATA
-		240: 138, // MSG_ID_LANDING_MAP
-		241: 90,  // MSG_ID_VIBRATION
-		242: 104, // MSG_ID_HOME_POSITION
-		243: 85,  // MSG_ID_SET_HOME_POSITION
-		244: 95,  // MSG_ID_MESSAGE_INTERVAL
-		245: 130, // MSG_ID_EXTENDED_SYS_STATE
-		246: 184, // MSG_ID_ADSB_VEHICLE
-		247: 81,  // MSG_ID_COLLISION
-		248: 8,   // MSG_ID_V2_EXTENSION
-		249: 204, // MSG_ID_MEMORY_VECT
-		250: 49,  // MSG_ID_DEBUG_VECT
-		251: 170, // MSG_ID_NAMED_VALUE_FLOAT
-		252: 44,  // MSG_ID_NAMED_VALUE_INT
-		253: 83,  // MSG_ID_STATUSTEXT
-		254: 46,  // MSG_ID_DEBUG
-		//256: 71,  // MSG_ID_SETUP_SIGNING
-		//257: 131, // MSG_ID_BUTTON_CHANGE
-		//258: 187, // MSG_ID_PLAY_TUNE
+	messages: map[uint32]msgInfo{
+		0:   {"HEARTBEAT", 50, 9, 9, func() Message { return new(Heartbeat) }},                                                           // MSG_ID_HEARTBEAT
+		1:   {"SYS_STATUS", 124, 31, 31, func() Message { return new(SysStatus) }},                                                       // MSG_ID_SYS_STATUS
+		2:   {"SYSTEM_TIME", 137, 12, 12, func() Message { return new(SystemTime) }},                                                     // MSG_ID_SYSTEM_TIME
+		4:   {"PING", 237, 14, 14, func() Message { return new(Ping) }},                                                                  // MSG_ID_PING
+		5:   {"CHANGE_OPERATOR_CONTROL", 217, 28, 28, func() Message { return new(ChangeOperatorControl) }},                              // MSG_ID_CHANGE_OPERATOR_CONTROL
+		6:   {"CHANGE_OPERATOR_CONTROL_ACK", 104, 3, 3, func() Message { return new(ChangeOperatorControlAck) }},                         // MSG_ID_CHANGE_OPERATOR_CONTROL_ACK
+		7:   {"AUTH_KEY", 119, 32, 32, func() Message { return new(AuthKey) }},                                                           // MSG_ID_AUTH_KEY
+		11:  {"SET_MODE", 89, 6, 6, func() Message { return new(SetMode) }},                                                              // MSG_ID_SET_MODE
+		20:  {"PARAM_REQUEST_READ", 214, 20, 20, func() Message { return new(ParamRequestRead) }},                                        // MSG_ID_PARAM_REQUEST_READ
+		21:  {"PARAM_REQUEST_LIST", 159, 2, 2, func() Message { return new(ParamRequestList) }},                                          // MSG_ID_PARAM_REQUEST_LIST
+		22:  {"PARAM_VALUE", 220, 25, 25, func() Message { return new(ParamValue) }},                                                     // MSG_ID_PARAM_VALUE
+		23:  {"PARAM_SET", 168, 23, 23, func() Message { return new(ParamSet) }},                                                         // MSG_ID_PARAM_SET
+		24:  {"GPS_RAW_INT", 24, 30, 30, func() Message { return new(GpsRawInt) }},                                                       // MSG_ID_GPS_RAW_INT
+		25:  {"GPS_STATUS", 23, 101, 101, func() Message { return new(GpsStatus) }},                                                      // MSG_ID_GPS_STATUS
+		26:  {"SCALED_IMU", 170, 22, 22, func() Message { return new(ScaledImu) }},                                                       // MSG_ID_SCALED_IMU
+		27:  {"RAW_IMU", 144, 26, 26, func() Message { return new(RawImu) }},                                                             // MSG_ID_RAW_IMU
+		28:  {"RAW_PRESSURE", 67, 16, 16, func() Message { return new(RawPressure) }},                                                    // MSG_ID_RAW_PRESSURE
+		29:  {"SCALED_PRESSURE", 115, 14, 14, func() Message { return new(ScaledPressure) }},                                             // MSG_ID_SCALED_PRESSURE
+		30:  {"ATTITUDE", 39, 28, 28, func() Message { return new(Attitude) }},                                                           // MSG_ID_ATTITUDE
+		31:  {"ATTITUDE_QUATERNION", 246, 32, 32, func() Message { return new(AttitudeQuaternion) }},                                     // MSG_ID_ATTITUDE_QUATERNION
+		32:  {"LOCAL_POSITION_NED", 185, 28, 28, func() Message { return new(LocalPositionNed) }},                                        // MSG_ID_LOCAL_POSITION_NED
+		33:  {"GLOBAL_POSITION_INT", 104, 28, 28, func() Message { return new(GlobalPositionInt) }},                                      // MSG_ID_GLOBAL_POSITION_INT
+		34:  {"RC_CHANNELS_SCALED", 237, 22, 22, func() Message { return new(RcChannelsScaled) }},                                        // MSG_ID_RC_CHANNELS_SCALED
+		35:  {"RC_CHANNELS_RAW", 244, 22, 22, func() Message { return new(RcChannelsRaw) }},                                              // MSG_ID_RC_CHANNELS_RAW
+		36:  {"SERVO_OUTPUT_RAW", 222, 37, 21, func() Message { return new(ServoOutputRaw) }},                                            // MSG_ID_SERVO_OUTPUT_RAW
+		37:  {"MISSION_REQUEST_PARTIAL_LIST", 212, 7, 6, func() Message { return new(MissionRequestPartialList) }},                       // MSG_ID_MISSION_REQUEST_PARTIAL_LIST
+		38:  {"MISSION_WRITE_PARTIAL_LIST", 9, 7, 6, func() Message { return new(MissionWritePartialList) }},                             // MSG_ID_MISSION_WRITE_PARTIAL_LIST
+		39:  {"MISSION_ITEM", 254, 38, 37, func() Message { return new(MissionItem) }},                                                   // MSG_ID_MISSION_ITEM
+		40:  {"MISSION_REQUEST", 230, 5, 4, func() Message { return new(MissionRequest) }},                                               // MSG_ID_MISSION_REQUEST
+		41:  {"MISSION_SET_CURRENT", 28, 4, 4, func() Message { return new(MissionSetCurrent) }},                                         // MSG_ID_MISSION_SET_CURRENT
+		42:  {"MISSION_CURRENT", 28, 2, 2, func() Message { return new(MissionCurrent) }},                                                // MSG_ID_MISSION_CURRENT
+		43:  {"MISSION_REQUEST_LIST", 132, 3, 2, func() Message { return new(MissionRequestList) }},                                      // MSG_ID_MISSION_REQUEST_LIST
+		44:  {"MISSION_COUNT", 221, 5, 4, func() Message { return new(MissionCount) }},                                                   // MSG_ID_MISSION_COUNT
+		45:  {"MISSION_CLEAR_ALL", 232, 3, 2, func() Message { return new(MissionClearAll) }},                                            // MSG_ID_MISSION_CLEAR_ALL
+		46:  {"MISSION_ITEM_REACHED", 11, 2, 2, func() Message { return new(MissionItemReached) }},                                       // MSG_ID_MISSION_ITEM_REACHED
+		47:  {"MISSION_ACK", 153, 4, 3, func() Message { return new(MissionAck) }},                                                       // MSG_ID_MISSION_ACK
+		48:  {"SET_GPS_GLOBAL_ORIGIN", 41, 13, 13, func() Message { return new(SetGpsGlobalOrigin) }},                                    // MSG_ID_SET_GPS_GLOBAL_ORIGIN
+		49:  {"GPS_GLOBAL_ORIGIN", 39, 12, 12, func() Message { return new(GpsGlobalOrigin) }},                                           // MSG_ID_GPS_GLOBAL_ORIGIN
+		50:  {"PARAM_MAP_RC", 78, 37, 37, func() Message { return new(ParamMapRc) }},                                                     // MSG_ID_PARAM_MAP_RC
+		51:  {"MISSION_REQUEST_INT", 196, 5, 4, func() Message { return new(MissionRequestInt) }},                                        // MSG_ID_MISSION_REQUEST_INT
+		54:  {"SAFETY_SET_ALLOWED_AREA", 15, 27, 27, func() Message { return new(SafetySetAllowedArea) }},                                // MSG_ID_SAFETY_SET_ALLOWED_AREA
+		55:  {"SAFETY_ALLOWED_AREA", 3, 25, 25, func() Message { return new(SafetyAllowedArea) }},                                        // MSG_ID_SAFETY_ALLOWED_AREA
+		61:  {"ATTITUDE_QUATERNION_COV", 153, 68, 68, func() Message { return new(AttitudeQuaternionCov) }},                              // MSG_ID_ATTITUDE_QUATERNION_COV
+		62:  {"NAV_CONTROLLER_OUTPUT", 183, 26, 26, func() Message { return new(NavControllerOutput) }},                                  // MSG_ID_NAV_CONTROLLER_OUTPUT
+		63:  {"GLOBAL_POSITION_INT_COV", 51, 185, 185, func() Message { return new(GlobalPositionIntCov) }},                              // MSG_ID_GLOBAL_POSITION_INT_COV
+		64:  {"LOCAL_POSITION_NED_COV", 59, 229, 229, func() Message { return new(LocalPositionNedCov) }},                                // MSG_ID_LOCAL_POSITION_NED_COV
+		65:  {"RC_CHANNELS", 118, 42, 42, func() Message { return new(RcChannels) }},                                                     // MSG_ID_RC_CHANNELS
+		66:  {"REQUEST_DATA_STREAM", 148, 6, 6, func() Message { return new(RequestDataStream) }},                                        // MSG_ID_REQUEST_DATA_STREAM
+		67:  {"DATA_STREAM", 21, 4, 4, func() Message { return new(DataStream) }},                                                        // MSG_ID_DATA_STREAM
+		69:  {"MANUAL_CONTROL", 243, 11, 11, func() Message { return new(ManualControl) }},                                               // MSG_ID_MANUAL_CONTROL
+		70:  {"RC_CHANNELS_OVERRIDE", 124, 18, 18, func() Message { return new(RcChannelsOverride) }},                                    // MSG_ID_RC_CHANNELS_OVERRIDE
+		73:  {"MISSION_ITEM_INT", 38, 38, 37, func() Message { return new(MissionItemInt) }},                                             // MSG_ID_MISSION_ITEM_INT
+		74:  {"VFR_HUD", 20, 20, 20, func() Message { return new(VfrHud) }},                                                              // MSG_ID_VFR_HUD
+		75:  {"COMMAND_INT", 158, 35, 35, func() Message { return new(CommandInt) }},                                                     // MSG_ID_COMMAND_INT
+		76:  {"COMMAND_LONG", 152, 33, 33, func() Message { return new(CommandLong) }},                                                   // MSG_ID_COMMAND_LONG
+		77:  {"COMMAND_ACK", 143, 10, 3, func() Message { return new(CommandAck) }},                                                      // MSG_ID_COMMAND_ACK
+		81:  {"MANUAL_SETPOINT", 106, 22, 22, func() Message { return new(ManualSetpoint) }},                                             // MSG_ID_MANUAL_SETPOINT
+		82:  {"SET_ATTITUDE_TARGET", 49, 39, 39, func() Message { return new(SetAttitudeTarget) }},                                       // MSG_ID_SET_ATTITUDE_TARGET
+		83:  {"ATTITUDE_TARGET", 22, 37, 37, func() Message { return new(AttitudeTarget) }},                                              // MSG_ID_ATTITUDE_TARGET
+		84:  {"SET_POSITION_TARGET_LOCAL_NED", 143, 53, 53, func() Message { return new(SetPositionTargetLocalNed) }},                    // MSG_ID_SET_POSITION_TARGET_LOCAL_NED
+		85:  {"POSITION_TARGET_LOCAL_NED", 140, 51, 51, func() Message { return new(PositionTargetLocalNed) }},                           // MSG_ID_POSITION_TARGET_LOCAL_NED
+		86:  {"SET_POSITION_TARGET_GLOBAL_INT", 5, 53, 53, func() Message { return new(SetPositionTargetGlobalInt) }},                    // MSG_ID_SET_POSITION_TARGET_GLOBAL_INT
+		87:  {"POSITION_TARGET_GLOBAL_INT", 150, 51, 51, func() Message { return new(PositionTargetGlobalInt) }},                         // MSG_ID_POSITION_TARGET_GLOBAL_INT
+		89:  {"LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET", 231, 28, 28, func() Message { return new(LocalPositionNedSystemGlobalOffset) }}, // MSG_ID_LOCAL_POSITION_NED_SYSTEM_GLOBAL_OFFSET
+		90:  {"HIL_STATE", 183, 56, 56, func() Message { return new(HilState) }},                                                         // MSG_ID_HIL_STATE
+		91:  {"HIL_CONTROLS", 63, 42, 42, func() Message { return new(HilControls) }},                                                    // MSG_ID_HIL_CONTROLS
+		92:  {"HIL_RC_INPUTS_RAW", 54, 33, 33, func() Message { return new(HilRcInputsRaw) }},                                            // MSG_ID_HIL_RC_INPUTS_RAW
+		100: {"OPTICAL_FLOW", 175, 26, 26, func() Message { return new(OpticalFlow) }},                                                   // MSG_ID_OPTICAL_FLOW
+		101: {"GLOBAL_VISION_POSITION_ESTIMATE", 102, 32, 32, func() Message { return new(GlobalVisionPositionEstimate) }},               // MSG_ID_GLOBAL_VISION_POSITION_ESTIMATE
+		102: {"VISION_POSITION_ESTIMATE", 158, 32, 32, func() Message { return new(VisionPositionEstimate) }},                            // MSG_ID_VISION_POSITION_ESTIMATE
+		103: {"VISION_SPEED_ESTIMATE", 208, 20, 20, func() Message { return new(VisionSpeedEstimate) }},                                  // MSG_ID_VISION_SPEED_ESTIMATE
+		104: {"VICON_POSITION_ESTIMATE", 56, 32, 32, func() Message { return new(ViconPositionEstimate) }},                               // MSG_ID_VICON_POSITION_ESTIMATE
+		105: {"HIGHRES_IMU", 93, 62, 62, func() Message { return new(HighresImu) }},                                                      // MSG_ID_HIGHRES_IMU
+		106: {"OPTICAL_FLOW_RAD", 138, 44, 44, func() Message { return new(OpticalFlowRad) }},                                            // MSG_ID_OPTICAL_FLOW_RAD
+		107: {"HIL_SENSOR", 108, 64, 64, func() Message { return new(HilSensor) }},                                                       // MSG_ID_HIL_SENSOR
+		108: {"SIM_STATE", 32, 84, 84, func() Message { return new(SimState) }},                                                          // MSG_ID_SIM_STATE
+		109: {"RADIO_STATUS", 185, 9, 9, func() Message { return new(RadioStatus) }},                                                     // MSG_ID_RADIO_STATUS
+		110: {"FILE_TRANSFER_PROTOCOL", 84, 254, 254, func() Message { return new(FileTransferProtocol) }},                               // MSG_ID_FILE_TRANSFER_PROTOCOL
+		111: {"TIMESYNC", 34, 16, 16, func() Message { return new(Timesync) }},                                                           // MSG_ID_TIMESYNC
+		112: {"CAMERA_TRIGGER", 174, 12, 12, func() Message { return new(CameraTrigger) }},                                               // MSG_ID_CAMERA_TRIGGER
+		113: {"HIL_GPS", 124, 36, 36, func() Message { return new(HilGps) }},                                                             // MSG_ID_HIL_GPS
+		114: {"HIL_OPTICAL_FLOW", 237, 44, 44, func() Message { return new(HilOpticalFlow) }},                                            // MSG_ID_HIL_OPTICAL_FLOW
+		115: {"HIL_STATE_QUATERNION", 4, 64, 64, func() Message { return new(HilStateQuaternion) }},                                      // MSG_ID_HIL_STATE_QUATERNION
+		116: {"SCALED_IMU2", 76, 22, 22, func() Message { return new(ScaledImu2) }},                                                      // MSG_ID_SCALED_IMU2
+		117: {"LOG_REQUEST_LIST", 128, 6, 6, func() Message { return new(LogRequestList) }},                                              // MSG_ID_LOG_REQUEST_LIST
+		118: {"LOG_ENTRY", 56, 14, 14, func() Message { return new(LogEntry) }},                                                          // MSG_ID_LOG_ENTRY
+		119: {"LOG_REQUEST_DATA", 116, 12, 12, func() Message { return new(LogRequestData) }},                                            // MSG_ID_LOG_REQUEST_DATA
+		120: {"LOG_DATA", 134, 97, 97, func() Message { return new(LogData) }},                                                           // MSG_ID_LOG_DATA
+		121: {"LOG_ERASE", 237, 2, 2, func() Message { return new(LogErase) }},                                                           // MSG_ID_LOG_ERASE
+		122: {"LOG_REQUEST_END", 203, 2, 2, func() Message { return new(LogRequestEnd) }},                                                // MSG_ID_LOG_REQUEST_END
+		123: {"GPS_INJECT_DATA", 250, 113, 113, func() Message { return new(GpsInjectData) }},                                            // MSG_ID_GPS_INJECT_DATA
+		124: {"GPS2_RAW", 87, 35, 35, func() Message { return new(Gps2Raw) }},                                                            // MSG_ID_GPS2_RAW
+		125: {"POWER_STATUS", 203, 6, 6, func() Message { return new(PowerStatus) }},                                                     // MSG_ID_POWER_STATUS
+		126: {"SERIAL_CONTROL", 220, 79, 79, func() Message { return new(SerialControl) }},                                               // MSG_ID_SERIAL_CONTROL
+		127: {"GPS_RTK", 25, 35, 35, func() Message { return new(GpsRtk) }},                                                              // MSG_ID_GPS_RTK
+		128: {"GPS2_RTK", 226, 35, 35, func() Message { return new(Gps2Rtk) }},                                                           // MSG_ID_GPS2_RTK
+		129: {"SCALED_IMU3", 46, 22, 22, func() Message { return new(ScaledImu3) }},                                                      // MSG_ID_SCALED_IMU3
+		130: {"DATA_TRANSMISSION_HANDSHAKE", 29, 13, 13, func() Message { return new(DataTransmissionHandshake) }},                       // MSG_ID_DATA_TRANSMISSION_HANDSHAKE
+		131: {"ENCAPSULATED_DATA", 223, 255, 255, func() Message { return new(EncapsulatedData) }},                                       // MSG_ID_ENCAPSULATED_DATA
+		132: {"DISTANCE_SENSOR", 85, 14, 14, func() Message { return new(DistanceSensor) }},                                              // MSG_ID_DISTANCE_SENSOR
+		133: {"TERRAIN_REQUEST", 6, 18, 18, func() Message { return new(TerrainRequest) }},                                               // MSG_ID_TERRAIN_REQUEST
+		134: {"TERRAIN_DATA", 229, 43, 43, func() Message { return new(TerrainData) }},                                                   // MSG_ID_TERRAIN_DATA
+		135: {"TERRAIN_CHECK", 203, 8, 8, func() Message { return new(TerrainCheck) }},                                                   // MSG_ID_TERRAIN_CHECK
+		136: {"TERRAIN_REPORT", 1, 22, 22, func() Message { return new(TerrainReport) }},                                                 // MSG_ID_TERRAIN_REPORT
+		137: {"SCALED_PRESSURE2", 195, 14, 14, func() Message { return new(ScaledPressure2) }},                                           // MSG_ID_SCALED_PRESSURE2
+		138: {"ATT_POS_MOCAP", 109, 36, 36, func() Message { return new(AttPosMocap) }},                                                  // MSG_ID_ATT_POS_MOCAP
+		139: {"SET_ACTUATOR_CONTROL_TARGET", 168, 43, 43, func() Message { return new(SetActuatorControlTarget) }},                       // MSG_ID_SET_ACTUATOR_CONTROL_TARGET
+		140: {"ACTUATOR_CONTROL_TARGET", 181, 41, 41, func() Message { return new(ActuatorControlTarget) }},                              // MSG_ID_ACTUATOR_CONTROL_TARGET
+		141: {"ALTITUDE", 47, 32, 32, func() Message { return new(Altitude) }},                                                           // MSG_ID_ALTITUDE
+		142: {"RESOURCE_REQUEST", 72, 243, 243, func() Message { return new(ResourceRequest) }},                                          // MSG_ID_RESOURCE_REQUEST
+		143: {"SCALED_PRESSURE3", 131, 14, 14, func() Message { return new(ScaledPressure3) }},                                           // MSG_ID_SCALED_PRESSURE3
+		144: {"FOLLOW_TARGET", 127, 93, 93, func() Message { return new(FollowTarget) }},                                                 // MSG_ID_FOLLOW_TARGET
+		146: {"CONTROL_SYSTEM_STATE", 103, 100, 100, func() Message { return new(ControlSystemState) }},                                  // MSG_ID_CONTROL_SYSTEM_STATE
+		147: {"BATTERY_STATUS", 154, 36, 36, func() Message { return new(BatteryStatus) }},                                               // MSG_ID_BATTERY_STATUS
+		148: {"AUTOPILOT_VERSION", 178, 60, 60, func() Message { return new(AutopilotVersion) }},                                         // MSG_ID_AUTOPILOT_VERSION
+		149: {"LANDING_TARGET", 200, 30, 30, func() Message { return new(LandingTarget) }},                                               // MSG_ID_LANDING_TARGET
+		230: {"ESTIMATOR_STATUS", 163, 42, 42, func() Message { return new(EstimatorStatus) }},                                           // MSG_ID_ESTIMATOR_STATUS
+		231: {"WIND_COV", 105, 40, 40, func() Message { return new(WindCov) }},                                                           // MSG_ID_WIND_COV
+		232: {"GPS_INPUT", 151, 63, 63, func() Message { return new(GpsInput) }},                                                         // MSG_ID_GPS_INPUT
+		233: {"GPS_RTCM_DATA", 35, 182, 182, func() Message { return new(GpsRtcmData) }},                                                 // MSG_ID_GPS_RTCM_DATA
+		240: {"LANDING_MAP", 138, 201, 201, func() Message { return new(LandingMap) }},                                                   // MSG_ID_LANDING_MAP
+		241: {"VIBRATION", 90, 32, 32, func() Message { return new(Vibration) }},                                                         // MSG_ID_VIBRATION
+		242: {"HOME_POSITION", 104, 52, 52, func() Message { return new(HomePosition) }},                                                 // MSG_ID_HOME_POSITION
+		243: {"SET_HOME_POSITION", 85, 53, 53, func() Message { return new(SetHomePosition) }},                                           // MSG_ID_SET_HOME_POSITION
+		244: {"MESSAGE_INTERVAL", 95, 6, 6, func() Message { return new(MessageInterval) }},                                              // MSG_ID_MESSAGE_INTERVAL
+		245: {"EXTENDED_SYS_STATE", 130, 2, 2, func() Message { return new(ExtendedSysState) }},                                          // MSG_ID_EXTENDED_SYS_STATE
+		246: {"ADSB_VEHICLE", 184, 38, 38, func() Message { return new(AdsbVehicle) }},                                                   // MSG_ID_ADSB_VEHICLE
+		247: {"COLLISION", 81, 19, 19, func() Message { return new(Collision) }},                                                         // MSG_ID_COLLISION
+		248: {"V2_EXTENSION", 8, 254, 254, func() Message { return new(V2Extension) }},                                                   // MSG_ID_V2_EXTENSION
+		249: {"MEMORY_VECT", 204, 36, 36, func() Message { return new(MemoryVect) }},                                                     // MSG_ID_MEMORY_VECT
+		250: {"DEBUG_VECT", 49, 30, 30, func() Message { return new(DebugVect) }},                                                        // MSG_ID_DEBUG_VECT
+		251: {"NAMED_VALUE_FLOAT", 170, 18, 18, func() Message { return new(NamedValueFloat) }},                                          // MSG_ID_NAMED_VALUE_FLOAT
+		252: {"NAMED_VALUE_INT", 44, 18, 18, func() Message { return new(NamedValueInt) }},                                               // MSG_ID_NAMED_VALUE_INT
+		253: {"STATUSTEXT", 83, 51, 51, func() Message { return new(Statustext) }},                                                       // MSG_ID_STATUSTEXT
+		254: {"DEBUG", 46, 9, 9, func() Message { return new(Debug) }},                                                                   // MSG_ID_DEBUG
+		256: {"SETUP_SIGNING", 71, 42, 42, func() Message { return new(SetupSigning) }},                                                  // MSG_ID_SETUP_SIGNING
+		257: {"BUTTON_CHANGE", 131, 9, 9, func() Message { return new(ButtonChange) }},                                                   // MSG_ID_BUTTON_CHANGE
+		258: {"PLAY_TUNE", 187, 32, 32, func() Message { return new(PlayTune) }},                                                         // MSG_ID_PLAY_TUNE
 	},
 }
+
+func init() {
+	registerDialect(DialectCommon)
+}
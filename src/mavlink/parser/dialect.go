@@ -13,6 +13,10 @@
  
 package mavlink
 
+import (
+	"sort"
+)
+
 // Dialect represents a set of message definitions.
 // Some dialects have conflicting definitions for given message IDs,
 // so a list of dialects must be provided to an Encoder/Decoder in
@@ -20,25 +24,132 @@ package mavlink
 //
 // The 'DialectCommon' dialect is added to all Encoders/Decoders by default.
 type Dialect struct {
-	Name      string
-	crcExtras map[uint8]uint8
+	Name     string
+	messages map[uint32]msgInfo
+}
+
+// wire details of a message definition
+type msgInfo struct {
+	name     string // as in the dialect xml, ie HEARTBEAT
+	crcExtra uint8
+	size     int // full payload size, including extension fields
+	baseSize int // payload size of the v1 message, without extension fields
+	new      func() Message
 }
 
 // Alias for a slice of Dialect pointers
 // Only really intended to be accessed as a field on Encoder/Decoder
 type DialectSlice []*Dialect
 
-// look up the crcextra for msgid
-func (ds *DialectSlice) findCrcX(msgid uint8) (uint8, error) {
+// Every generated dialect registers itself here on init.
+var AllDialects DialectSlice
 
-	// http://www.mavlink.org/mavlink/crc_extra_calculation
+func registerDialect(d *Dialect) {
+	AllDialects.Add(d)
+}
+
+// DialectByName returns the registered dialect called name, or nil
+func DialectByName(name string) *Dialect {
+	for _, d := range AllDialects {
+		if d.Name == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// MsgIDs returns the ids of the messages defined by d, in ascending order
+func (d *Dialect) MsgIDs() []uint32 {
+	ids := make([]uint32, 0, len(d.messages))
+	for id := range d.messages {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// HasMsgID reports whether d defines a message for msgid
+func (d *Dialect) HasMsgID(msgid uint32) bool {
+	_, ok := d.messages[msgid]
+	return ok
+}
+
+// NewMessage returns an empty message of the type registered for msgid
+// by any loaded dialect.
+func NewMessage(msgid uint32) (Message, error) {
+	return AllDialects.NewMessage(msgid)
+}
+
+// DecodeMessage unpacks p into the message type registered for its
+// msgid by any loaded dialect.
+func DecodeMessage(p *Packet) (Message, error) {
+	return AllDialects.DecodeMessage(p)
+}
+
+// NewMessage returns an empty message of the type registered for msgid
+func (ds *DialectSlice) NewMessage(msgid uint32) (Message, error) {
+	info, err := ds.findMsgInfo(msgid)
+	if err != nil {
+		return nil, err
+	}
+	return info.new(), nil
+}
+
+// NewMessageByName returns an empty message for the dialect name of a message,
+// ie COMMAND_LONG
+func NewMessageByName(name string) (Message, error) {
+	return AllDialects.NewMessageByName(name)
+}
+
+// NewMessageByName returns an empty message for the dialect name of a message
+func (ds *DialectSlice) NewMessageByName(name string) (Message, error) {
 	for _, d := range *ds {
-		if crcx, ok := d.crcExtras[msgid]; ok {
-			return crcx, nil
+		for _, info := range d.messages {
+			if info.name == name {
+				return info.new(), nil
+			}
 		}
 	}
 
-	return 0, ErrUnknownMsgID
+	return nil, ErrUnknownMsgName
+}
+
+// DecodeMessage unpacks p into the message type registered for its msgid
+func (ds *DialectSlice) DecodeMessage(p *Packet) (Message, error) {
+	m, err := ds.NewMessage(p.MsgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Unpack(p); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// look up the definition of msgid, first dialect wins
+func (ds *DialectSlice) findMsgInfo(msgid uint32) (msgInfo, error) {
+	for _, d := range *ds {
+		if info, ok := d.messages[msgid]; ok {
+			return info, nil
+		}
+	}
+
+	return msgInfo{}, ErrUnknownMsgID
+}
+
+// look up the crcextra for msgid
+func (ds *DialectSlice) findCrcX(msgid uint32) (uint8, error) {
+
+	// http://www.mavlink.org/mavlink/crc_extra_calculation
+	info, err := ds.findMsgInfo(msgid)
+	return info.crcExtra, err
+}
+
+// look up the full (untruncated) payload size for msgid
+func (ds *DialectSlice) findMsgSize(msgid uint32) (int, error) {
+	info, err := ds.findMsgInfo(msgid)
+	return info.size, err
 }
 
 // IndexOf returns the index of d or -1 if not found
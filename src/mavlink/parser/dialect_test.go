@@ -50,3 +50,55 @@ func TestAddRemove(t *testing.T) {
 		t.Error("wrong dialect")
 	}
 }
+
+func TestRegistry(t *testing.T) {
+
+	m, err := NewMessage(MSG_ID_MEMINFO)
+	if err != nil {
+		t.Fatalf("NewMessage fail %q", err)
+	}
+	if _, ok := m.(*Meminfo); !ok {
+		t.Errorf("NewMessage type fail, got %T", m)
+	}
+
+	if _, err := NewMessage(0xffffff); err != ErrUnknownMsgID {
+		t.Errorf("NewMessage expected ErrUnknownMsgID, got %q", err)
+	}
+
+	var p Packet
+	if err := (&Heartbeat{Type: MAV_TYPE_QUADROTOR, CustomMode: 7}).Pack(&p); err != nil {
+		t.Fatalf("Pack fail %q", err)
+	}
+
+	m, err = DecodeMessage(&p)
+	if err != nil {
+		t.Fatalf("DecodeMessage fail %q", err)
+	}
+	if hb, ok := m.(*Heartbeat); !ok || hb.Type != MAV_TYPE_QUADROTOR || hb.CustomMode != 7 {
+		t.Errorf("DecodeMessage fail, got %v", m)
+	}
+
+	// a slice without ardupilotmega doesn't know its messages
+	ds := DialectSlice{DialectCommon}
+	if _, err := ds.DecodeMessage(&Packet{MsgID: MSG_ID_MEMINFO}); err != ErrUnknownMsgID {
+		t.Errorf("DecodeMessage expected ErrUnknownMsgID, got %q", err)
+	}
+}
+
+func TestDialectMsgIDs(t *testing.T) {
+
+	ids := DialectArdupilotmega.MsgIDs()
+	want := []uint32{150, 151, 152, 163, 165, 173}
+	if len(ids) != len(want) {
+		t.Fatalf("MsgIDs fail, got %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("MsgIDs fail, got %v, want %v", ids, want)
+		}
+	}
+
+	if !DialectCommon.HasMsgID(MSG_ID_HEARTBEAT) || DialectCommon.HasMsgID(MSG_ID_MEMINFO) {
+		t.Error("HasMsgID fail")
+	}
+}
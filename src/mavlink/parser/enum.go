@@ -0,0 +1,27 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+// Generated enum types look up their names and descriptions in tables
+// of enumEntry. Dialects extending an enum add their entries on init.
+//
+// The enum values themselves are left untyped constants. Messages carry them
+// in uint8, uint16 and uint32 fields depending on the message, and bitmask
+// enums are ORed together into plain integers; typed constants would need a
+// conversion at each of those, since Go doesn't convert them implicitly. The
+// types are for naming a value instead: mavlink.MavState(m.SystemStatus).
+type enumEntry struct {
+	name        string
+	description string
+}
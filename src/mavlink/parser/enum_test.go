@@ -0,0 +1,56 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"testing"
+)
+
+func TestEnumStrings(t *testing.T) {
+
+	if got := MavType(MAV_TYPE_QUADROTOR).String(); got != "MAV_TYPE_QUADROTOR" {
+		t.Errorf("String fail, got %q", got)
+	}
+	if got := MavResult(MAV_RESULT_FAILED).Description(); got != "Command executed, but failed" {
+		t.Errorf("Description fail, got %q", got)
+	}
+
+	// values beyond uint8 must survive
+	if got := MavCmd(MAV_CMD_COMPONENT_ARM_DISARM).String(); got != "MAV_CMD_COMPONENT_ARM_DISARM" {
+		t.Errorf("String fail, got %q", got)
+	}
+
+	if got := MavState(200).String(); got != "MavState(200)" {
+		t.Errorf("unknown value String fail, got %q", got)
+	}
+	if got := MavState(200).Description(); got != "" {
+		t.Errorf("unknown value Description fail, got %q", got)
+	}
+
+	if !MavCmd(MAV_CMD_NAV_WAYPOINT).Valid() || MavCmd(9999).Valid() {
+		t.Error("Valid fail")
+	}
+}
+
+func TestEnumParse(t *testing.T) {
+
+	v, err := ParseMavCmd("MAV_CMD_NAV_TAKEOFF")
+	if err != nil || v != MAV_CMD_NAV_TAKEOFF {
+		t.Errorf("Parse fail, got %d, %v", v, err)
+	}
+
+	if _, err := ParseMavCmd("MAV_CMD_NOPE"); err == nil {
+		t.Error("Parse of unknown name should fail")
+	}
+}
@@ -0,0 +1,182 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Messages marshal to JSON as
+//
+//   {"name": "COMMAND_LONG", "fields": {"command": 400, "param1": 1, ...}}
+//
+// using the names from the dialect xml. Char arrays are strings, and
+// NaN or infinite floats are null, since JSON has no way to write them.
+type jsonMessage struct {
+	Name   string                     `json:"name"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// UnmarshalMessage decodes a message of any type from its JSON form
+func UnmarshalMessage(data []byte) (Message, error) {
+	return AllDialects.UnmarshalMessage(data)
+}
+
+// UnmarshalMessage decodes a message of any type from its JSON form
+func (ds *DialectSlice) UnmarshalMessage(data []byte) (Message, error) {
+	var jm jsonMessage
+	if err := json.Unmarshal(data, &jm); err != nil {
+		return nil, err
+	}
+
+	m, err := ds.NewMessageByName(jm.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// dialect name of a struct field, and whether it is text
+func fieldTag(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("mavlink")
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:] == "char"
+	}
+	return tag, false
+}
+
+func marshalMessage(name string, m Message) ([]byte, error) {
+	v := reflect.ValueOf(m).Elem()
+	t := v.Type()
+
+	fields := make(map[string]interface{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		fname, char := fieldTag(t.Field(i))
+		fields[fname] = jsonValue(v.Field(i), char)
+	}
+
+	return json.Marshal(struct {
+		Name   string                 `json:"name"`
+		Fields map[string]interface{} `json:"fields"`
+	}{name, fields})
+}
+
+func jsonValue(v reflect.Value, char bool) interface{} {
+	switch {
+	case char:
+		b := v.Slice(0, v.Len()).Bytes()
+		if i := strings.IndexByte(string(b), 0); i >= 0 {
+			b = b[:i]
+		}
+		return string(b)
+
+	case v.Kind() == reflect.Array:
+		elems := make([]interface{}, v.Len())
+		for i := range elems {
+			elems[i] = jsonValue(v.Index(i), false)
+		}
+		return elems
+
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil
+		}
+	}
+
+	return v.Interface()
+}
+
+// fields missing from data are left as they are
+func unmarshalMessage(name string, m Message, data []byte) error {
+	var jm jsonMessage
+	if err := json.Unmarshal(data, &jm); err != nil {
+		return err
+	}
+
+	if jm.Name != "" && jm.Name != name {
+		return fmt.Errorf("cannot unmarshal %s into %s", jm.Name, name)
+	}
+
+	v := reflect.ValueOf(m).Elem()
+	t := v.Type()
+
+	for fname, raw := range jm.Fields {
+		found := false
+		for i := 0; i < t.NumField(); i++ {
+			tname, char := fieldTag(t.Field(i))
+			if tname != fname {
+				continue
+			}
+
+			if err := setJSONValue(v.Field(i), char, raw); err != nil {
+				return fmt.Errorf("%s.%s: %v", name, fname, err)
+			}
+			found = true
+			break
+		}
+
+		if !found {
+			return fmt.Errorf("%s has no field %s", name, fname)
+		}
+	}
+
+	return nil
+}
+
+func setJSONValue(v reflect.Value, char bool, raw json.RawMessage) error {
+	switch {
+	case char:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		if len(s) > v.Len() {
+			return fmt.Errorf("text longer than %d characters", v.Len())
+		}
+		v.Set(reflect.Zero(v.Type()))
+		reflect.Copy(v, reflect.ValueOf([]byte(s)))
+		return nil
+
+	case v.Kind() == reflect.Array:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return err
+		}
+		if len(elems) > v.Len() {
+			return fmt.Errorf("more than %d elements", v.Len())
+		}
+		for i, elem := range elems {
+			if err := setJSONValue(v.Index(i), false, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		if string(raw) == "null" {
+			v.SetFloat(math.NaN())
+			return nil
+		}
+	}
+
+	return json.Unmarshal(raw, v.Addr().Interface())
+}
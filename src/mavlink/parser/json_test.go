@@ -0,0 +1,110 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+
+	cmd := &CommandLong{
+		Command:         MAV_CMD_DO_REPOSITION,
+		TargetSystem:    1,
+		TargetComponent: 1,
+		Param1:          -1,
+		Param4:          float32(math.NaN()),
+		Param5:          47.3977,
+	}
+
+	b, err := json.Marshal(cmd)
+	if err != nil {
+		t.Fatalf("Marshal fail %q", err)
+	}
+
+	s := string(b)
+	if !strings.Contains(s, `"name":"COMMAND_LONG"`) || !strings.Contains(s, `"param4":null`) ||
+		!strings.Contains(s, `"command":192`) {
+		t.Errorf("Marshal fail, got %s", s)
+	}
+
+	m, err := UnmarshalMessage(b)
+	if err != nil {
+		t.Fatalf("UnmarshalMessage fail %q", err)
+	}
+
+	out, ok := m.(*CommandLong)
+	if !ok {
+		t.Fatalf("UnmarshalMessage type fail, got %T", m)
+	}
+	if !math.IsNaN(float64(out.Param4)) {
+		t.Errorf("null float should be NaN, got %v", out.Param4)
+	}
+	out.Param4, cmd.Param4 = 0, 0
+	if *out != *cmd {
+		t.Errorf("Round trip fail, got %v, want %v", *out, *cmd)
+	}
+}
+
+func TestJSONText(t *testing.T) {
+
+	in := `{"name":"STATUSTEXT","fields":{"severity":6,"text":"Takeoff detected"}}`
+
+	m, err := UnmarshalMessage([]byte(in))
+	if err != nil {
+		t.Fatalf("UnmarshalMessage fail %q", err)
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal fail %q", err)
+	}
+	if string(b) != in {
+		t.Errorf("Round trip fail, got %s, want %s", b, in)
+	}
+
+	// missing fields are left alone
+	st := Statustext{Severity: 3}
+	if err := json.Unmarshal([]byte(`{"fields":{"text":"x"}}`), &st); err != nil {
+		t.Fatalf("Unmarshal fail %q", err)
+	}
+	if st.Severity != 3 || st.Text[0] != 'x' || st.Text[1] != 0 {
+		t.Errorf("partial Unmarshal fail, got %v", st)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+
+	cases := []string{
+		`{"name":"NOT_A_MESSAGE","fields":{}}`,
+		`{"name":"STATUSTEXT","fields":{"nope":1}}`,
+		`{"name":"STATUSTEXT","fields":{"severity":300}}`,
+		`{"name":"STATUSTEXT","fields":{"text":"` + strings.Repeat("a", 51) + `"}}`,
+		`{"name":"ATTITUDE_QUATERNION_COV","fields":{"q":[1,2,3,4,5]}}`,
+	}
+
+	for _, c := range cases {
+		if _, err := UnmarshalMessage([]byte(c)); err == nil {
+			t.Errorf("UnmarshalMessage of %s should fail", c)
+		}
+	}
+
+	var hb Heartbeat
+	if err := json.Unmarshal([]byte(`{"name":"STATUSTEXT","fields":{}}`), &hb); err == nil {
+		t.Error("Unmarshal into the wrong type should fail")
+	}
+}
@@ -24,13 +24,25 @@ import (
 
 const (
 	startByte        = 0xfe
+	startByteV2      = 0xfd
 	numChecksumBytes = 2
 	hdrLen           = 6
+	hdrLenV2         = 10
+)
+
+// Wire protocol versions
+const (
+	MAVLINK_V1 = 1
+	MAVLINK_V2 = 2
 )
 
 var (
-	ErrUnknownMsgID = errors.New("unknown msg id")
-	ErrCrcFail      = errors.New("checksum did not match")
+	ErrUnknownMsgID   = errors.New("unknown msg id")
+	ErrUnknownMsgName = errors.New("unknown msg name")
+	ErrCrcFail        = errors.New("checksum did not match")
+	ErrInvalidHeader  = errors.New("invalid header")
+	ErrIncompatFlags  = errors.New("unsupported incompat flags")
+	ErrMsgIDTooLarge  = errors.New("msg id requires mavlink v2")
 )
 
 // basic type for encoding/decoding mavlink messages.
@@ -40,30 +52,40 @@ type Message interface {
 	Pack(*Packet) error
 	Unpack(*Packet) error
 	MsgName() string
-	MsgID() uint8
+	MsgID() uint32
 }
 
 // wire type for encoding/decoding mavlink messages.
 // use the ToPacket() and FromPacket() routines on specific message
 // types to convert them to/from the Message type.
 type Packet struct {
-	SeqID    uint8 // Sequence of packet
-	SysID    uint8 // ID of message sender system/aircraft
-	CompID   uint8 // ID of the message sender component
-	MsgID    uint8 // ID of message in payload
-	Payload  []byte
-	Checksum uint16
+	Version       uint8  // Protocol version the packet was received with
+	IncompatFlags uint8  // v2 only, flags that must be understood to parse the packet
+	CompatFlags   uint8  // v2 only, flags that may be ignored if not understood
+	SeqID         uint8  // Sequence of packet
+	SysID         uint8  // ID of message sender system/aircraft
+	CompID        uint8  // ID of the message sender component
+	MsgID         uint32 // ID of message in payload (24 bits in v2, 8 bits in v1)
+	Payload       []byte
+	Checksum      uint16
+	LinkID        uint8  // v2 signed only, link the packet was signed on
+	Timestamp     uint64 // v2 signed only, 10us ticks since 1 Jan 2015 GMT
+	Signature     []byte // v2 signed only, first 48 bits of the sha256 signature
 }
 
 type Decoder struct {
 	CurrSeqID uint8        // last seq id decoded
+	Version   uint8        // highest protocol version seen on this link
 	Dialects  DialectSlice // dialects that can be decoded
+	Signing   *Signing     // if set, only correctly signed packets are accepted
 	br        *bufio.Reader
 }
 
 type Encoder struct {
 	CurrSeqID uint8        // last seq id encoded
+	Version   uint8        // protocol version to encode with
 	Dialects  DialectSlice // dialects that can be encoded
+	Signing   *Signing     // if set, v2 packets are signed
 	bw        *bufio.Writer
 }
 
@@ -84,6 +106,7 @@ func NewDecoder(r io.Reader) *Decoder {
 func NewEncoder(w io.Writer) *Encoder {
 
 	e := &Encoder{
+		Version:  MAVLINK_V1,
 		Dialects: DialectSlice{DialectCommon},
 	}
 
@@ -96,13 +119,35 @@ func NewEncoder(w io.Writer) *Encoder {
 	return e
 }
 
-// helper to create packet w/header populated with received bytes
-func newPacketFromBytes(b []byte) (*Packet, int) {
+// length of the header (including start byte) for the given start byte
+func headerLen(stx byte) int {
+	if stx == startByteV2 {
+		return hdrLenV2
+	}
+	return hdrLen
+}
+
+// helper to create packet w/header populated with received bytes.
+// b contains the header bytes following the start byte.
+func newPacketFromBytes(stx byte, b []byte) (*Packet, int) {
+	if stx == startByteV2 {
+		return &Packet{
+			Version:       MAVLINK_V2,
+			IncompatFlags: b[1],
+			CompatFlags:   b[2],
+			SeqID:         b[3],
+			SysID:         b[4],
+			CompID:        b[5],
+			MsgID:         uint32(b[6]) | uint32(b[7])<<8 | uint32(b[8])<<16,
+		}, int(b[0])
+	}
+
 	return &Packet{
-		SeqID:  b[1],
-		SysID:  b[2],
-		CompID: b[3],
-		MsgID:  b[4],
+		Version: MAVLINK_V1,
+		SeqID:   b[1],
+		SysID:   b[2],
+		CompID:  b[3],
+		MsgID:   uint32(b[4]),
 	}, int(b[0])
 }
 
@@ -113,84 +158,130 @@ func newPacketFromBytes(b []byte) (*Packet, int) {
 func (dec *Decoder) Decode() (*Packet, error) {
 
 	// discard bytes until our start byte
+	var stx byte
 	for {
 		c, err := dec.br.ReadByte()
 		if err != nil {
 			return nil, err
 		}
-		if c == startByte {
+		if c == startByte || c == startByteV2 {
+			stx = c
 			break
 		}
 	}
 
-	// hdr contains LENGTH, SEQ, SYSID, COMPID, MSGID
-	hdr := make([]byte, 5)
+	// hdr contains LENGTH, (INCOMPAT, COMPAT,) SEQ, SYSID, COMPID, MSGID
+	hdr := make([]byte, headerLen(stx)-1)
 	if _, err := io.ReadFull(dec.br, hdr); err != nil {
 		return nil, err
 	}
 
-	p, payloadLen := newPacketFromBytes(hdr)
-
-	crc := x25.New()
-	crc.Write(hdr)
+	p, payloadLen := newPacketFromBytes(stx, hdr)
 
-	// read payload (if there is one) and checksum bytes
-	buf := make([]byte, payloadLen+numChecksumBytes)
+	// read payload (if there is one), checksum and signature bytes
+	frameLen := payloadLen + numChecksumBytes
+	buf := make([]byte, frameLen+p.signatureLen())
 	if _, err := io.ReadFull(dec.br, buf); err != nil {
 		return p, err
 	}
 
-	p.Payload = buf[:payloadLen]
-	crc.Write(p.Payload)
+	return p, dec.verify(p, stx, hdr, buf[:payloadLen], buf[payloadLen:frameLen], buf[frameLen:])
+}
 
-	crcx, err := dec.Dialects.findCrcX(p.MsgID)
-	if err != nil {
-		return p, err
+// Decode a packet from a previously received buffer (such as a UDP packet),
+// b must contain a complete message
+func (dec *Decoder) DecodeBytes(b []byte) (*Packet, error) {
+
+	if len(b) < hdrLen || (b[0] != startByte && b[0] != startByteV2) {
+		return nil, ErrInvalidHeader
 	}
-	crc.WriteByte(crcx)
 
-	p.Checksum = bytesToU16(buf[payloadLen:])
+	hl := headerLen(b[0])
+	if len(b) < hl {
+		return nil, ErrInvalidHeader
+	}
 
-	// does the transmitted checksum match our computed checksum?
-	if p.Checksum != crc.Sum16() {
-		return p, ErrCrcFail
+	p, payloadLen := newPacketFromBytes(b[0], b[1:hl])
+
+	frameLen := hl + payloadLen + numChecksumBytes
+	if len(b) < frameLen+p.signatureLen() {
+		return p, io.ErrUnexpectedEOF
 	}
 
-	dec.CurrSeqID = p.SeqID
-	return p, nil
+	return p, dec.verify(p, b[0], b[1:hl], b[hl:hl+payloadLen], b[hl+payloadLen:frameLen], b[frameLen:])
 }
 
-// Decode a packet from a previously received buffer (such as a UDP packet),
-// b must contain a complete message
+// Decode a packet from a previously received buffer using the
+// common dialect, without tracking any link state.
 func DecodeBytes(b []byte) (*Packet, error) {
-
-	Dialects := DialectSlice{DialectCommon}
-
-	if len(b) < hdrLen || b[0] != startByte {
-		return nil, errors.New("invalid header")
+	dec := &Decoder{
+		Dialects: DialectSlice{DialectCommon},
 	}
 
-	p, payloadLen := newPacketFromBytes(b[1:])
+	return dec.DecodeBytes(b)
+}
+
+// check the crc (and signature) of a received packet, and restore any
+// payload bytes that were truncated by a v2 sender.
+func (dec *Decoder) verify(p *Packet, stx byte, hdr, payload, crcBytes, sig []byte) error {
 
 	crc := x25.New()
-	p.Payload = b[hdrLen: hdrLen+payloadLen]
-	crc.Write(b[1:hdrLen+payloadLen])
+	crc.Write(hdr)
+
+	p.Payload = payload
+	crc.Write(p.Payload)
 
-	crcx, err := Dialects.findCrcX(p.MsgID)
+	crcx, err := dec.Dialects.findCrcX(p.MsgID)
 	if err != nil {
-		return p, err
+		return err
 	}
 	crc.WriteByte(crcx)
 
-	p.Checksum = bytesToU16(b[hdrLen+payloadLen:])
+	p.Checksum = bytesToU16(crcBytes)
 
 	// does the transmitted checksum match our computed checksum?
 	if p.Checksum != crc.Sum16() {
-		return p, ErrCrcFail
+		return ErrCrcFail
+	}
+
+	if p.IncompatFlags&^IFLAG_SIGNED != 0 {
+		return ErrIncompatFlags
+	}
+
+	if p.IncompatFlags&IFLAG_SIGNED != 0 {
+		p.LinkID = sig[0]
+		p.Timestamp = bytesToU48(sig[1:7])
+		p.Signature = sig[7:signatureLen]
+	}
+
+	if dec.Signing != nil {
+		// signature covers the whole frame, start byte through crc
+		frame := make([]byte, 0, 1+len(hdr)+len(payload)+numChecksumBytes)
+		frame = append(frame, stx)
+		frame = append(frame, hdr...)
+		frame = append(frame, payload...)
+		frame = append(frame, crcBytes[:numChecksumBytes]...)
+
+		if err := dec.Signing.verify(p, frame); err != nil {
+			return err
+		}
+	}
+
+	// v2 senders strip trailing zeros from the payload
+	if p.Version == MAVLINK_V2 {
+		if sz, err := dec.Dialects.findMsgSize(p.MsgID); err == nil && len(p.Payload) < sz {
+			full := make([]byte, sz)
+			copy(full, p.Payload)
+			p.Payload = full
+		}
 	}
 
-	// dec.CurrSeqID = p.SeqID
-	return p, nil
+	dec.CurrSeqID = p.SeqID
+	if p.Version > dec.Version {
+		dec.Version = p.Version
+	}
+
+	return nil
 }
 
 // helper that accepts a Message, internally converts it to a Packet,
@@ -207,30 +298,53 @@ func (enc *Encoder) Encode(sysID, compID uint8, m Message) error {
 	return enc.EncodePacket(&p)
 }
 
-// Encode writes p to its writer
+// Encode writes p to its writer, framed according to enc.Version
 func (enc *Encoder) EncodePacket(p *Packet) error {
 
+	info, err := enc.Dialects.findMsgInfo(p.MsgID)
+	if err != nil {
+		return err
+	}
+
 	crc := x25.New()
 
 	// header
-	hdr := []byte{startByte, byte(len(p.Payload)), enc.CurrSeqID, p.SysID, p.CompID, p.MsgID}
+	var hdr []byte
+	payload := p.Payload
+
+	if enc.Version >= MAVLINK_V2 {
+		var incompat uint8
+		if enc.Signing != nil {
+			incompat |= IFLAG_SIGNED
+		}
+
+		payload = truncatePayload(payload)
+		hdr = []byte{startByteV2, byte(len(payload)), incompat, 0, enc.CurrSeqID, p.SysID, p.CompID,
+			byte(p.MsgID), byte(p.MsgID >> 8), byte(p.MsgID >> 16)}
+	} else {
+		if p.MsgID > 0xff {
+			return ErrMsgIDTooLarge
+		}
+		// v1 receivers don't know about extension fields
+		if len(payload) > info.baseSize {
+			payload = payload[:info.baseSize]
+		}
+		hdr = []byte{startByte, byte(len(payload)), enc.CurrSeqID, p.SysID, p.CompID, byte(p.MsgID)}
+	}
+
 	if err := enc.writeAndCheck(hdr); err != nil {
 		return err
 	}
 	crc.Write(hdr[1:]) // don't include start byte
 
 	// payload
-	if err := enc.writeAndCheck(p.Payload); err != nil {
+	if err := enc.writeAndCheck(payload); err != nil {
 		return err
 	}
-	crc.Write(p.Payload)
+	crc.Write(payload)
 
 	// crc extra
-	crcx, err := enc.Dialects.findCrcX(p.MsgID)
-	if err != nil {
-		return err
-	}
-	crc.WriteByte(crcx)
+	crc.WriteByte(info.crcExtra)
 
 	// crc
 	crcBytes := u16ToBytes(crc.Sum16())
@@ -238,6 +352,22 @@ func (enc *Encoder) EncodePacket(p *Packet) error {
 		return err
 	}
 
+	// signature
+	if hdr[0] == startByteV2 && enc.Signing != nil {
+		frame := make([]byte, 0, len(hdr)+len(payload)+numChecksumBytes)
+		frame = append(frame, hdr...)
+		frame = append(frame, payload...)
+		frame = append(frame, crcBytes...)
+
+		ts := enc.Signing.nextTimestamp()
+		sig := []byte{enc.Signing.LinkID}
+		sig = append(sig, u48ToBytes(ts)...)
+		sig = append(sig, enc.Signing.sign(frame, enc.Signing.LinkID, ts)...)
+		if err := enc.writeAndCheck(sig); err != nil {
+			return err
+		}
+	}
+
 	err = enc.bw.Flush()
 	if err == nil {
 		enc.CurrSeqID++
@@ -246,6 +376,23 @@ func (enc *Encoder) EncodePacket(p *Packet) error {
 	return err
 }
 
+// number of signature bytes that follow the checksum
+func (p *Packet) signatureLen() int {
+	if p.Version == MAVLINK_V2 && p.IncompatFlags&IFLAG_SIGNED != 0 {
+		return signatureLen
+	}
+	return 0
+}
+
+// v2 payloads drop trailing zero bytes, but always keep the first byte
+func truncatePayload(p []byte) []byte {
+	n := len(p)
+	for n > 1 && p[n-1] == 0 {
+		n--
+	}
+	return p[:n]
+}
+
 // helper to check both the write and writelen status
 func (enc *Encoder) writeAndCheck(p []byte) error {
 	n, err := enc.bw.Write(p)
@@ -15,6 +15,7 @@ package mavlink
 
 import (
 	"bytes"
+	"io"
 	"testing"
 )
 
@@ -31,7 +32,7 @@ func TestRoundTrip(t *testing.T) {
 
 		var pkt Packet
 		if err := p.Pack(&pkt); err != nil {
-			t.Errorf("Pack fail %q (%q)", pkt, err)
+			t.Errorf("Pack fail %v (%q)", pkt, err)
 		}
 
 		var buf bytes.Buffer
@@ -46,7 +47,7 @@ func TestRoundTrip(t *testing.T) {
 		}
 
 		if pktOut.MsgID != MSG_ID_PING {
-			t.Errorf("MsgID fail, want %q, got %q", MSG_ID_PING, pktOut.MsgID)
+			t.Errorf("MsgID fail, want %d, got %d", MSG_ID_PING, pktOut.MsgID)
 		}
 
 		var pingOut Ping
@@ -55,7 +56,7 @@ func TestRoundTrip(t *testing.T) {
 		}
 
 		if pingOut.Seq != c.seq {
-			t.Errorf("Mismatch msg field, got %q, want %q", pingOut.Seq, c.seq)
+			t.Errorf("Mismatch msg field, got %d, want %d", pingOut.Seq, c.seq)
 		}
 	}
 }
@@ -65,7 +66,82 @@ func TestDecode(t *testing.T) {
 	pktbytes := []byte{0xfe, 0x09, 0x0, 0x01, 0xC8, 0x00, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x5A, 0x3E}
 	_, err := NewDecoder(bytes.NewBuffer(pktbytes)).Decode()
 	if err != nil {
-		t.Errorf("Decode fail:", err)
+		t.Errorf("Decode fail: %q", err)
+	}
+}
+
+func TestRoundTripV2(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	enc := NewEncoder(&buf)
+	enc.Version = MAVLINK_V2
+
+	// PLAY_TUNE has a msg id that does not fit in a v1 header,
+	// and its payload is mostly zeros so it exercises truncation.
+	tune := &PlayTune{
+		TargetSystem:    1,
+		TargetComponent: 2,
+	}
+	copy(tune.Tune[:], "MFT200L8")
+
+	if err := enc.Encode(0x1, 0x1, tune); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	raw := buf.Bytes()
+	if raw[0] != startByteV2 {
+		t.Errorf("start byte fail, got %x", raw[0])
+	}
+	if want := 2 + len("MFT200L8"); int(raw[1]) != want {
+		t.Errorf("truncated len fail, got %d, want %d", raw[1], want)
+	}
+
+	dec := NewDecoder(bytes.NewReader(raw))
+	pktOut, err := dec.Decode()
+	if err != nil {
+		t.Fatalf("Decode fail %q", err)
+	}
+
+	if pktOut.MsgID != MSG_ID_PLAY_TUNE {
+		t.Errorf("MsgID fail, want %d, got %d", MSG_ID_PLAY_TUNE, pktOut.MsgID)
+	}
+	if dec.Version != MAVLINK_V2 {
+		t.Errorf("link version fail, got %d", dec.Version)
+	}
+
+	var tuneOut PlayTune
+	if err := tuneOut.Unpack(pktOut); err != nil {
+		t.Fatalf("Unpack fail %q", err)
+	}
+	if tuneOut != *tune {
+		t.Errorf("Round trip fail, got %v, want %v", tuneOut, *tune)
+	}
+
+	// the same bytes must decode from a datagram as well
+	pktOut, err = DecodeBytes(raw)
+	if err != nil {
+		t.Fatalf("DecodeBytes fail %q", err)
+	}
+	if pktOut.Version != MAVLINK_V2 || len(pktOut.Payload) != 32 {
+		t.Errorf("DecodeBytes fail, version %d, payload len %d", pktOut.Version, len(pktOut.Payload))
+	}
+}
+
+func TestEncodeV1LargeMsgID(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := NewEncoder(&buf).Encode(0x1, 0x1, &PlayTune{})
+	if err != ErrMsgIDTooLarge {
+		t.Errorf("encode expected ErrMsgIDTooLarge, got %q", err)
+	}
+}
+
+func TestDecodeBytesShort(t *testing.T) {
+	// header claims a 9 byte payload, but the buffer ends early
+	pktbytes := []byte{0xfd, 0x09, 0x0, 0x0, 0x0, 0x01, 0xC8, 0x00, 0x0, 0x0, 0x0}
+	if _, err := DecodeBytes(pktbytes); err != io.ErrUnexpectedEOF {
+		t.Errorf("decode expected ErrUnexpectedEOF, got %q", err)
 	}
 }
 
@@ -123,3 +199,47 @@ func TestDialects(t *testing.T) {
 		t.Errorf("Round trip fail")
 	}
 }
+
+func TestExtensionFields(t *testing.T) {
+
+	servos := &ServoOutputRaw{Servo1Raw: 1100, Port: 1, Servo9Raw: 1500, Servo16Raw: 1900}
+
+	// v1 drops the extension fields
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(0x1, 0x1, servos); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+	if buf.Bytes()[1] != 21 {
+		t.Errorf("v1 payload len fail, got %d, want 21", buf.Bytes()[1])
+	}
+
+	pkt, err := NewDecoder(&buf).Decode()
+	if err != nil {
+		t.Fatalf("Decode fail %q", err)
+	}
+
+	var out ServoOutputRaw
+	if err := out.Unpack(pkt); err != nil {
+		t.Fatalf("Unpack of v1 payload fail %q", err)
+	}
+	if out.Servo1Raw != 1100 || out.Port != 1 || out.Servo9Raw != 0 || out.Servo16Raw != 0 {
+		t.Errorf("v1 unpack fail, got %v", out)
+	}
+
+	// v2 carries them
+	enc := NewEncoder(&buf)
+	enc.Version = MAVLINK_V2
+	if err := enc.Encode(0x1, 0x1, servos); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	if pkt, err = NewDecoder(&buf).Decode(); err != nil {
+		t.Fatalf("Decode fail %q", err)
+	}
+	if err := out.Unpack(pkt); err != nil {
+		t.Fatalf("Unpack fail %q", err)
+	}
+	if out != *servos {
+		t.Errorf("v2 round trip fail, got %v, want %v", out, *servos)
+	}
+}
@@ -0,0 +1,151 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	IFLAG_SIGNED = 0x01 // incompat flag, packet carries a signature block
+
+	signatureLen     = 13      // link id + 48 bit timestamp + 48 bit signature
+	signingSkewTicks = 6000000 // one minute, in 10us ticks
+)
+
+var (
+	ErrUnsigned       = errors.New("packet is not signed")
+	ErrBadSignature   = errors.New("signature did not match")
+	ErrStaleTimestamp = errors.New("signature timestamp is too old")
+
+	// signing timestamps count 10us ticks since 1 Jan 2015 GMT
+	signingEpoch = time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// a signed stream is identified by who sent it and on which link
+type signingStream struct {
+	sysID  uint8
+	compID uint8
+	linkID uint8
+}
+
+// Signing holds the secret key and timestamp state for a signed link.
+// The same Signing can be shared by the Decoder and Encoder of a link,
+// and is safe for concurrent use.
+type Signing struct {
+	Key    [32]byte // shared secret, as sent in SETUP_SIGNING
+	LinkID uint8    // link id stamped on outgoing packets
+
+	// Optional, lets selected unsigned packets through.
+	// By default all unsigned packets are rejected.
+	AllowUnsigned func(p *Packet) bool
+
+	lock      sync.Mutex
+	timestamp uint64 // newest timestamp sent or accepted on this link
+	streams   map[signingStream]uint64
+}
+
+func NewSigning(key [32]byte, linkID uint8) *Signing {
+	return &Signing{
+		Key:       key,
+		LinkID:    linkID,
+		timestamp: SigningTimestamp(time.Now()),
+		streams:   make(map[signingStream]uint64),
+	}
+}
+
+// SigningTimestamp converts t to signing timestamp ticks
+func SigningTimestamp(t time.Time) uint64 {
+	return uint64(t.Sub(signingEpoch) / (10 * time.Microsecond))
+}
+
+// Timestamp returns the newest timestamp seen on this link
+func (s *Signing) Timestamp() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.timestamp
+}
+
+// next timestamp to sign with. Must always increase, even if the clock doesn't.
+func (s *Signing) nextTimestamp() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := SigningTimestamp(time.Now())
+	if now <= s.timestamp {
+		now = s.timestamp + 1
+	}
+	s.timestamp = now
+	return now
+}
+
+// compute the 48 bit signature over the frame (start byte through crc),
+// the link id and the timestamp
+func (s *Signing) sign(frame []byte, linkID uint8, timestamp uint64) []byte {
+	h := sha256.New()
+	h.Write(s.Key[:])
+	h.Write(frame)
+	h.Write([]byte{linkID})
+	h.Write(u48ToBytes(timestamp))
+	return h.Sum(nil)[:6]
+}
+
+// check the signature block of p against frame, and that it is not a replay
+func (s *Signing) verify(p *Packet, frame []byte) error {
+	if p.IncompatFlags&IFLAG_SIGNED == 0 {
+		if s.AllowUnsigned != nil && s.AllowUnsigned(p) {
+			return nil
+		}
+		return ErrUnsigned
+	}
+
+	want := s.sign(frame, p.LinkID, p.Timestamp)
+	if subtle.ConstantTimeCompare(want, p.Signature) != 1 {
+		return ErrBadSignature
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stream := signingStream{p.SysID, p.CompID, p.LinkID}
+	if last, ok := s.streams[stream]; ok {
+		if p.Timestamp <= last {
+			return ErrStaleTimestamp
+		}
+	} else if p.Timestamp+signingSkewTicks < s.timestamp {
+		// new streams may not start too far behind our own clock
+		return ErrStaleTimestamp
+	}
+
+	s.streams[stream] = p.Timestamp
+	if p.Timestamp > s.timestamp {
+		s.timestamp = p.Timestamp
+	}
+
+	return nil
+}
+
+func u48ToBytes(v uint64) []byte {
+	return []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24), byte(v >> 32), byte(v >> 40)}
+}
+
+func bytesToU48(p []byte) uint64 {
+	// NB: does not check size of p
+	return uint64(p[0]) | uint64(p[1])<<8 | uint64(p[2])<<16 |
+		uint64(p[3])<<24 | uint64(p[4])<<32 | uint64(p[5])<<40
+}
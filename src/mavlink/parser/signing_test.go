@@ -0,0 +1,142 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mavlink
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newSignedPair(key [32]byte) (*Encoder, *Decoder, *bytes.Buffer) {
+	var buf bytes.Buffer
+
+	enc := NewEncoder(&buf)
+	enc.Version = MAVLINK_V2
+	enc.Signing = NewSigning(key, 3)
+
+	dec := NewDecoder(&buf)
+	dec.Signing = NewSigning(key, 0)
+
+	return enc, dec, &buf
+}
+
+func TestSignedRoundTrip(t *testing.T) {
+
+	enc, dec, buf := newSignedPair([32]byte{1, 2, 3})
+
+	for i := 0; i < 3; i++ {
+		if err := enc.Encode(0x1, 0x1, &Ping{Seq: uint32(i)}); err != nil {
+			t.Fatalf("Encode fail %q", err)
+		}
+
+		pkt, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("Decode fail %q", err)
+		}
+
+		if pkt.IncompatFlags&IFLAG_SIGNED == 0 || pkt.LinkID != 3 || len(pkt.Signature) != 6 {
+			t.Errorf("signature fields fail, got %v", pkt)
+		}
+
+		var ping Ping
+		if err := ping.Unpack(pkt); err != nil || ping.Seq != uint32(i) {
+			t.Errorf("Unpack fail %q, seq %d", err, ping.Seq)
+		}
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("left over bytes: %d", buf.Len())
+	}
+}
+
+func TestSignedWrongKey(t *testing.T) {
+
+	enc, _, buf := newSignedPair([32]byte{1, 2, 3})
+
+	if err := enc.Encode(0x1, 0x1, &Ping{Seq: 1}); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	dec := NewDecoder(buf)
+	dec.Signing = NewSigning([32]byte{4, 5, 6}, 0)
+
+	if _, err := dec.Decode(); err != ErrBadSignature {
+		t.Errorf("decode expected ErrBadSignature, got %q", err)
+	}
+}
+
+func TestSignedReplay(t *testing.T) {
+
+	enc, _, buf := newSignedPair([32]byte{1, 2, 3})
+
+	if err := enc.Encode(0x1, 0x1, &Ping{Seq: 1}); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	raw := append([]byte(nil), buf.Bytes()...)
+
+	dec := NewDecoder(nil)
+	dec.Signing = NewSigning([32]byte{1, 2, 3}, 0)
+
+	if _, err := dec.DecodeBytes(raw); err != nil {
+		t.Fatalf("DecodeBytes fail %q", err)
+	}
+
+	if _, err := dec.DecodeBytes(raw); err != ErrStaleTimestamp {
+		t.Errorf("replay expected ErrStaleTimestamp, got %q", err)
+	}
+}
+
+func TestSignedRejectsUnsigned(t *testing.T) {
+
+	var buf bytes.Buffer
+
+	enc := NewEncoder(&buf)
+	enc.Version = MAVLINK_V2
+
+	if err := enc.Encode(0x1, 0x1, &Ping{Seq: 1}); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	raw := append([]byte(nil), buf.Bytes()...)
+
+	dec := NewDecoder(nil)
+	dec.Signing = NewSigning([32]byte{1, 2, 3}, 0)
+
+	if _, err := dec.DecodeBytes(raw); err != ErrUnsigned {
+		t.Errorf("decode expected ErrUnsigned, got %q", err)
+	}
+
+	dec.Signing.AllowUnsigned = func(p *Packet) bool {
+		return p.MsgID == MSG_ID_PING
+	}
+
+	if _, err := dec.DecodeBytes(raw); err != nil {
+		t.Errorf("decode of allowed unsigned msg fail %q", err)
+	}
+}
+
+func TestUnverifiedSignedAccepted(t *testing.T) {
+
+	enc, _, buf := newSignedPair([32]byte{1, 2, 3})
+
+	if err := enc.Encode(0x1, 0x1, &Ping{Seq: 1}); err != nil {
+		t.Fatalf("Encode fail %q", err)
+	}
+
+	// without a key, signed packets still need to be framed correctly
+	if _, err := NewDecoder(buf).Decode(); err != nil {
+		t.Errorf("Decode fail %q", err)
+	}
+}
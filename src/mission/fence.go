@@ -0,0 +1,265 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "fmt"
+  "math"
+
+  "mavlink/parser"
+)
+
+const earthRadius = 6371000.0 // meters
+
+//
+// A geofence, as inclusion and exclusion areas. A position is inside the
+// fence when it is inside at least one inclusion area (if there are any),
+// and outside every exclusion area.
+//
+type Fence struct {
+  Polygons      []FencePolygon
+  Circles       []FenceCircle
+  MaxAltitude   float32 // meters above home, 0 for no limit
+}
+
+type FencePolygon struct {
+  Inclusion bool
+  Points    [][2]float64 // latitude, longitude in degrees
+}
+
+type FenceCircle struct {
+  Inclusion bool
+  Latitude  float64
+  Longitude float64
+  Radius    float64 // meters
+}
+
+func (f *Fence) Empty() bool {
+  return len(f.Polygons) == 0 && len(f.Circles) == 0 && f.MaxAltitude == 0
+}
+
+func validLatLon(lat, lon float64) bool {
+  return lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+func (f *Fence) Validate() error {
+  for i, poly := range f.Polygons {
+    if len(poly.Points) < 3 {
+      return fmt.Errorf("Fence polygon %d needs at least 3 points.", i)
+    }
+    for _, p := range poly.Points {
+      if !validLatLon(p[0], p[1]) {
+        return fmt.Errorf("Fence polygon %d: point %v, %v is off the globe.", i, p[0], p[1])
+      }
+    }
+  }
+
+  for i, c := range f.Circles {
+    if c.Radius <= 0 {
+      return fmt.Errorf("Fence circle %d needs a radius.", i)
+    }
+    if !validLatLon(c.Latitude, c.Longitude) {
+      return fmt.Errorf("Fence circle %d: center %v, %v is off the globe.", i, c.Latitude, c.Longitude)
+    }
+  }
+
+  if f.MaxAltitude < 0 {
+    return fmt.Errorf("Fence max altitude must be positive.")
+  }
+  return nil
+}
+
+// great circle distance in meters
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+  rad := math.Pi / 180
+  dLat := (lat2 - lat1) * rad
+  dLon := (lon2 - lon1) * rad
+  a := math.Sin(dLat/2) * math.Sin(dLat/2) +
+    math.Cos(lat1 * rad) * math.Cos(lat2 * rad) * math.Sin(dLon/2) * math.Sin(dLon/2)
+  return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// ray casting, fine for fences that don't span the poles or the date line
+func (poly *FencePolygon) contains(lat, lon float64) bool {
+  inside := false
+  for i, j := 0, len(poly.Points) - 1; i < len(poly.Points); j, i = i, i + 1 {
+    a, b := poly.Points[i], poly.Points[j]
+    if (a[0] > lat) != (b[0] > lat) &&
+      lon < (b[1] - a[1]) * (lat - a[0]) / (b[0] - a[0]) + a[1] {
+      inside = !inside
+    }
+  }
+  return inside
+}
+
+func (c *FenceCircle) contains(lat, lon float64) bool {
+  return Distance(c.Latitude, c.Longitude, lat, lon) <= c.Radius
+}
+
+//
+// Check returns why lat, lon at alt (meters above home) is outside the
+// fence, or nil if it is inside.
+//
+func (f *Fence) Check(lat, lon float64, alt float32) error {
+  if f.MaxAltitude > 0 && alt > f.MaxAltitude {
+    return fmt.Errorf("Target altitude %vm is above the fence limit of %vm.", alt, f.MaxAltitude)
+  }
+
+  hasInclusion, included := false, false
+
+  for i := range f.Polygons {
+    poly := &f.Polygons[i]
+    in := poly.contains(lat, lon)
+    if poly.Inclusion {
+      hasInclusion = true
+      included = included || in
+    } else if in {
+      return fmt.Errorf("Target %v, %v is inside exclusion polygon %d.", lat, lon, i)
+    }
+  }
+
+  for i := range f.Circles {
+    c := &f.Circles[i]
+    in := c.contains(lat, lon)
+    if c.Inclusion {
+      hasInclusion = true
+      included = included || in
+    } else if in {
+      return fmt.Errorf("Target %v, %v is inside exclusion circle %d.", lat, lon, i)
+    }
+  }
+
+  if hasInclusion && !included {
+    return fmt.Errorf("Target %v, %v is outside the fence.", lat, lon)
+  }
+  return nil
+}
+
+//
+// Bounds of the inclusion areas, as south west and north east corners.
+// ok is false when there are no inclusion areas.
+//
+func (f *Fence) Bounds() (sw, ne [2]float64, ok bool) {
+  sw = [2]float64{90, 180}
+  ne = [2]float64{-90, -180}
+
+  extend := func(lat, lon float64) {
+    sw[0], sw[1] = math.Min(sw[0], lat), math.Min(sw[1], lon)
+    ne[0], ne[1] = math.Max(ne[0], lat), math.Max(ne[1], lon)
+    ok = true
+  }
+
+  for _, poly := range f.Polygons {
+    if poly.Inclusion {
+      for _, p := range poly.Points {
+        extend(p[0], p[1])
+      }
+    }
+  }
+
+  for _, c := range f.Circles {
+    if c.Inclusion {
+      dLat := c.Radius / earthRadius * 180 / math.Pi
+      dLon := dLat / math.Cos(c.Latitude * math.Pi / 180)
+      extend(c.Latitude - dLat, c.Longitude - dLon)
+      extend(c.Latitude + dLat, c.Longitude + dLon)
+    }
+  }
+  return
+}
+
+//
+// As MAV_MISSION_TYPE_FENCE items. Each polygon vertex is an item carrying
+// the vertex count, each circle one item carrying its radius.
+//
+func (f *Fence) Items() []*mavlink.MissionItemInt {
+  var items []*mavlink.MissionItemInt
+
+  add := func(cmd uint16, param1 float32, lat, lon float64) {
+    items = append(items, &mavlink.MissionItemInt{
+      Param1: param1,
+      X: EncodeCoord(mavlink.MAV_FRAME_GLOBAL, lat),
+      Y: EncodeCoord(mavlink.MAV_FRAME_GLOBAL, lon),
+      Seq: uint16(len(items)),
+      Command: cmd,
+      Frame: mavlink.MAV_FRAME_GLOBAL,
+      Autocontinue: 1,
+      MissionType: mavlink.MAV_MISSION_TYPE_FENCE,
+    })
+  }
+
+  for _, poly := range f.Polygons {
+    cmd := uint16(mavlink.MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION)
+    if poly.Inclusion {
+      cmd = mavlink.MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION
+    }
+    for _, p := range poly.Points {
+      add(cmd, float32(len(poly.Points)), p[0], p[1])
+    }
+  }
+
+  for _, c := range f.Circles {
+    cmd := uint16(mavlink.MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION)
+    if c.Inclusion {
+      cmd = mavlink.MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION
+    }
+    add(cmd, float32(c.Radius), c.Latitude, c.Longitude)
+  }
+
+  return items
+}
+
+// FenceFromItems rebuilds a fence read back from the vehicle.
+func FenceFromItems(items []*mavlink.MissionItemInt) (*Fence, error) {
+  f := &Fence{}
+
+  for i := 0; i < len(items); i++ {
+    item := items[i]
+    lat, lon := DecodeCoord(item.Frame, item.X), DecodeCoord(item.Frame, item.Y)
+
+    switch item.Command {
+    case mavlink.MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION, mavlink.MAV_CMD_NAV_FENCE_POLYGON_VERTEX_EXCLUSION:
+      count := int(item.Param1)
+      if count < 3 || i + count > len(items) {
+        return nil, fmt.Errorf("Fence item %d: bad polygon vertex count %d.", i, count)
+      }
+
+      poly := FencePolygon{Inclusion: item.Command == mavlink.MAV_CMD_NAV_FENCE_POLYGON_VERTEX_INCLUSION}
+      for _, v := range items[i:i + count] {
+        if v.Command != item.Command {
+          return nil, fmt.Errorf("Fence item %d: polygon ends early.", i)
+        }
+        poly.Points = append(poly.Points, [2]float64{DecodeCoord(v.Frame, v.X), DecodeCoord(v.Frame, v.Y)})
+      }
+      f.Polygons = append(f.Polygons, poly)
+      i += count - 1
+
+    case mavlink.MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION, mavlink.MAV_CMD_NAV_FENCE_CIRCLE_EXCLUSION:
+      f.Circles = append(f.Circles, FenceCircle{
+        Inclusion: item.Command == mavlink.MAV_CMD_NAV_FENCE_CIRCLE_INCLUSION,
+        Latitude: lat,
+        Longitude: lon,
+        Radius: float64(item.Param1),
+      })
+
+    case mavlink.MAV_CMD_NAV_FENCE_RETURN_POINT:
+      // not used by the API
+
+    default:
+      return nil, fmt.Errorf("Fence item %d: %s is not a fence command.", i, mavlink.MavCmd(item.Command))
+    }
+  }
+
+  return f, nil
+}
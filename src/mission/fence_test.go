@@ -0,0 +1,98 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "testing"
+)
+
+func testFence() *Fence {
+  return &Fence{
+    Polygons: []FencePolygon{
+      {Inclusion: true, Points: [][2]float64{{36.0, -115.2}, {36.0, -115.0}, {36.2, -115.0}, {36.2, -115.2}}},
+    },
+    Circles: []FenceCircle{
+      {Inclusion: false, Latitude: 36.1, Longitude: -115.1, Radius: 100},
+    },
+    MaxAltitude: 120,
+  }
+}
+
+func TestFenceCheck(t *testing.T) {
+  f := testFence()
+
+  checks := []struct {
+    lat, lon  float64
+    alt       float32
+    inside    bool
+  }{
+    {36.05, -115.05, 10, true},
+    {36.05, -115.05, 150, false},  // too high
+    {36.3, -115.1, 10, false},     // outside the polygon
+    {36.1005, -115.1, 10, false},  // ~55m from the circle center
+    {36.102, -115.1, 10, true},    // ~220m from it
+  }
+
+  for _, c := range checks {
+    if err := f.Check(c.lat, c.lon, c.alt); (err == nil) != c.inside {
+      t.Errorf("Check %v, %v at %v: got %v", c.lat, c.lon, c.alt, err)
+    }
+  }
+
+  // no inclusion areas, only the exclusion circle matters
+  f.Polygons = nil
+  if err := f.Check(10, 10, 0); err != nil {
+    t.Errorf("Check without inclusion areas fail %q", err)
+  }
+}
+
+func TestFenceItems(t *testing.T) {
+  want := testFence()
+  items := want.Items()
+
+  if len(items) != 5 || items[0].Param1 != 4 || items[4].Param1 != 100 {
+    t.Fatalf("Items fail, got %v", items)
+  }
+
+  got, err := FenceFromItems(items)
+  if err != nil {
+    t.Fatalf("FenceFromItems fail %q", err)
+  }
+  if len(got.Polygons) != 1 || len(got.Polygons[0].Points) != 4 || got.Polygons[0].Points[2] != [2]float64{36.2, -115.0} ||
+    len(got.Circles) != 1 || got.Circles[0] != want.Circles[0] {
+    t.Errorf("FenceFromItems read back %v", got)
+  }
+
+  // truncated polygon
+  if _, err := FenceFromItems(items[:2]); err == nil {
+    t.Error("expected truncated polygon to fail")
+  }
+}
+
+func TestFenceValidate(t *testing.T) {
+  if err := testFence().Validate(); err != nil {
+    t.Errorf("Validate fail %q", err)
+  }
+
+  bad := []*Fence{
+    {Polygons: []FencePolygon{{Points: [][2]float64{{1, 1}, {2, 2}}}}},
+    {Circles: []FenceCircle{{Latitude: 1, Longitude: 1}}},
+    {Circles: []FenceCircle{{Latitude: 100, Longitude: 1, Radius: 10}}},
+  }
+  for i, f := range bad {
+    if err := f.Validate(); err == nil {
+      t.Errorf("fence %d: expected validation to fail", i)
+    }
+  }
+}
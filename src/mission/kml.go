@@ -0,0 +1,210 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "encoding/xml"
+  "fmt"
+  "io"
+  "strconv"
+  "strings"
+
+  "mavlink/parser"
+)
+
+//
+// KML paths. A mission is read from the first LineString in the file, or
+// from its Points in order if there is none. Each coordinate becomes a
+// waypoint. Written missions have the path of their global nav items, plus
+// a Point per item.
+//
+type kmlFile struct {
+  XMLName   xml.Name      `xml:"kml"`
+  Namespace string        `xml:"xmlns,attr,omitempty"`
+  Document  kmlDocument   `xml:"Document"`
+}
+
+type kmlDocument struct {
+  Name        string          `xml:"name,omitempty"`
+  Placemarks  []kmlPlacemark  `xml:"Placemark"`
+  Folders     []kmlDocument   `xml:"Folder"`
+}
+
+type kmlPlacemark struct {
+  Name        string          `xml:"name,omitempty"`
+  Description string          `xml:"description,omitempty"`
+  LineString  *kmlGeometry    `xml:"LineString"`
+  Point       *kmlGeometry    `xml:"Point"`
+}
+
+type kmlGeometry struct {
+  AltitudeMode  string  `xml:"altitudeMode,omitempty"`
+  Coordinates   string  `xml:"coordinates"`
+}
+
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// every placemark in document order, folders included
+func (d *kmlDocument) placemarks() []kmlPlacemark {
+  marks := d.Placemarks
+  for i := range d.Folders {
+    marks = append(marks, d.Folders[i].placemarks()...)
+  }
+  return marks
+}
+
+// "lon,lat[,alt] lon,lat[,alt] ..." as waypoints
+func (g *kmlGeometry) waypoints(alt float32) ([]*mavlink.MissionItemInt, error) {
+  frame := uint8(mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT)
+  useAlt := false
+  switch g.AltitudeMode {
+  case "absolute":
+    frame = mavlink.MAV_FRAME_GLOBAL
+    useAlt = true
+  case "relativeToGround":
+    useAlt = true
+  }
+
+  var items []*mavlink.MissionItemInt
+  for _, tuple := range strings.Fields(g.Coordinates) {
+    parts := strings.Split(tuple, ",")
+    if len(parts) < 2 || len(parts) > 3 {
+      return nil, fmt.Errorf("KML coordinate %q is not lon,lat[,alt].", tuple)
+    }
+
+    var vals [3]float64
+    for i, p := range parts {
+      v, err := strconv.ParseFloat(p, 64)
+      if err != nil {
+        return nil, fmt.Errorf("KML coordinate %q is not lon,lat[,alt].", tuple)
+      }
+      vals[i] = v
+    }
+
+    z := alt
+    if useAlt && len(parts) == 3 {
+      z = float32(vals[2])
+    }
+
+    items = append(items, &mavlink.MissionItemInt{
+      X: EncodeCoord(frame, vals[1]),
+      Y: EncodeCoord(frame, vals[0]),
+      Z: z,
+      Command: mavlink.MAV_CMD_NAV_WAYPOINT,
+      Frame: frame,
+      Autocontinue: 1,
+    })
+  }
+  return items, nil
+}
+
+func ReadKML(r io.Reader, alt float32) (*Mission, error) {
+  var file kmlFile
+  if err := xml.NewDecoder(r).Decode(&file); err != nil {
+    return nil, fmt.Errorf("KML is not valid XML.")
+  }
+
+  marks := file.Document.placemarks()
+  m := &Mission{}
+
+  for _, mark := range marks {
+    if mark.LineString != nil {
+      items, err := mark.LineString.waypoints(alt)
+      if err != nil {
+        return nil, err
+      }
+      m.Items = items
+      break
+    }
+  }
+
+  if m.Items == nil {
+    for _, mark := range marks {
+      if mark.Point != nil {
+        items, err := mark.Point.waypoints(alt)
+        if err != nil {
+          return nil, err
+        }
+        m.Items = append(m.Items, items...)
+      }
+    }
+  }
+
+  if len(m.Items) == 0 {
+    return nil, fmt.Errorf("KML has no path or points.")
+  }
+
+  for i, item := range m.Items {
+    item.Seq = uint16(i)
+  }
+  return m, nil
+}
+
+func kmlAltitudeMode(frame uint8) string {
+  if frame == mavlink.MAV_FRAME_GLOBAL || frame == mavlink.MAV_FRAME_GLOBAL_INT {
+    return "absolute"
+  }
+  return "relativeToGround"
+}
+
+func kmlCoord(item *mavlink.MissionItemInt) string {
+  return formatFloat(DecodeCoord(item.Frame, item.Y)) + "," +
+    formatFloat(DecodeCoord(item.Frame, item.X)) + "," +
+    formatFloat32(item.Z)
+}
+
+func WriteKML(w io.Writer, m *Mission) error {
+  doc := kmlDocument{Name: "Mission"}
+
+  var path []string
+  mode := ""
+
+  for _, item := range m.Items {
+    // only items that go somewhere
+    if !IsGlobal(item.Frame) || item.Command > mavlink.MAV_CMD_NAV_LAST || (item.X == 0 && item.Y == 0) {
+      continue
+    }
+
+    if mode == "" {
+      mode = kmlAltitudeMode(item.Frame)
+    }
+    path = append(path, kmlCoord(item))
+
+    doc.Placemarks = append(doc.Placemarks, kmlPlacemark{
+      Name: strconv.Itoa(int(item.Seq)),
+      Description: mavlink.MavCmd(item.Command).String(),
+      Point: &kmlGeometry{kmlAltitudeMode(item.Frame), kmlCoord(item)},
+    })
+  }
+
+  if len(path) > 0 {
+    // path goes first, so it is what gets read back
+    doc.Placemarks = append([]kmlPlacemark{{
+      Name: "Path",
+      LineString: &kmlGeometry{mode, strings.Join(path, " ")},
+    }}, doc.Placemarks...)
+  }
+
+  if _, err := io.WriteString(w, xml.Header); err != nil {
+    return err
+  }
+
+  enc := xml.NewEncoder(w)
+  enc.Indent("", "  ")
+  if err := enc.Encode(&kmlFile{Namespace: kmlNamespace, Document: doc}); err != nil {
+    return err
+  }
+  _, err := io.WriteString(w, "\n")
+  return err
+}
@@ -0,0 +1,164 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+//
+// Mission files, to and from MISSION_ITEM_INT sequences. Supports
+// QGroundControl .plan files, Mission Planner / QGC .waypoints files, and
+// KML paths.
+//
+
+package mission
+
+import (
+  "bytes"
+  "fmt"
+  "io"
+  "math"
+
+  "mavlink/parser"
+)
+
+const (
+  FORMAT_PLAN      = "plan"
+  FORMAT_WAYPOINTS = "waypoints"
+  FORMAT_KML       = "kml"
+)
+
+var Formats = []string{FORMAT_PLAN, FORMAT_WAYPOINTS, FORMAT_KML}
+
+type Mission struct {
+  Home      *mavlink.MissionItemInt // planned home position, nil if unknown
+  Items     []*mavlink.MissionItemInt
+}
+
+// How X and Y of a MISSION_ITEM_INT are scaled for frame: degrees * 1e7
+// for global frames, meters * 1e4 for local ones.
+func CoordScale(frame uint8) float64 {
+  switch frame {
+  case mavlink.MAV_FRAME_GLOBAL, mavlink.MAV_FRAME_GLOBAL_INT,
+    mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT_INT,
+    mavlink.MAV_FRAME_GLOBAL_TERRAIN_ALT, mavlink.MAV_FRAME_GLOBAL_TERRAIN_ALT_INT:
+    return 1e7
+  case mavlink.MAV_FRAME_LOCAL_NED, mavlink.MAV_FRAME_LOCAL_ENU,
+    mavlink.MAV_FRAME_LOCAL_OFFSET_NED, mavlink.MAV_FRAME_BODY_NED,
+    mavlink.MAV_FRAME_BODY_OFFSET_NED:
+    return 1e4
+  default:
+    return 1
+  }
+}
+
+func IsGlobal(frame uint8) bool {
+  return CoordScale(frame) == 1e7
+}
+
+// x, y in plain units (degrees or meters) to the ints of MISSION_ITEM_INT
+func EncodeCoord(frame uint8, v float64) int32 {
+  return int32(math.Floor(v * CoordScale(frame) + 0.5))
+}
+
+func DecodeCoord(frame uint8, v int32) float64 {
+  return float64(v) / CoordScale(frame)
+}
+
+func boolToUint8(b bool) uint8 {
+  if b {
+    return 1
+  }
+  return 0
+}
+
+//
+// Checks every item against common.xml: known MAV_CMD and MAV_FRAME,
+// coordinates on the globe, and jumps that land inside the mission.
+// Items are renumbered in order.
+//
+func (m *Mission) Validate() error {
+  for i, item := range m.Items {
+    item.Seq = uint16(i)
+
+    if !mavlink.MavCmd(item.Command).Valid() || item.Command == mavlink.MAV_CMD_NAV_LAST {
+      return fmt.Errorf("Mission item %d: unknown command %d.", i, item.Command)
+    }
+
+    if !mavlink.MavFrame(item.Frame).Valid() {
+      return fmt.Errorf("Mission item %d: unknown frame %d.", i, item.Frame)
+    }
+
+    if IsGlobal(item.Frame) {
+      lat, lon := DecodeCoord(item.Frame, item.X), DecodeCoord(item.Frame, item.Y)
+      if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+        return fmt.Errorf("Mission item %d: position %v, %v is off the globe.", i, lat, lon)
+      }
+    }
+
+    if item.Command == mavlink.MAV_CMD_DO_JUMP {
+      if target := int(item.Param1); target < 0 || target >= len(m.Items) || target == i {
+        return fmt.Errorf("Mission item %d: jump to missing item %d.", i, target)
+      }
+    }
+
+    if item.Current > 1 || item.Autocontinue > 1 {
+      return fmt.Errorf("Mission item %d: current and autocontinue must be 0 or 1.", i)
+    }
+  }
+  return nil
+}
+
+//
+// Read and write in one of the formats. For KML, alt is the altitude
+// (relative to home) given to points that have none.
+//
+func Read(format string, r io.Reader, alt float32) (*Mission, error) {
+  var m *Mission
+  var err error
+
+  switch format {
+  case FORMAT_PLAN: m, err = ReadPlan(r)
+  case FORMAT_WAYPOINTS: m, err = ReadWaypoints(r)
+  case FORMAT_KML: m, err = ReadKML(r, alt)
+  default:
+    return nil, fmt.Errorf("Unknown mission format %s.", format)
+  }
+
+  if err != nil {
+    return nil, err
+  }
+  return m, m.Validate()
+}
+
+func Write(format string, w io.Writer, m *Mission) error {
+  switch format {
+  case FORMAT_PLAN: return WritePlan(w, m)
+  case FORMAT_WAYPOINTS: return WriteWaypoints(w, m)
+  case FORMAT_KML: return WriteKML(w, m)
+  default:
+    return fmt.Errorf("Unknown mission format %s.", format)
+  }
+}
+
+func Marshal(format string, m *Mission) ([]byte, error) {
+  var buf bytes.Buffer
+  err := Write(format, &buf, m)
+  return buf.Bytes(), err
+}
+
+// MIME type of format, empty if it is not one we know.
+func ContentType(format string) string {
+  switch format {
+  case FORMAT_PLAN: return "application/json"
+  case FORMAT_WAYPOINTS: return "text/plain"
+  case FORMAT_KML: return "application/vnd.google-earth.kml+xml"
+  default: return ""
+  }
+}
@@ -0,0 +1,137 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "bytes"
+  "strings"
+  "testing"
+
+  "mavlink/parser"
+)
+
+func testMission() *Mission {
+  return &Mission{
+    Home: &mavlink.MissionItemInt{Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: mavlink.MAV_FRAME_GLOBAL,
+      X: 361699000, Y: -1151398000, Z: 620, Autocontinue: 1},
+    Items: []*mavlink.MissionItemInt{
+      {Seq: 0, Command: mavlink.MAV_CMD_NAV_TAKEOFF, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+        X: 361699000, Y: -1151398000, Z: 10, Autocontinue: 1},
+      {Seq: 1, Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+        Param1: 2.5, X: 361701234, Y: -1151401234, Z: 15.5, Autocontinue: 1},
+      {Seq: 2, Command: mavlink.MAV_CMD_DO_JUMP, Frame: mavlink.MAV_FRAME_MISSION,
+        Param1: 1, Param2: 3, Autocontinue: 1},
+      {Seq: 3, Command: mavlink.MAV_CMD_NAV_LAND, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+        X: 361699000, Y: -1151398000, Autocontinue: 1},
+    },
+  }
+}
+
+func TestRoundTrip(t *testing.T) {
+  want := testMission()
+
+  for _, format := range []string{FORMAT_PLAN, FORMAT_WAYPOINTS} {
+    data, err := Marshal(format, want)
+    if err != nil {
+      t.Fatalf("%s: Write fail %q", format, err)
+    }
+
+    got, err := Read(format, bytes.NewReader(data), 0)
+    if err != nil {
+      t.Fatalf("%s: Read fail %q\n%s", format, err, data)
+    }
+
+    if *got.Home != *want.Home {
+      t.Errorf("%s: home read back as %v, want %v", format, got.Home, want.Home)
+    }
+    if len(got.Items) != len(want.Items) {
+      t.Fatalf("%s: read back %d items, want %d", format, len(got.Items), len(want.Items))
+    }
+    for i := range want.Items {
+      if *got.Items[i] != *want.Items[i] {
+        t.Errorf("%s: item %d read back as %v, want %v", format, i, got.Items[i], want.Items[i])
+      }
+    }
+  }
+}
+
+func TestKML(t *testing.T) {
+  data, err := Marshal(FORMAT_KML, testMission())
+  if err != nil {
+    t.Fatalf("Write fail %q", err)
+  }
+
+  got, err := Read(FORMAT_KML, bytes.NewReader(data), 0)
+  if err != nil {
+    t.Fatalf("Read fail %q\n%s", err, data)
+  }
+
+  // the path has the 3 items with a position, as waypoints
+  if len(got.Items) != 3 || got.Items[1].X != 361701234 || got.Items[1].Z != 15.5 ||
+    got.Items[1].Command != mavlink.MAV_CMD_NAV_WAYPOINT {
+    t.Errorf("KML read back as %v\n%s", got.Items, data)
+  }
+
+  kml := `<?xml version="1.0" encoding="UTF-8"?>
+<kml xmlns="http://www.opengis.net/kml/2.2"><Document><Folder>
+  <Placemark><Point><coordinates>-115.1,36.1</coordinates></Point></Placemark>
+  <Placemark><Point><coordinates>-115.2,36.2,50</coordinates></Point></Placemark>
+</Folder></Document></kml>`
+
+  got, err = Read(FORMAT_KML, strings.NewReader(kml), 25)
+  if err != nil {
+    t.Fatalf("Read fail %q", err)
+  }
+  if len(got.Items) != 2 || got.Items[1].Y != -1152000000 || got.Items[1].Z != 25 || got.Items[1].Seq != 1 {
+    t.Errorf("KML points read back as %v", got.Items)
+  }
+}
+
+func TestValidate(t *testing.T) {
+  bad := map[string]*mavlink.MissionItemInt{
+    "command": {Command: 9999, Frame: mavlink.MAV_FRAME_GLOBAL},
+    "frame":   {Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: 200},
+    "globe":   {Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: mavlink.MAV_FRAME_GLOBAL, X: 910000000},
+    "jump":    {Command: mavlink.MAV_CMD_DO_JUMP, Frame: mavlink.MAV_FRAME_MISSION, Param1: 7},
+  }
+
+  for name, item := range bad {
+    m := &Mission{Items: []*mavlink.MissionItemInt{item}}
+    if err := m.Validate(); err == nil {
+      t.Errorf("%s: expected validation to fail", name)
+    }
+  }
+
+  if err := testMission().Validate(); err != nil {
+    t.Errorf("Validate fail %q", err)
+  }
+}
+
+func TestReadErrors(t *testing.T) {
+  bad := map[string]string{
+    FORMAT_PLAN:      `{"fileType": "Plan", "mission": {"items": [{"type": "ComplexItem", "complexItemType": "survey"}]}}`,
+    FORMAT_WAYPOINTS: "QGC WPL 110\n0\t1\t0\t16\n",
+    FORMAT_KML:       `<kml><Document></Document></kml>`,
+  }
+
+  for format, data := range bad {
+    if _, err := Read(format, strings.NewReader(data), 0); err == nil {
+      t.Errorf("%s: expected read to fail", format)
+    }
+  }
+
+  if _, err := Read("gpx", strings.NewReader(""), 0); err == nil {
+    t.Error("expected unknown format to fail")
+  }
+}
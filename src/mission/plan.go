@@ -0,0 +1,215 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "encoding/json"
+  "fmt"
+  "io"
+  "math"
+  "strconv"
+
+  "mavlink/parser"
+)
+
+//
+// QGroundControl .plan file, version 1. Only the mission section is used,
+// fence and rally points are written empty.
+//
+type planFile struct {
+  FileType      string        `json:"fileType"`
+  GroundStation string        `json:"groundStation"`
+  Version       int           `json:"version"`
+  Mission       planMission   `json:"mission"`
+  GeoFence      planGeoFence  `json:"geoFence"`
+  RallyPoints   planRally     `json:"rallyPoints"`
+}
+
+type planMission struct {
+  Version             int          `json:"version"`
+  FirmwareType        int          `json:"firmwareType"`
+  VehicleType         int          `json:"vehicleType"`
+  CruiseSpeed         float64      `json:"cruiseSpeed"`
+  HoverSpeed          float64      `json:"hoverSpeed"`
+  PlannedHomePosition []float64    `json:"plannedHomePosition"`
+  Items               []planItem   `json:"items"`
+}
+
+type planItem struct {
+  Type          string        `json:"type"`
+  Command       uint16        `json:"command"`
+  Frame         uint8         `json:"frame"`
+  AutoContinue  bool          `json:"autoContinue"`
+  DoJumpId      int           `json:"doJumpId"`
+  Params        []*float64    `json:"params"` // 7 of them, null for NaN
+
+  ComplexItemType string      `json:"complexItemType,omitempty"`
+}
+
+type planGeoFence struct {
+  Version   int             `json:"version"`
+  Circles   []interface{}   `json:"circles"`
+  Polygons  []interface{}   `json:"polygons"`
+}
+
+type planRally struct {
+  Version   int             `json:"version"`
+  Points    []interface{}   `json:"points"`
+}
+
+func planParam(p *float64) float32 {
+  if p == nil {
+    return float32(math.NaN())
+  }
+  return float32(*p)
+}
+
+func planValue(v float64) *float64 {
+  if math.IsNaN(v) || math.IsInf(v, 0) {
+    return nil
+  }
+  return &v
+}
+
+// float32 as written, ie 0.1 rather than 0.10000000149011612
+func round32(v float32) float64 {
+  f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+  return f
+}
+
+func planValue32(v float32) *float64 {
+  return planValue(round32(v))
+}
+
+func ReadPlan(r io.Reader) (*Mission, error) {
+  var plan planFile
+  if err := json.NewDecoder(r).Decode(&plan); err != nil {
+    return nil, fmt.Errorf("Plan is not valid JSON.")
+  }
+
+  if plan.FileType != "Plan" {
+    return nil, fmt.Errorf("Not a QGroundControl plan file.")
+  }
+
+  m := &Mission{}
+
+  if home := plan.Mission.PlannedHomePosition; len(home) == 3 {
+    m.Home = &mavlink.MissionItemInt{
+      Command: mavlink.MAV_CMD_NAV_WAYPOINT,
+      Frame: mavlink.MAV_FRAME_GLOBAL,
+      X: EncodeCoord(mavlink.MAV_FRAME_GLOBAL, home[0]),
+      Y: EncodeCoord(mavlink.MAV_FRAME_GLOBAL, home[1]),
+      Z: float32(home[2]),
+      Autocontinue: 1,
+    }
+  }
+
+  // doJumpIds are numbered from 1, jump targets refer to them
+  jumpIds := make(map[int]int)
+
+  for i, it := range plan.Mission.Items {
+    if it.Type != "SimpleItem" {
+      return nil, fmt.Errorf("Plan item %d: %s items are not supported, only SimpleItem.", i, it.ComplexItemType)
+    }
+    if len(it.Params) != 7 {
+      return nil, fmt.Errorf("Plan item %d: needs 7 params.", i)
+    }
+
+    item := &mavlink.MissionItemInt{
+      Param1: planParam(it.Params[0]),
+      Param2: planParam(it.Params[1]),
+      Param3: planParam(it.Params[2]),
+      Param4: planParam(it.Params[3]),
+      Z: planParam(it.Params[6]),
+      Seq: uint16(i),
+      Command: it.Command,
+      Frame: it.Frame,
+      Autocontinue: boolToUint8(it.AutoContinue),
+    }
+    if it.Params[4] != nil {
+      item.X = EncodeCoord(it.Frame, *it.Params[4])
+    }
+    if it.Params[5] != nil {
+      item.Y = EncodeCoord(it.Frame, *it.Params[5])
+    }
+
+    jumpIds[it.DoJumpId] = i
+    m.Items = append(m.Items, item)
+  }
+
+  for _, item := range m.Items {
+    if item.Command == mavlink.MAV_CMD_DO_JUMP {
+      if seq, ok := jumpIds[int(item.Param1)]; ok {
+        item.Param1 = float32(seq)
+      }
+    }
+  }
+
+  return m, nil
+}
+
+func WritePlan(w io.Writer, m *Mission) error {
+  plan := planFile{
+    FileType: "Plan",
+    GroundStation: "Dronesmith",
+    Version: 1,
+    Mission: planMission{
+      Version: 2,
+      FirmwareType: mavlink.MAV_AUTOPILOT_PX4,
+      VehicleType: mavlink.MAV_TYPE_QUADROTOR,
+      CruiseSpeed: 15,
+      HoverSpeed: 5,
+      PlannedHomePosition: []float64{0, 0, 0},
+      Items: []planItem{},
+    },
+    GeoFence: planGeoFence{2, []interface{}{}, []interface{}{}},
+    RallyPoints: planRally{2, []interface{}{}},
+  }
+
+  if m.Home != nil {
+    plan.Mission.PlannedHomePosition = []float64{
+      DecodeCoord(m.Home.Frame, m.Home.X),
+      DecodeCoord(m.Home.Frame, m.Home.Y),
+      round32(m.Home.Z),
+    }
+  }
+
+  for i, item := range m.Items {
+    param1 := item.Param1
+    if item.Command == mavlink.MAV_CMD_DO_JUMP {
+      param1 += 1 // to doJumpId
+    }
+
+    plan.Mission.Items = append(plan.Mission.Items, planItem{
+      Type: "SimpleItem",
+      Command: item.Command,
+      Frame: item.Frame,
+      AutoContinue: item.Autocontinue != 0,
+      DoJumpId: i + 1,
+      Params: []*float64{
+        planValue32(param1),
+        planValue32(item.Param2),
+        planValue32(item.Param3),
+        planValue32(item.Param4),
+        planValue(DecodeCoord(item.Frame, item.X)),
+        planValue(DecodeCoord(item.Frame, item.Y)),
+        planValue32(item.Z),
+      },
+    })
+  }
+
+  enc := json.NewEncoder(w)
+  enc.SetIndent("", "    ")
+  return enc.Encode(&plan)
+}
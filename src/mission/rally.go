@@ -0,0 +1,69 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "fmt"
+
+  "mavlink/parser"
+)
+
+// An alternative place to return to on RTL.
+type RallyPoint struct {
+  Latitude  float64
+  Longitude float64
+  Altitude  float32 // meters above home
+}
+
+func ValidateRally(points []RallyPoint) error {
+  for i, p := range points {
+    if !validLatLon(p.Latitude, p.Longitude) {
+      return fmt.Errorf("Rally point %d: %v, %v is off the globe.", i, p.Latitude, p.Longitude)
+    }
+  }
+  return nil
+}
+
+// As MAV_MISSION_TYPE_RALLY items.
+func RallyItems(points []RallyPoint) []*mavlink.MissionItemInt {
+  items := make([]*mavlink.MissionItemInt, len(points))
+  for i, p := range points {
+    items[i] = &mavlink.MissionItemInt{
+      X: EncodeCoord(mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, p.Latitude),
+      Y: EncodeCoord(mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, p.Longitude),
+      Z: p.Altitude,
+      Seq: uint16(i),
+      Command: mavlink.MAV_CMD_NAV_RALLY_POINT,
+      Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+      Autocontinue: 1,
+      MissionType: mavlink.MAV_MISSION_TYPE_RALLY,
+    }
+  }
+  return items
+}
+
+func RallyFromItems(items []*mavlink.MissionItemInt) ([]RallyPoint, error) {
+  points := make([]RallyPoint, len(items))
+  for i, item := range items {
+    if item.Command != mavlink.MAV_CMD_NAV_RALLY_POINT {
+      return nil, fmt.Errorf("Rally item %d: %s is not a rally point.", i, mavlink.MavCmd(item.Command))
+    }
+    points[i] = RallyPoint{
+      Latitude: DecodeCoord(item.Frame, item.X),
+      Longitude: DecodeCoord(item.Frame, item.Y),
+      Altitude: item.Z,
+    }
+  }
+  return points, nil
+}
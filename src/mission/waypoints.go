@@ -0,0 +1,161 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package mission
+
+import (
+  "bufio"
+  "fmt"
+  "io"
+  "strconv"
+  "strings"
+
+  "mavlink/parser"
+)
+
+//
+// Mission Planner / QGC text format. After the header, one item per line:
+//   index current frame command p1 p2 p3 p4 x y z autocontinue
+// separated by tabs. Line 0 is the home position.
+//
+const waypointsHeader = "QGC WPL 110"
+
+func ReadWaypoints(r io.Reader) (*Mission, error) {
+  scanner := bufio.NewScanner(r)
+
+  if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != waypointsHeader {
+    return nil, fmt.Errorf("Not a %s waypoints file.", waypointsHeader)
+  }
+
+  m := &Mission{}
+  line := 1
+
+  for scanner.Scan() {
+    line++
+    text := strings.TrimSpace(scanner.Text())
+    if text == "" {
+      continue
+    }
+
+    fields := strings.Fields(text)
+    if len(fields) != 12 {
+      return nil, fmt.Errorf("Waypoints line %d: expected 12 fields, got %d.", line, len(fields))
+    }
+
+    var ints [4]uint64
+    for i := range ints {
+      v, err := strconv.ParseUint(fields[i], 10, 16)
+      if err != nil {
+        return nil, fmt.Errorf("Waypoints line %d: bad field %q.", line, fields[i])
+      }
+      ints[i] = v
+    }
+
+    var floats [7]float64
+    for i := range floats {
+      v, err := strconv.ParseFloat(fields[4 + i], 64)
+      if err != nil {
+        return nil, fmt.Errorf("Waypoints line %d: bad field %q.", line, fields[4 + i])
+      }
+      floats[i] = v
+    }
+
+    autocontinue, err := strconv.ParseUint(fields[11], 10, 8)
+    if err != nil {
+      return nil, fmt.Errorf("Waypoints line %d: bad field %q.", line, fields[11])
+    }
+
+    frame := uint8(ints[2])
+    item := &mavlink.MissionItemInt{
+      Param1: float32(floats[0]),
+      Param2: float32(floats[1]),
+      Param3: float32(floats[2]),
+      Param4: float32(floats[3]),
+      X: EncodeCoord(frame, floats[4]),
+      Y: EncodeCoord(frame, floats[5]),
+      Z: float32(floats[6]),
+      Command: uint16(ints[3]),
+      Frame: frame,
+      Current: uint8(ints[1]),
+      Autocontinue: uint8(autocontinue),
+    }
+
+    if ints[0] == 0 && m.Home == nil && len(m.Items) == 0 {
+      item.Current = 0
+      m.Home = item
+    } else {
+      item.Seq = uint16(len(m.Items))
+      m.Items = append(m.Items, item)
+    }
+  }
+
+  if err := scanner.Err(); err != nil {
+    return nil, err
+  }
+
+  // jumps count the home line
+  for _, item := range m.Items {
+    if item.Command == mavlink.MAV_CMD_DO_JUMP && m.Home != nil {
+      item.Param1 -= 1
+    }
+  }
+  return m, nil
+}
+
+func formatFloat(v float64) string {
+  return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatFloat32(v float32) string {
+  return strconv.FormatFloat(float64(v), 'f', -1, 32)
+}
+
+func writeWaypoint(w *bufio.Writer, index int, item *mavlink.MissionItemInt) {
+  param1 := item.Param1
+  if item.Command == mavlink.MAV_CMD_DO_JUMP && index > 0 {
+    param1 += 1
+  }
+
+  fields := []string{
+    strconv.Itoa(index),
+    strconv.Itoa(int(item.Current)),
+    strconv.Itoa(int(item.Frame)),
+    strconv.Itoa(int(item.Command)),
+    formatFloat32(param1),
+    formatFloat32(item.Param2),
+    formatFloat32(item.Param3),
+    formatFloat32(item.Param4),
+    formatFloat(DecodeCoord(item.Frame, item.X)),
+    formatFloat(DecodeCoord(item.Frame, item.Y)),
+    formatFloat32(item.Z),
+    strconv.Itoa(int(item.Autocontinue)),
+  }
+  w.WriteString(strings.Join(fields, "\t") + "\r\n")
+}
+
+func WriteWaypoints(w io.Writer, m *Mission) error {
+  out := bufio.NewWriter(w)
+  out.WriteString(waypointsHeader + "\r\n")
+
+  home := m.Home
+  if home == nil {
+    home = &mavlink.MissionItemInt{Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: mavlink.MAV_FRAME_GLOBAL, Autocontinue: 1}
+  }
+  writeWaypoint(out, 0, home)
+
+  for i, item := range m.Items {
+    writeWaypoint(out, i + 1, item)
+  }
+
+  return out.Flush()
+}
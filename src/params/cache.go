@@ -0,0 +1,132 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+//
+// Params of every FMU we've seen, kept on disk so a vehicle that drops off
+// and comes back doesn't have to send them all again. Entries are keyed by
+// the FMU's UID, and carry the hash the FMU gave for them so they can be
+// checked against it before use.
+//
+
+package params
+
+import (
+  "encoding/json"
+  "fmt"
+  "io/ioutil"
+  "os"
+  "path/filepath"
+  "sort"
+  "time"
+)
+
+// A param as the vehicle sent it. The value is kept as the bits of the float
+// on the wire, since unions of ints aren't always numbers.
+type CachedParam struct {
+  Name    string
+  Index   uint
+  Bits    uint32
+  Type    uint8 // MAV_PARAM_TYPE
+}
+
+type CacheEntry struct {
+  FmuId   uint64
+  Hash    uint32 // _HASH_CHECK of the params, when they were cached
+  Total   uint
+  Saved   time.Time
+  Params  []CachedParam
+}
+
+type Cache struct {
+  dir     string
+}
+
+func NewCache(dir string) (*Cache, error) {
+  if err := os.MkdirAll(dir, 0755); err != nil {
+    return nil, err
+  }
+  return &Cache{dir}, nil
+}
+
+func (c *Cache) path(fmuId uint64) string {
+  return filepath.Join(c.dir, fmt.Sprintf("%016x.json", fmuId))
+}
+
+// Entry for fmuId, or nil if there isn't one.
+func (c *Cache) Load(fmuId uint64) (*CacheEntry, error) {
+  raw, err := ioutil.ReadFile(c.path(fmuId))
+  if os.IsNotExist(err) {
+    return nil, nil
+  } else if err != nil {
+    return nil, err
+  }
+
+  var e CacheEntry
+  if err := json.Unmarshal(raw, &e); err != nil {
+    return nil, err
+  } else if e.FmuId != fmuId {
+    return nil, fmt.Errorf("Param cache for %016x is for another FMU.", fmuId)
+  }
+  return &e, nil
+}
+
+// Replaces the entry for e.FmuId. Written aside and moved into place, so a
+// crash never leaves half an entry behind.
+func (c *Cache) Save(e *CacheEntry) error {
+  sort.Slice(e.Params, func(i, j int) bool { return e.Params[i].Index < e.Params[j].Index })
+
+  raw, err := json.Marshal(e)
+  if err != nil {
+    return err
+  }
+
+  tmp, err := ioutil.TempFile(c.dir, ".params")
+  if err != nil {
+    return err
+  }
+  if _, err := tmp.Write(raw); err != nil {
+    tmp.Close()
+    os.Remove(tmp.Name())
+    return err
+  }
+  if err := tmp.Close(); err != nil {
+    os.Remove(tmp.Name())
+    return err
+  }
+  return os.Rename(tmp.Name(), c.path(e.FmuId))
+}
+
+func (c *Cache) Remove(fmuId uint64) error {
+  err := os.Remove(c.path(fmuId))
+  if os.IsNotExist(err) {
+    return nil
+  }
+  return err
+}
+
+var cache *Cache
+
+// Keeps params in dir from now on.
+func InitCache(dir string) error {
+  c, err := NewCache(dir)
+  if err != nil {
+    return err
+  }
+  cache = c
+  return nil
+}
+
+// The param cache, or nil if there isn't one.
+func SharedCache() *Cache {
+  return cache
+}
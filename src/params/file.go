@@ -0,0 +1,279 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+//
+// Param files, to clone a vehicle's configuration onto others. Supports
+// QGroundControl .params files and our own JSON.
+//
+
+package params
+
+import (
+  "bufio"
+  "bytes"
+  "encoding/json"
+  "fmt"
+  "io"
+  "sort"
+  "strconv"
+  "strings"
+
+  "mavlink/parser"
+)
+
+const (
+  FORMAT_QGC  = "params"
+  FORMAT_JSON = "json"
+)
+
+var Formats = []string{FORMAT_QGC, FORMAT_JSON}
+
+type Value struct {
+  Name    string
+  Value   float64
+  Type    uint8 // MAV_PARAM_TYPE
+}
+
+// A vehicle's params at one time, by name.
+type Snapshot struct {
+  SystemId      uint8
+  ComponentId   uint8
+  Params        []Value
+}
+
+func NewSnapshot(sysId, compId uint8, values []Value) *Snapshot {
+  s := &Snapshot{sysId, compId, values}
+  sort.Slice(s.Params, func(i, j int) bool { return s.Params[i].Name < s.Params[j].Name })
+  return s
+}
+
+func (s *Snapshot) Get(name string) (Value, bool) {
+  for _, v := range s.Params {
+    if v.Name == name {
+      return v, true
+    }
+  }
+  return Value{}, false
+}
+
+func (s *Snapshot) Validate() error {
+  if len(s.Params) == 0 {
+    return fmt.Errorf("No params in the file.")
+  }
+
+  seen := make(map[string]bool)
+  for _, v := range s.Params {
+    if v.Name == "" || len(v.Name) > 16 {
+      return fmt.Errorf("Param name %q must be 1 to 16 characters.", v.Name)
+    } else if v.Type < mavlink.MAV_PARAM_TYPE_UINT8 || v.Type > mavlink.MAV_PARAM_TYPE_REAL64 {
+      return fmt.Errorf("Param %s has unknown type %d.", v.Name, v.Type)
+    } else if seen[v.Name] {
+      return fmt.Errorf("Param %s is in the file twice.", v.Name)
+    }
+    seen[v.Name] = true
+  }
+  return nil
+}
+
+func isFloat(kind uint8) bool {
+  return kind == mavlink.MAV_PARAM_TYPE_REAL32 || kind == mavlink.MAV_PARAM_TYPE_REAL64
+}
+
+// Whether a and b are the same value as kind. Floats only count as far as
+// the float32 they are sent as.
+func Equal(kind uint8, a, b float64) bool {
+  if isFloat(kind) {
+    return float32(a) == float32(b)
+  }
+  return a == b
+}
+
+//
+// QGC text format. After # comments, one param per line:
+//   vehicle-id component-id name value type
+// separated by tabs. Lines for components other than the first are skipped,
+// since only the autopilot's params can be set.
+//
+func ReadQGC(r io.Reader) (*Snapshot, error) {
+  scanner := bufio.NewScanner(r)
+  s := &Snapshot{}
+  line := 0
+  first := true
+
+  for scanner.Scan() {
+    line++
+    text := strings.TrimSpace(scanner.Text())
+    if text == "" || strings.HasPrefix(text, "#") {
+      continue
+    }
+
+    fields := strings.Fields(text)
+    if len(fields) != 5 {
+      return nil, fmt.Errorf("Params line %d: expected 5 fields, got %d.", line, len(fields))
+    }
+
+    var ids [2]uint64
+    for i := range ids {
+      v, err := strconv.ParseUint(fields[i], 10, 8)
+      if err != nil {
+        return nil, fmt.Errorf("Params line %d: bad field %q.", line, fields[i])
+      }
+      ids[i] = v
+    }
+
+    value, err := strconv.ParseFloat(fields[3], 64)
+    if err != nil {
+      return nil, fmt.Errorf("Params line %d: bad field %q.", line, fields[3])
+    }
+
+    kind, err := strconv.ParseUint(fields[4], 10, 8)
+    if err != nil {
+      return nil, fmt.Errorf("Params line %d: bad field %q.", line, fields[4])
+    }
+
+    if first {
+      s.SystemId, s.ComponentId = uint8(ids[0]), uint8(ids[1])
+      first = false
+    } else if uint8(ids[1]) != s.ComponentId {
+      continue
+    }
+
+    s.Params = append(s.Params, Value{fields[2], value, uint8(kind)})
+  }
+
+  if err := scanner.Err(); err != nil {
+    return nil, err
+  }
+  return s, nil
+}
+
+func formatValue(v Value) string {
+  if isFloat(v.Type) {
+    return strconv.FormatFloat(v.Value, 'f', -1, 32)
+  }
+  return strconv.FormatFloat(v.Value, 'f', 0, 64)
+}
+
+func WriteQGC(w io.Writer, s *Snapshot) error {
+  bw := bufio.NewWriter(w)
+  fmt.Fprintf(bw, "# Onboard parameters for Vehicle %d\n", s.SystemId)
+  fmt.Fprintln(bw, "#")
+  fmt.Fprintln(bw, "# Vehicle-Id Component-Id Name Value Type")
+
+  for _, v := range s.Params {
+    fmt.Fprintf(bw, "%d\t%d\t%s\t%s\t%d\n", s.SystemId, s.ComponentId, v.Name, formatValue(v), v.Type)
+  }
+  return bw.Flush()
+}
+
+func ReadJSON(r io.Reader) (*Snapshot, error) {
+  var s Snapshot
+  if err := json.NewDecoder(r).Decode(&s); err != nil {
+    return nil, fmt.Errorf("Not a params JSON file: %v", err)
+  }
+  return &s, nil
+}
+
+func WriteJSON(w io.Writer, s *Snapshot) error {
+  enc := json.NewEncoder(w)
+  enc.SetIndent("", "  ")
+  return enc.Encode(s)
+}
+
+func Read(format string, r io.Reader) (*Snapshot, error) {
+  var s *Snapshot
+  var err error
+
+  switch format {
+  case FORMAT_QGC: s, err = ReadQGC(r)
+  case FORMAT_JSON: s, err = ReadJSON(r)
+  default:
+    return nil, fmt.Errorf("Unknown params format %s.", format)
+  }
+
+  if err != nil {
+    return nil, err
+  }
+  return s, s.Validate()
+}
+
+func Write(format string, w io.Writer, s *Snapshot) error {
+  switch format {
+  case FORMAT_QGC: return WriteQGC(w, s)
+  case FORMAT_JSON: return WriteJSON(w, s)
+  default:
+    return fmt.Errorf("Unknown params format %s.", format)
+  }
+}
+
+func Marshal(format string, s *Snapshot) ([]byte, error) {
+  var buf bytes.Buffer
+  err := Write(format, &buf, s)
+  return buf.Bytes(), err
+}
+
+// MIME type of format, empty if it is not one we know.
+func ContentType(format string) string {
+  switch format {
+  case FORMAT_QGC: return "text/plain"
+  case FORMAT_JSON: return "application/json"
+  default: return ""
+  }
+}
+
+type Change struct {
+  Name    string
+  Type    uint8
+  Old     float64 // on the vehicle
+  New     float64 // in the file
+}
+
+//
+// How a file differs from what is on the vehicle. Types are the vehicle's.
+//
+type Diff struct {
+  Changed   []Change
+  Unknown   []string // in the file, but not on the vehicle
+  Missing   []string // on the vehicle, but not in the file
+  Same      int
+}
+
+func Compare(file, live *Snapshot) *Diff {
+  d := &Diff{Changed: []Change{}, Unknown: []string{}, Missing: []string{}}
+
+  onVehicle := make(map[string]Value)
+  for _, v := range live.Params {
+    onVehicle[v.Name] = v
+  }
+
+  inFile := make(map[string]bool)
+  for _, v := range file.Params {
+    inFile[v.Name] = true
+    cur, found := onVehicle[v.Name]
+    if !found {
+      d.Unknown = append(d.Unknown, v.Name)
+    } else if Equal(cur.Type, cur.Value, v.Value) {
+      d.Same++
+    } else {
+      d.Changed = append(d.Changed, Change{v.Name, cur.Type, cur.Value, v.Value})
+    }
+  }
+
+  for _, v := range live.Params {
+    if !inFile[v.Name] {
+      d.Missing = append(d.Missing, v.Name)
+    }
+  }
+
+  return d
+}
@@ -0,0 +1,97 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package params
+
+import (
+  "bytes"
+  "strings"
+  "testing"
+
+  "mavlink/parser"
+)
+
+const testQGC = `# Onboard parameters for Vehicle 1
+#
+# Stack: PX4 Pro
+#
+# Vehicle-Id Component-Id Name Value Type
+1	1	MC_ROLL_P	6.500000000000000000	9
+1	1	SYS_AUTOSTART	16777217	6
+1	1	NAV_RCL_ACT	2	6
+1	154	MNT_MODE	1	6
+`
+
+func TestQGCRoundTrip(t *testing.T) {
+  s, err := Read(FORMAT_QGC, strings.NewReader(testQGC))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  // the gimbal's params are skipped
+  if len(s.Params) != 3 || s.SystemId != 1 || s.ComponentId != 1 {
+    t.Fatalf("expected 3 autopilot params, got %+v", s)
+  }
+  if v, _ := s.Get("SYS_AUTOSTART"); v.Value != 16777217 || v.Type != mavlink.MAV_PARAM_TYPE_INT32 {
+    t.Errorf("SYS_AUTOSTART fail, got %+v", v)
+  }
+
+  for _, format := range Formats {
+    data, err := Marshal(format, s)
+    if err != nil {
+      t.Fatal(err)
+    }
+    back, err := Read(format, bytes.NewReader(data))
+    if err != nil {
+      t.Fatalf("%s: %v\n%s", format, err, data)
+    }
+    if len(back.Params) != len(s.Params) {
+      t.Fatalf("%s: expected %d params, got %d", format, len(s.Params), len(back.Params))
+    }
+    for i, v := range s.Params {
+      if back.Params[i] != v {
+        t.Errorf("%s: expected %+v, got %+v", format, v, back.Params[i])
+      }
+    }
+  }
+
+  if _, err := Read(FORMAT_QGC, strings.NewReader("1 1 MC_ROLL_P 6.5\n")); err == nil {
+    t.Error("read a line without a type")
+  }
+  if _, err := Read(FORMAT_QGC, strings.NewReader("1\t1\tMC_ROLL_P\t6.5\t9\n1\t1\tMC_ROLL_P\t7\t9\n")); err == nil {
+    t.Error("read a param twice")
+  }
+}
+
+func TestCompare(t *testing.T) {
+  file := NewSnapshot(1, 1, []Value{
+    {"MC_ROLL_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32},
+    {"MC_PITCH_P", 0.1, mavlink.MAV_PARAM_TYPE_REAL32},
+    {"SYS_AUTOSTART", 16777217, mavlink.MAV_PARAM_TYPE_INT32},
+    {"NOT_ON_VEHICLE", 1, mavlink.MAV_PARAM_TYPE_INT32},
+  })
+  live := NewSnapshot(1, 1, []Value{
+    {"MC_ROLL_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32},
+    {"MC_PITCH_P", float64(float32(0.1)), mavlink.MAV_PARAM_TYPE_REAL32}, // same as float32
+    {"SYS_AUTOSTART", 16777216, mavlink.MAV_PARAM_TYPE_INT32},
+    {"NOT_IN_FILE", 1, mavlink.MAV_PARAM_TYPE_INT32},
+  })
+
+  d := Compare(file, live)
+  if d.Same != 2 || len(d.Changed) != 1 || d.Changed[0].Name != "SYS_AUTOSTART" || d.Changed[0].New != 16777217 {
+    t.Errorf("changes fail, got %+v", d)
+  }
+  if len(d.Unknown) != 1 || d.Unknown[0] != "NOT_ON_VEHICLE" || len(d.Missing) != 1 || d.Missing[0] != "NOT_IN_FILE" {
+    t.Errorf("unknown and missing fail, got %+v", d)
+  }
+}
@@ -0,0 +1,299 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package params
+
+import (
+  "encoding/xml"
+  "fmt"
+  "io"
+  "math"
+  "os"
+  "sort"
+  "strconv"
+  "strings"
+)
+
+const (
+  PARAM_INT32 = "INT32"
+  PARAM_FLOAT = "FLOAT"
+)
+
+//
+// What the firmware says about a param, from PX4ParameterFactMetaData.xml.
+// Limits that the file leaves out are nil.
+//
+type Param struct {
+  Name            string
+  Group           string
+  Type            string // PARAM_INT32 or PARAM_FLOAT
+  Default         float64
+  ShortDesc       string
+  LongDesc        string          `json:",omitempty"`
+  Unit            string          `json:",omitempty"`
+  Min             *float64        `json:",omitempty"`
+  Max             *float64        `json:",omitempty"`
+  Decimals        *int            `json:",omitempty"`
+  Increment       *float64        `json:",omitempty"`
+  Values          map[int]string  `json:",omitempty"` // description by code, if it is an enum
+  Bitmask         map[uint]string `json:",omitempty"` // description by bit index
+  Boolean         bool            `json:",omitempty"`
+  RebootRequired  bool            `json:",omitempty"`
+}
+
+//
+// Every param in a metadata file, by name.
+//
+type Metadata struct {
+  Version   int
+  params    map[string]*Param
+  groups    []string
+}
+
+type xmlParameters struct {
+  XMLName   xml.Name    `xml:"parameters"`
+  Version   int         `xml:"version"`
+  Groups    []xmlGroup  `xml:"group"`
+}
+
+type xmlGroup struct {
+  Name    string      `xml:"name,attr"`
+  Params  []xmlParam  `xml:"parameter"`
+}
+
+type xmlParam struct {
+  Name            string      `xml:"name,attr"`
+  Type            string      `xml:"type,attr"`
+  Default         string      `xml:"default,attr"`
+  ShortDesc       string      `xml:"short_desc"`
+  LongDesc        string      `xml:"long_desc"`
+  Unit            string      `xml:"unit"`
+  Min             string      `xml:"min"`
+  Max             string      `xml:"max"`
+  Decimal         string      `xml:"decimal"`
+  Increment       string      `xml:"increment"`
+  Boolean         *struct{}   `xml:"boolean"`
+  RebootRequired  string      `xml:"reboot_required"`
+  Values          []xmlOption `xml:"values>value"`
+  Bits            []xmlOption `xml:"bitmask>bit"`
+}
+
+type xmlOption struct {
+  Code  string `xml:"code,attr"`
+  Index string `xml:"index,attr"`
+  Desc  string `xml:",chardata"`
+}
+
+func Load(path string) (*Metadata, error) {
+  f, err := os.Open(path)
+  if err != nil {
+    return nil, err
+  }
+  defer f.Close()
+  return Parse(f)
+}
+
+func Parse(r io.Reader) (*Metadata, error) {
+  var doc xmlParameters
+  if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+    return nil, err
+  }
+
+  m := &Metadata{Version: doc.Version, params: make(map[string]*Param)}
+  for _, g := range doc.Groups {
+    m.groups = append(m.groups, g.Name)
+    for _, x := range g.Params {
+      p, err := x.param(g.Name)
+      if err != nil {
+        return nil, fmt.Errorf("Param %s: %v", x.Name, err)
+      }
+      m.params[p.Name] = p
+    }
+  }
+
+  return m, nil
+}
+
+// descriptions span lines in the file
+func oneLine(s string) string {
+  return strings.Join(strings.Fields(s), " ")
+}
+
+// nil if s is empty
+func optionalFloat(s string) (*float64, error) {
+  if s = strings.TrimSpace(s); s == "" {
+    return nil, nil
+  }
+  f, err := strconv.ParseFloat(s, 64)
+  if err != nil {
+    return nil, err
+  }
+  return &f, nil
+}
+
+func (x *xmlParam) param(group string) (*Param, error) {
+  p := &Param{
+    Name: x.Name,
+    Group: group,
+    Type: x.Type,
+    ShortDesc: oneLine(x.ShortDesc),
+    LongDesc: oneLine(x.LongDesc),
+    Unit: strings.TrimSpace(x.Unit),
+    Boolean: x.Boolean != nil,
+    RebootRequired: strings.TrimSpace(x.RebootRequired) == "true",
+  }
+
+  if p.Type != PARAM_INT32 && p.Type != PARAM_FLOAT {
+    return nil, fmt.Errorf("unknown type %s", p.Type)
+  }
+
+  var err error
+  if p.Default, err = strconv.ParseFloat(x.Default, 64); err != nil {
+    return nil, err
+  }
+  if p.Min, err = optionalFloat(x.Min); err != nil {
+    return nil, err
+  }
+  if p.Max, err = optionalFloat(x.Max); err != nil {
+    return nil, err
+  }
+  if p.Increment, err = optionalFloat(x.Increment); err != nil {
+    return nil, err
+  }
+  if s := strings.TrimSpace(x.Decimal); s != "" {
+    d, err := strconv.Atoi(s)
+    if err != nil {
+      return nil, err
+    }
+    p.Decimals = &d
+  }
+
+  if len(x.Values) > 0 {
+    p.Values = make(map[int]string)
+    for _, v := range x.Values {
+      code, err := strconv.Atoi(v.Code)
+      if err != nil {
+        return nil, err
+      }
+      p.Values[code] = oneLine(v.Desc)
+    }
+  }
+
+  if len(x.Bits) > 0 {
+    p.Bitmask = make(map[uint]string)
+    for _, b := range x.Bits {
+      index, err := strconv.ParseUint(b.Index, 10, 5)
+      if err != nil {
+        return nil, err
+      }
+      p.Bitmask[uint(index)] = oneLine(b.Desc)
+    }
+  }
+
+  return p, nil
+}
+
+// The param called name, or nil if the file doesn't know it.
+func (m *Metadata) Get(name string) *Param {
+  return m.params[name]
+}
+
+// Group names, in file order.
+func (m *Metadata) Groups() []string {
+  return m.groups
+}
+
+// Every param name, sorted.
+func (m *Metadata) Names() []string {
+  names := make([]string, 0, len(m.params))
+  for name := range m.params {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+  return names
+}
+
+//
+// Error if the firmware wouldn't take value for this param: outside its
+// limits, not a whole number for an INT32, not one of its options for an enum
+// or boolean, or setting bits a bitmask doesn't define.
+//
+// FLOAT limits are compared as float32, since that is what goes over the wire.
+//
+func (p *Param) Validate(value float64) error {
+  if math.IsNaN(value) || math.IsInf(value, 0) {
+    return fmt.Errorf("%s must be a number.", p.Name)
+  }
+
+  below := func(limit float64) bool { return value < limit }
+  above := func(limit float64) bool { return value > limit }
+
+  if p.Type == PARAM_INT32 {
+    if value != math.Trunc(value) {
+      return fmt.Errorf("%s must be a whole number.", p.Name)
+    } else if value < math.MinInt32 || value > math.MaxInt32 {
+      return fmt.Errorf("%s is out of range.", p.Name)
+    }
+  } else {
+    below = func(limit float64) bool { return float32(value) < float32(limit) }
+    above = func(limit float64) bool { return float32(value) > float32(limit) }
+  }
+
+  if p.Min != nil && below(*p.Min) {
+    return fmt.Errorf("%s must be at least %g.", p.Name, *p.Min)
+  }
+  if p.Max != nil && above(*p.Max) {
+    return fmt.Errorf("%s must be at most %g.", p.Name, *p.Max)
+  }
+
+  if p.Boolean && value != 0 && value != 1 {
+    return fmt.Errorf("%s must be 0 or 1.", p.Name)
+  }
+
+  if p.Values != nil {
+    if _, found := p.Values[int(value)]; !found {
+      return fmt.Errorf("%s must be one of its values, not %g.", p.Name, value)
+    }
+  }
+
+  if p.Bitmask != nil {
+    bits := uint32(int32(value))
+    for i := uint(0); i < 32; i++ {
+      if _, found := p.Bitmask[i]; bits & (1 << i) != 0 && !found {
+        return fmt.Errorf("%s has no bit %d.", p.Name, i)
+      }
+    }
+  }
+
+  return nil
+}
+
+var metadata *Metadata
+
+// Loads the metadata params are checked and described with.
+func InitParams(path string) error {
+  m, err := Load(path)
+  if err != nil {
+    return err
+  }
+  metadata = m
+  return nil
+}
+
+// Metadata for name, or nil if it isn't known or none was loaded.
+func Lookup(name string) *Param {
+  if metadata == nil {
+    return nil
+  }
+  return metadata.Get(name)
+}
@@ -0,0 +1,123 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package params
+
+import (
+  "strings"
+  "testing"
+)
+
+const testMetadata = "../../assets/PX4ParameterFactMetaData.xml"
+
+func TestLoadMetadata(t *testing.T) {
+  m, err := Load(testMetadata)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if len(m.Names()) != 869 || len(m.Groups()) != 47 {
+    t.Errorf("expected 869 params in 47 groups, got %d in %d", len(m.Names()), len(m.Groups()))
+  }
+
+  p := m.Get("EKF2_GPS_CHECK")
+  if p == nil || p.Type != PARAM_INT32 || p.Default != 21 || *p.Max != 511 || len(p.Bitmask) != 9 ||
+    p.Bitmask[2] != "Max horizontal position error (EKF2_REQ_EPH)" {
+    t.Errorf("EKF2_GPS_CHECK fail, got %+v", p)
+  }
+
+  p = m.Get("SYS_AUTOSTART")
+  if p == nil || !p.RebootRequired || p.Group != "System" {
+    t.Errorf("SYS_AUTOSTART fail, got %+v", p)
+  }
+
+  p = m.Get("ATT_ACC_COMP")
+  if p == nil || strings.Contains(p.ShortDesc, "\n") {
+    t.Errorf("expected one line description, got %+v", p)
+  }
+
+  if m.Get("NOT_A_PARAM") != nil {
+    t.Error("found a param that isn't there")
+  }
+}
+
+func TestValidateParam(t *testing.T) {
+  m, err := Parse(strings.NewReader(`<?xml version='1.0' encoding='UTF-8'?>
+<parameters>
+  <version>3</version>
+  <group name="Test">
+    <parameter default="0.1" name="T_GAIN" type="FLOAT">
+      <short_desc>Gain</short_desc>
+      <min>0.1</min>
+      <max>0.5</max>
+      <decimal>2</decimal>
+    </parameter>
+    <parameter default="1" name="T_MODE" type="INT32">
+      <short_desc>Mode</short_desc>
+      <values>
+        <value code="-1">Off</value>
+        <value code="1">On</value>
+      </values>
+    </parameter>
+    <parameter default="0" name="T_EN" type="INT32">
+      <short_desc>Enable</short_desc>
+      <boolean />
+    </parameter>
+    <parameter default="0" name="T_BIG" type="INT32">
+      <short_desc>Big</short_desc>
+      <min>16777217</min>
+    </parameter>
+    <parameter default="3" name="T_MASK" type="INT32">
+      <short_desc>Mask</short_desc>
+      <bitmask>
+        <bit index="0">A</bit>
+        <bit index="1">B</bit>
+        <bit index="3">D</bit>
+      </bitmask>
+    </parameter>
+  </group>
+</parameters>`))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  checks := []struct {
+    name  string
+    value float64
+    ok    bool
+  }{
+    {"T_GAIN", 0.1, true}, // float32(0.1) > 0.1
+    {"T_GAIN", 0.5, true},
+    {"T_GAIN", 0.05, false},
+    {"T_GAIN", 0.6, false},
+    {"T_MODE", -1, true},
+    {"T_MODE", 0, false},
+    {"T_MODE", 1.5, false},
+    {"T_EN", 1, true},
+    {"T_EN", 2, false},
+    {"T_MASK", 11, true},
+    {"T_MASK", 4, false},
+    {"T_BIG", 16777217, true},
+    {"T_BIG", 16777216, false}, // same float32 as the min
+  }
+
+  for _, c := range checks {
+    if err := m.Get(c.name).Validate(c.value); (err == nil) != c.ok {
+      t.Errorf("%s = %g: expected ok %v, got %v", c.name, c.value, c.ok, err)
+    }
+  }
+
+  if p := m.Get("T_GAIN"); *p.Decimals != 2 || p.Values != nil {
+    t.Errorf("T_GAIN fail, got %+v", p)
+  }
+}
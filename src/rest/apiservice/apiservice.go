@@ -20,6 +20,8 @@ import (
   "math"
   "net/http"
   "regexp"
+  "crypto/sha256"
+  "encoding/hex"
   "encoding/json"
   "time"
   "strconv"
@@ -28,10 +30,14 @@ import (
   "mavlink/parser"
   "cloud"
   "dronemanager"
+  "params"
   "vehicle"
   CoreApi "vehicle/api"
 )
 
+// How long a request waits on a command's ack.
+const COMMAND_WAIT = 5 * time.Second
+
 type DroneAPI struct {
   addr string
   localMode bool
@@ -138,6 +144,10 @@ func (api *DroneAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
   if !api.localMode {
 
     if filteredPath[1] == "*" && req.Method == "GET" {
+      if len(filteredPath) > 2 && filteredPath[2] == "stream" {
+        api.handleFleetStream(&w, req)
+        return
+      }
       jsonObj := api.manager.GetAllVehicleData()
       api.SendAPIJSON(jsonObj, &w)
       return
@@ -215,17 +225,48 @@ func (api *DroneAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
     case "what3words": api.handleW3W(chunk, &w)
     case "home": api.handleTelem("Home", chunk, &w)
     case "log": api.handleLog(veh, &w)
+    case "stream": api.handleStream(veh, filteredPath[1], &w, req)
+    case "events": api.handleEvents(veh, &w, req)
+    case "fence": api.handleGetFence(veh, &w)
+    case "rally": api.handleGetRally(veh, &w)
+    case "offboard": api.handleGetOffboard(veh, &w)
+    case "jobs":
+      if len(filteredPath) < 4 {
+        api.handleGetJobs(veh, &w)
+      } else {
+        api.handleGetJob(veh, filteredPath[3], &w)
+      }
+    case "mission":
+      if len(filteredPath) < 4 {
+        api.handleGetMission(veh, &w)
+      } else if filteredPath[3] == "export" && len(filteredPath) > 4 {
+        api.handleExportMission(veh, filteredPath[4], &w)
+      } else {
+        api.Send404(&w)
+      }
+    case "mavlink":
+      if len(filteredPath) < 4 {
+        api.Send404(&w)
+      } else {
+        api.handleGetMAVLink(veh, filteredPath[3], &w)
+      }
     case "param":
       if len(filteredPath) < 4 {
         api.Send404(&w)
       } else {
-        api.handleGetSingleParam(veh, filteredPath[3], &w)
+        api.handleGetSingleParam(veh, filteredPath[3], withMeta(req), &w)
       }
     case "params":
       if len(filteredPath) < 4 {
-        api.handleGetAllParams(veh, &w)
+        api.handleGetAllParams(veh, withMeta(req), &w)
       } else if filteredPath[3] == "refresh" {
-        api.handleRefreshParams(veh, &w)
+        api.handleRefreshParams(veh, isAsync(req, nil), &w)
+      } else if filteredPath[3] == "export" {
+        format := params.FORMAT_QGC
+        if len(filteredPath) > 4 {
+          format = filteredPath[4]
+        }
+        api.handleExportParams(veh, format, &w)
       } else {
         api.Send404(&w)
       }
@@ -242,10 +283,13 @@ func (api *DroneAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
     defer req.Body.Close()
 
     toLowerJSON(pdata)
+    if isAsync(req, pdata) {
+      pdata["async"] = true
+    }
 
     switch filteredPath[2] {
-    case "arm": api.handleArmDisarm(veh, true, &w)
-    case "disarm": api.handleArmDisarm(veh, false, &w)
+    case "arm": api.handleArmDisarm(veh, true, pdata, &w)
+    case "disarm": api.handleArmDisarm(veh, false, pdata, &w)
     case "ssh":
       if len(filteredPath) < 4 {
         api.Send404(&w)
@@ -268,7 +312,73 @@ func (api *DroneAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
       } else {
         api.handleSetParam(veh, filteredPath[3], pdata, &w)
       }
+    case "params":
+      if len(filteredPath) < 4 {
+        api.handleSetParams(veh, pdata, &w)
+      } else if filteredPath[3] == "import" {
+        api.handleImportParams(veh, pdata, &w)
+      } else if filteredPath[3] == "diff" {
+        api.handleDiffParams(veh, pdata, &w)
+      } else {
+        api.Send404(&w)
+      }
     case "home": api.handleSetHome(veh, pdata, &w)
+    case "signing": api.handleSigning(veh, pdata, &w)
+    case "mavlink": api.handleSendMAVLink(veh, pdata, &w)
+    case "fence":
+      if len(filteredPath) < 4 {
+        api.handleUploadFence(veh, pdata, &w)
+      } else if filteredPath[3] == "clear" {
+        api.handleClearFence(veh, &w)
+      } else {
+        api.Send404(&w)
+      }
+    case "mission":
+      if len(filteredPath) < 4 {
+        api.handleUploadMission(veh, pdata, &w)
+      } else if filteredPath[3] == "clear" {
+        api.handleClearMission(veh, &w)
+      } else if filteredPath[3] == "import" {
+        api.handleImportMission(veh, pdata, &w)
+      } else if filteredPath[3] == "current" {
+        api.handleSetMissionCurrent(veh, pdata, &w)
+      } else {
+        api.Send404(&w)
+      }
+    case "offboard":
+      if len(filteredPath) < 4 {
+        api.handleStartOffboard(veh, pdata, &w)
+      } else if filteredPath[3] == "setpoint" {
+        api.handleOffboardSetpoint(veh, pdata, &w)
+      } else if filteredPath[3] == "heartbeat" {
+        api.handleOffboardHeartbeat(veh, &w)
+      } else if filteredPath[3] == "stop" {
+        api.handleStopOffboard(veh, pdata, &w)
+      } else {
+        api.Send404(&w)
+      }
+    case "jobs":
+      if len(filteredPath) > 4 && filteredPath[4] == "cancel" {
+        api.handleCancelJob(veh, filteredPath[3], &w)
+      } else {
+        api.Send404(&w)
+      }
+    default: api.Send404(&w)
+    }
+  } else if req.Method == "PUT" {
+    decoder := json.NewDecoder(req.Body)
+    var pdata map[string]interface{}
+    err := decoder.Decode(&pdata)
+    if err != nil {
+      api.Send404(&w)
+      return
+    }
+    defer req.Body.Close()
+
+    toLowerJSON(pdata)
+
+    switch filteredPath[2] {
+    case "rally": api.handleSetRally(veh, pdata, &w)
     default: api.Send404(&w)
     }
   } else {
@@ -277,9 +387,8 @@ func (api *DroneAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
   }
 }
 
-func (api *DroneAPI) handleArmDisarm(veh *vehicle.Vehicle, arming bool, w *http.ResponseWriter) {
-  veh.SetModeAndArm(false, true, "", arming)
-  api.commandBlock(veh, 176, w)
+func (api *DroneAPI) handleArmDisarm(veh *vehicle.Vehicle, arming bool, postData map[string]interface{}, w *http.ResponseWriter) {
+  api.commandBlock(veh, veh.SetModeAndArm(false, true, "", arming), postData, w)
 }
 
 func (api *DroneAPI) handleModeArm(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
@@ -298,8 +407,7 @@ func (api *DroneAPI) handleModeArm(veh *vehicle.Vehicle, postData map[string]int
     mode = m.(string)
   }
 
-  veh.SetModeAndArm(doSetMode, doSetArm, mode, arming)
-  api.commandBlock(veh, 176, w)
+  api.commandBlock(veh, veh.SetModeAndArm(doSetMode, doSetArm, mode, arming), postData, w)
 }
 
 func (api *DroneAPI) handleTerminal(w *http.ResponseWriter, id string, enable bool) {
@@ -396,8 +504,7 @@ func (api *DroneAPI) handleSetHome(veh *vehicle.Vehicle, postData map[string]int
     rel = false
   }
 
-  veh.SetHome(float32(lat), float32(lon), float32(alt), rel)
-  api.commandBlock(veh, 179, w)
+  api.commandBlock(veh, veh.SetHome(float32(lat), float32(lon), float32(alt), rel), postData, w)
 }
 
 
@@ -421,25 +528,53 @@ func (api *DroneAPI) handleTelem(kind string, data map[string]interface{}, w *ht
   }
 }
 
-func (api *DroneAPI) handleGetAllParams(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+// ?meta=true, to describe params with their metadata
+func withMeta(req *http.Request) bool {
+  meta, _ := strconv.ParseBool(req.URL.Query().Get("meta"))
+  return meta
+}
+
+func (api *DroneAPI) handleGetAllParams(veh *vehicle.Vehicle, meta bool, w *http.ResponseWriter) {
   paramsRes := make(map[string]interface{})
   current, total, chunk := veh.GetAllParams()
   paramsRes["total"] = total
   paramsRes["current"] = current
   paramsRes["missing"] = veh.MissingParams()
 
-  // JSON cannot encode NaNs
+  // Values exactly as their types, ints past 2^24 included
+  values := make(map[string]interface{})
+  types := make(map[string]string)
   for k, e := range chunk {
-    if math.IsNaN(float64(e)) {
-      chunk[k] = 0.0
+    // JSON cannot encode NaNs
+    if math.IsNaN(e.Value) {
+      e.Value = 0.0
     }
+    values[k] = e.Number()
+    types[k] = e.TypeName()
+  }
+
+  paramsRes["params"] = values
+  paramsRes["types"] = types
+
+  if meta {
+    descs := make(map[string]*params.Param)
+    for k := range chunk {
+      if p := params.Lookup(k); p != nil {
+        descs[k] = p
+      }
+    }
+    paramsRes["meta"] = descs
   }
 
-  paramsRes["params"] = chunk
   api.SendAPIJSON(paramsRes, w)
 }
 
-func (api *DroneAPI) handleRefreshParams(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+func (api *DroneAPI) handleRefreshParams(veh *vehicle.Vehicle, async bool, w *http.ResponseWriter) {
+  if async {
+    api.sendJob(veh.RefreshParamsJob(), w)
+    return
+  }
+
   veh.RefreshParams()
 
   attempts := 0
@@ -462,26 +597,37 @@ func (api *DroneAPI) handleRefreshParams(veh *vehicle.Vehicle, w *http.ResponseW
   api.SendAPIError(fmt.Errorf("Failed to fetch all params."), w)
 }
 
-func (api *DroneAPI) handleGetSingleParam(veh *vehicle.Vehicle, name string, w *http.ResponseWriter) {
-  var val float32
+func (api *DroneAPI) handleGetSingleParam(veh *vehicle.Vehicle, name string, meta bool, w *http.ResponseWriter) {
+  var val CoreApi.ParamValue
   var perr error
   if i, err := strconv.Atoi(name); err != nil {
     // look up by string
     val, perr = veh.GetParam(name)
   } else {
     val, perr = veh.GetParamByIndex(uint(i))
+    if perr == nil && meta {
+      // metadata is by name
+      name, perr = veh.ParamNameAt(uint(i))
+    }
   }
 
   if perr != nil {
     api.SendAPIError(perr, w)
+  } else if meta {
+    ret := make(map[string]interface{})
+    ret["Name"] = name
+    ret["Value"] = val.Number()
+    ret["Type"] = val.TypeName()
+    ret["Meta"] = params.Lookup(name)
+    api.SendAPIJSON(ret, w)
   } else {
-    api.SendAPIJSON(val, w)
+    api.SendAPIJSON(val.Number(), w)
   }
 }
 
 func (api *DroneAPI) handleSetParam(veh *vehicle.Vehicle, path string, data map[string]interface{}, w *http.ResponseWriter) {
   val := data["value"].(float64)
-  if err := veh.SetParam(path, float32(val)); err != nil {
+  if err := veh.SetParam(path, val); err != nil {
     api.SendAPIError(err, w)
   } else {
     ret := make(map[string]interface{})
@@ -493,12 +639,20 @@ func (api *DroneAPI) handleSetParam(veh *vehicle.Vehicle, path string, data map[
 func (api *DroneAPI) handleInput(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
   t := postData["type"].(string)
   e := postData["enabled"].(bool)
-  var ts float64
+  var ts, rate float64
 
   if postData["timeout"] != nil {
     ts = postData["timeout"].(float64)
   }
 
+  if postData["rate"] != nil {
+    rate = postData["rate"].(float64)
+    if rate < 1 || rate > 50 {
+      api.SendAPIError(fmt.Errorf("Rate must be between 1 and 50 Hz."), w)
+      return
+    }
+  }
+
   if t == "radio" {
     channels := [8]uint16{65535, 65535, 65535, 65535, 65535, 65535, 65535, 65535,}
     vals := postData["channels"].([]interface{})
@@ -507,7 +661,31 @@ func (api *DroneAPI) handleInput(veh *vehicle.Vehicle, postData map[string]inter
       arg := e.(float64)
       channels[i] = uint16(arg)
     }
-    veh.SendRCOverride(channels, e, uint(ts))
+    veh.SendRCOverride(channels, e, uint(ts), uint(rate))
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  } else if t == "joystick" {
+    // x pitch, y roll, z thrust, r yaw. Axes left out are sent as unused.
+    var axes [4]int16
+    for i, name := range []string{"x", "y", "z", "r"} {
+      axes[i] = math.MaxInt16
+      if postData[name] != nil {
+        arg := postData[name].(float64)
+        if arg < -1000 || arg > 1000 {
+          api.SendAPIError(fmt.Errorf("Axes must be between -1000 and 1000."), w)
+          return
+        }
+        axes[i] = int16(arg)
+      }
+    }
+
+    var buttons uint16
+    if postData["buttons"] != nil {
+      buttons = uint16(postData["buttons"].(float64))
+    }
+
+    veh.SendManualControl(axes[0], axes[1], axes[2], axes[3], buttons, e, uint(ts), uint(rate))
     ret := make(map[string]interface{})
     ret["Status"] = "OK"
     api.SendAPIJSON(ret, w)
@@ -517,6 +695,104 @@ func (api *DroneAPI) handleInput(veh *vehicle.Vehicle, postData map[string]inter
 
 }
 
+//
+// Turns link signing on with a key or a passphrase to derive one from, ie
+//   {"key": "<64 hex characters>"} or {"passphrase": "..."}
+// or off with {"enabled": false}. The key is only kept in memory, so after a
+// restart we don't sign until it is set again, and a vehicle that still has
+// it may ignore us.
+//
+func (api *DroneAPI) handleSigning(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  var key [32]byte
+
+  enabled := true
+  if postData["enabled"] != nil {
+    var ok bool
+    if enabled, ok = postData["enabled"].(bool); !ok {
+      api.SendAPIError(fmt.Errorf("Enabled must be true or false."), w)
+      return
+    }
+  }
+
+  if !enabled {
+    // zero key disables signing
+  } else if postData["key"] != nil {
+    hexKey, ok := postData["key"].(string)
+    raw, err := hex.DecodeString(hexKey)
+    if !ok || err != nil || len(raw) != len(key) {
+      api.SendAPIError(fmt.Errorf("Key must be 64 hex characters."), w)
+      return
+    }
+    copy(key[:], raw)
+  } else if postData["passphrase"] != nil {
+    passphrase, ok := postData["passphrase"].(string)
+    if !ok {
+      api.SendAPIError(fmt.Errorf("Passphrase must be a string."), w)
+      return
+    }
+    key = sha256.Sum256([]byte(passphrase))
+  } else {
+    api.SendAPIError(fmt.Errorf("Key or passphrase is required."), w)
+    return
+  }
+
+  if err := veh.SetupSigning(key); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Signing"] = veh.SigningEnabled()
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleGetMAVLink(veh *vehicle.Vehicle, name string, w *http.ResponseWriter) {
+  name = strings.ToUpper(name)
+
+  if _, err := mavlink.NewMessageByName(name); err != nil {
+    api.SendAPIError(fmt.Errorf("Unknown message %s.", name), w)
+  } else if m := veh.LastMessage(name); m == nil {
+    api.SendAPIError(fmt.Errorf("No %s received yet.", name), w)
+  } else {
+    api.SendAPIJSON(m, w)
+  }
+}
+
+//
+// Sends any message the dialects know about, given as
+// {"name": "COMMAND_LONG", "fields": {"command": 400, ...}}
+//
+func (api *DroneAPI) handleSendMAVLink(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  if postData["name"] == nil {
+    api.SendAPIError(fmt.Errorf("Name is required."), w)
+    return
+  }
+
+  raw, err := json.Marshal(map[string]interface{}{
+    "name": strings.ToUpper(postData["name"].(string)),
+    "fields": postData["fields"],
+  })
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  m, err := mavlink.UnmarshalMessage(raw)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  if err := veh.SendMessage(m); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Message"] = m
+    api.SendAPIJSON(ret, w)
+  }
+}
+
 func (api *DroneAPI) handleCommand(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
   params := [7]float32{}
   cmd := 0.0
@@ -540,8 +816,7 @@ func (api *DroneAPI) handleCommand(veh *vehicle.Vehicle, postData map[string]int
     }
   }
 
-  veh.DoGenericCommand(int(cmd), params)
-  api.commandBlock(veh, int(cmd), w)
+  api.commandBlock(veh, veh.DoGenericCommand(int(cmd), params), postData, w)
 }
 
 func (api *DroneAPI) handleLand(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
@@ -585,18 +860,17 @@ func (api *DroneAPI) handleLand(veh *vehicle.Vehicle, postData map[string]interf
   // }
   //
   // params[6] = veh.GetMASLAlt()
-  veh.DoGenericCommand(mavlink.MAV_CMD_NAV_LAND, params)
-  api.commandBlock(veh, mavlink.MAV_CMD_NAV_LAND, w)
+  api.commandBlock(veh, veh.DoGenericCommand(mavlink.MAV_CMD_NAV_LAND, params), postData, w)
 }
 
 func (api *DroneAPI) handleGuided(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
-  params := [7]float32{}
-  loc := veh.GetGlobal()
+  params := [4]float32{}
+  curLat, curLon := veh.GetLatLon()
+  var lat, lon float64
+  var alt float32
   useRelPos := false
   useRelAlt := true
 
-  veh.SetModeAndArm(true, false, "Hold", true)
-
   if postData["relativealt"] != nil {
     val := postData["relativealt"].(bool)
     useRelAlt = val
@@ -623,50 +897,56 @@ func (api *DroneAPI) handleGuided(veh *vehicle.Vehicle, postData map[string]inte
     val := postData["altitude"].(float64)
 
     if useRelAlt {
-      params[6] = float32(val) + veh.GetMASLAlt()
+      alt = float32(val) + veh.GetMASLAlt()
     } else {
-      params[6] = float32(val)
+      alt = float32(val)
     }
   } else {
-    params[6] = veh.GetMASLAlt()
+    alt = veh.GetMASLAlt()
   }
 
   if postData["lat"] != nil {
     val := postData["lat"].(float64)
 
     if useRelPos {
-      params[4] = loc["Latitude"] + float32(val)
+      lat = curLat + val
     } else {
-      params[4] = float32(val)
+      lat = val
     }
   } else {
-    params[4] = loc["Latitude"]
+    lat = curLat
   }
 
   if postData["lon"] != nil {
     val := postData["lon"].(float64)
 
     if useRelPos {
-      params[5] = loc["Longitude"] + float32(val)
+      lon = curLon + val
     } else {
-      params[5] = float32(val)
+      lon = val
     }
   } else {
-    params[5] = loc["Longitude"]
+    lon = curLon
   }
 
-  veh.DoGenericCommand(mavlink.MAV_CMD_DO_REPOSITION, params)
-  api.commandBlock(veh, mavlink.MAV_CMD_DO_REPOSITION, w)
+  if err := api.checkFence(veh, lat, lon, alt); err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  veh.SetModeAndArm(true, false, "Hold", true)
+  api.commandBlock(veh, veh.DoPositionCommand(mavlink.MAV_CMD_DO_REPOSITION,
+    mavlink.MAV_FRAME_GLOBAL, params, lat, lon, alt), postData, w)
 }
 
 func (api *DroneAPI) handleTakeoff(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
-  params := [7]float32{}
-  global := veh.GetGlobal()
+  params := [4]float32{}
+  curLat, curLon := veh.GetLatLon()
+  var lat, lon float64
+  var alt float32
   useRelPos := false
   useRelAlt := true
 
-  veh.SetModeAndArm(true, true, "Takeoff", true)
-
   if postData["relativepos"] != nil {
     val := postData["relativepos"].(bool)
     useRelPos = val
@@ -686,71 +966,65 @@ func (api *DroneAPI) handleTakeoff(veh *vehicle.Vehicle, postData map[string]int
     val := postData["altitude"].(float64)
 
     if useRelAlt {
-      params[6] = float32(val) + veh.GetMASLAlt()
+      alt = float32(val) + veh.GetMASLAlt()
     } else {
-      params[6] = float32(val)
+      alt = float32(val)
     }
   } else {
-    params[6] = 10 + veh.GetMASLAlt()
+    alt = 10 + veh.GetMASLAlt()
   }
 
   if postData["lat"] != nil {
     val := postData["lat"].(float64)
 
     if useRelPos {
-      params[4] = global["Latitude"] + float32(val)
+      lat = curLat + val
     } else {
-      params[4] = float32(val)
+      lat = val
     }
   } else {
-    params[4] = global["Latitude"]
+    lat = curLat
   }
 
   if postData["lon"] != nil {
     val := postData["lon"].(float64)
     if useRelPos {
-      params[5] = global["Longitude"] + float32(val)
+      lon = curLon + val
     } else {
-      params[5] = float32(val)
+      lon = val
     }
   } else {
-    params[5] = global["Longitude"]
+    lon = curLon
   }
 
-  veh.DoGenericCommand(mavlink.MAV_CMD_NAV_TAKEOFF, params)
-  api.commandBlock(veh, mavlink.MAV_CMD_NAV_TAKEOFF, w)
-}
+  if err := api.checkFence(veh, lat, lon, alt); err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
 
-func (api *DroneAPI) commandBlock(veh *vehicle.Vehicle, cmd int, w *http.ResponseWriter) {
-  attempts := 0
-  data := make(map[string]interface{})
-  for {
-    if attempts >= 10 {
-      break
-    }
-    time.Sleep(250 * time.Millisecond)
-
-    if op, ack, num := veh.GetLastSuccessfulCmd(); op == int(cmd) {
-      // data["Status"] = "OK"
-      data["Status"] = ack
-      data["Command"] = cmd
-      data["StatusCode"] = num
-      if num != 10 {
-        // logger.Debug("Nulling last successful cmd")
-        veh.NullLastSuccessfulCmd()
-        api.SendAPIJSON(data, w)
-        return
-      }
-    } else {
-      data["Status"] = ack
-      data["StatusCode"] = num
-    }
+  veh.SetModeAndArm(true, true, "Takeoff", true)
+  api.commandBlock(veh, veh.DoPositionCommand(mavlink.MAV_CMD_NAV_TAKEOFF,
+    mavlink.MAV_FRAME_GLOBAL, params, lat, lon, alt), postData, w)
+}
 
-    attempts++
+// Waits on cmd's own ack, and replies with how it went. Commands still going
+// after COMMAND_WAIT are reported as they stand, with StatusCode 10 if the
+// vehicle hasn't answered at all. Async requests get a job to poll instead.
+func (api *DroneAPI) commandBlock(veh *vehicle.Vehicle, cmd *CoreApi.VehicleCommand, postData map[string]interface{}, w *http.ResponseWriter) {
+  if postData["async"] == true {
+    api.sendJob(veh.CommandJob(cmd), w)
+    return
   }
 
-  // data["Status"] = "FAIL"
-  data["Command"] = cmd
+  res, _ := cmd.Wait(COMMAND_WAIT)
+
+  data := make(map[string]interface{})
+  data["Status"] = res.Status
+  data["Command"] = res.Command
+  data["StatusCode"] = res.Result
+  if res.Result == mavlink.MAV_RESULT_IN_PROGRESS {
+    data["Progress"] = res.Progress
+  }
   api.SendAPIJSON(data, w)
 }
 
@@ -0,0 +1,109 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strconv"
+  "time"
+
+  "vehicle"
+  CoreApi "vehicle/api"
+)
+
+const (
+  eventsKeepAlive = 15 * time.Second // comment line so proxies keep the stream open
+)
+
+//
+// Server-Sent Events feed of the vehicle's events. Each event is sent as
+//   id: 42
+//   event: statustext
+//   data: {"Id":42,"Event":"statustext","Time":...,"Data":{...}}
+// Clients resume with the Last-Event-ID header (or ?lastEventId=, for those
+// that can't set headers) and get whatever is left in the history after it.
+//
+func (api *DroneAPI) handleEvents(veh *vehicle.Vehicle, w *http.ResponseWriter, req *http.Request) {
+  flusher, ok := (*w).(http.Flusher)
+  if !ok {
+    api.SendAPIError(fmt.Errorf("Streaming not supported."), w)
+    return
+  }
+
+  lastId := req.Header.Get("Last-Event-ID")
+  if lastId == "" {
+    lastId = req.URL.Query().Get("lastEventId")
+  }
+
+  var since uint64
+  if lastId != "" {
+    var err error
+    if since, err = strconv.ParseUint(lastId, 10, 64); err != nil {
+      api.SendAPIError(fmt.Errorf("Last-Event-ID must be a number."), w)
+      return
+    }
+  }
+
+  replay, events := veh.Events().Subscribe(since)
+  defer veh.Events().Unsubscribe(events)
+
+  header := (*w).Header()
+  header.Set("Content-Type", "text/event-stream")
+  header.Set("Cache-Control", "no-cache")
+  header.Set("Connection", "keep-alive")
+  (*w).WriteHeader(http.StatusOK)
+
+  for _, e := range replay {
+    if writeEvent(*w, e) != nil {
+      return
+    }
+  }
+  flusher.Flush()
+
+  keepAlive := time.NewTicker(eventsKeepAlive)
+  defer keepAlive.Stop()
+
+  for {
+    select {
+    case e, ok := <-events:
+      if !ok {
+        // fell behind, the client will reconnect and replay
+        return
+      }
+      if writeEvent(*w, e) != nil {
+        return
+      }
+
+    case <-keepAlive.C:
+      if _, err := fmt.Fprint(*w, ": keepalive\n\n"); err != nil {
+        return
+      }
+
+    case <-req.Context().Done():
+      return
+    }
+    flusher.Flush()
+  }
+}
+
+func writeEvent(w http.ResponseWriter, e *CoreApi.VehicleEvent) error {
+  data, err := json.Marshal(e)
+  if err != nil {
+    return err
+  }
+  _, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Id, e.Event, data)
+  return err
+}
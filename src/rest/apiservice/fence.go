@@ -0,0 +1,88 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+
+  "mission"
+  "vehicle"
+)
+
+func (api *DroneAPI) handleGetFence(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  if f := veh.Fence(); f != nil {
+    api.SendAPIJSON(f, w)
+  } else {
+    api.SendAPIJSON(&mission.Fence{}, w)
+  }
+}
+
+//
+// Replaces the fence, ie
+//   {"polygons": [{"inclusion": true, "points": [[36.1, -115.1], ...]}],
+//    "circles": [{"inclusion": false, "latitude": 36.1, "longitude": -115.1, "radius": 20}],
+//    "maxaltitude": 120}
+//
+func (api *DroneAPI) handleUploadFence(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  raw, err := json.Marshal(map[string]interface{}{
+    "Polygons": postData["polygons"],
+    "Circles": postData["circles"],
+    "MaxAltitude": postData["maxaltitude"],
+  })
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  var f mission.Fence
+  if err := json.Unmarshal(raw, &f); err != nil {
+    api.SendAPIError(fmt.Errorf("Fence is invalid."), w)
+    return
+  }
+
+  if err := veh.UploadFence(&f); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Fence"] = &f
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleClearFence(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  if err := veh.ClearFence(); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+// Targets sent through the API must stay inside the vehicle's fence.
+// amsl is the target altitude above sea level. The fence's altitude limit is
+// above home, so without a home there is no telling if a target is under it.
+func (api *DroneAPI) checkFence(veh *vehicle.Vehicle, lat, lon float64, amsl float32) error {
+  home := veh.GetHome()
+  if home["Latitude"] == 0 && home["Longitude"] == 0 {
+    if f := veh.Fence(); f != nil && f.MaxAltitude > 0 {
+      return fmt.Errorf("Home position is not known yet, so the target can't be checked against the fence altitude.")
+    }
+    return veh.CheckFence(lat, lon, 0)
+  }
+  return veh.CheckFence(lat, lon, amsl - home["Altitude"])
+}
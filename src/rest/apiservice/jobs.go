@@ -0,0 +1,71 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strconv"
+
+  "vehicle"
+  CoreApi "vehicle/api"
+)
+
+// Whether the request asked to get a job back instead of waiting, with
+// ?async=true or "async": true in the body.
+func isAsync(req *http.Request, postData map[string]interface{}) bool {
+  if async, err := strconv.ParseBool(req.URL.Query().Get("async")); err == nil && async {
+    return true
+  }
+  async, _ := postData["async"].(bool)
+  return async
+}
+
+// Replies 202 with the job to poll.
+func (api *DroneAPI) sendJob(job *CoreApi.Job, w *http.ResponseWriter) {
+  (*w).Header().Set("Content-Type", "application/json")
+  (*w).WriteHeader(http.StatusAccepted)
+  json.NewEncoder(*w).Encode(job.Status())
+}
+
+func (api *DroneAPI) handleGetJobs(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  jobs := []CoreApi.JobStatus{}
+  for _, j := range veh.Jobs().All() {
+    jobs = append(jobs, j.Status())
+  }
+  api.SendAPIJSON(jobs, w)
+}
+
+func (api *DroneAPI) handleGetJob(veh *vehicle.Vehicle, id string, w *http.ResponseWriter) {
+  if job := veh.Jobs().Get(id); job == nil {
+    api.SendAPIError(fmt.Errorf("Job not found."), w)
+  } else {
+    api.SendAPIJSON(job.Status(), w)
+  }
+}
+
+func (api *DroneAPI) handleCancelJob(veh *vehicle.Vehicle, id string, w *http.ResponseWriter) {
+  job := veh.Jobs().Get(id)
+  if job == nil {
+    api.SendAPIError(fmt.Errorf("Job not found."), w)
+    return
+  }
+
+  if err := job.Cancel(); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    api.SendAPIJSON(job.Status(), w)
+  }
+}
@@ -0,0 +1,194 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strings"
+
+  "mavlink/parser"
+  "mission"
+  "vehicle"
+  CoreApi "vehicle/api"
+)
+
+func (api *DroneAPI) handleGetMission(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  if mission, err := veh.DownloadMission(); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    api.SendAPIJSON(mission, w)
+  }
+}
+
+//
+// Replaces the mission on the vehicle, ie
+//   {"items": [{"Command": 16, "X": 36.1, "Y": -115.1, "Z": 10}, ...]}
+// Items are numbered in the order given. Frame defaults to
+// MAV_FRAME_GLOBAL_RELATIVE_ALT and Autocontinue to true.
+//
+func (api *DroneAPI) handleUploadMission(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  if postData["items"] == nil {
+    api.SendAPIError(fmt.Errorf("Items are required."), w)
+    return
+  }
+
+  raw, err := json.Marshal(postData["items"])
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  var rawItems []json.RawMessage
+  if err := json.Unmarshal(raw, &rawItems); err != nil {
+    api.SendAPIError(fmt.Errorf("Items must be a list."), w)
+    return
+  }
+
+  items := make([]*CoreApi.MissionItem, len(rawItems))
+  for i, r := range rawItems {
+    items[i] = &CoreApi.MissionItem{
+      Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+      Autocontinue: true,
+    }
+    if err := json.Unmarshal(r, items[i]); err != nil {
+      api.SendAPIError(fmt.Errorf("Mission item %d is invalid.", i), w)
+      return
+    }
+  }
+
+  api.uploadMission(veh, items, w)
+}
+
+// Validates items against common.xml before sending them to the vehicle.
+func (api *DroneAPI) uploadMission(veh *vehicle.Vehicle, items []*CoreApi.MissionItem, w *http.ResponseWriter) {
+  m := &mission.Mission{}
+  for _, item := range items {
+    m.Items = append(m.Items, item.Int())
+  }
+
+  if err := m.Validate(); err != nil {
+    api.SendAPIError(err, w)
+  } else if err := veh.UploadMission(items); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Count"] = len(items)
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleClearMission(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  if err := veh.ClearMission(); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleSetMissionCurrent(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  seq, ok := postData["seq"].(float64)
+  if !ok || seq < 0 || seq > 65535 {
+    api.SendAPIError(fmt.Errorf("Seq is required."), w)
+    return
+  }
+
+  if err := veh.SetMissionCurrent(uint16(seq)); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Current"] = uint16(seq)
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+//
+// Uploads a mission file, ie
+//   {"format": "plan", "data": "<contents of the .plan file>"}
+// Format is one of plan, waypoints or kml. KML points without an altitude
+// are flown at "altitude" meters above home.
+//
+func (api *DroneAPI) handleImportMission(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  format, _ := postData["format"].(string)
+  data, _ := postData["data"].(string)
+  if format == "" || data == "" {
+    api.SendAPIError(fmt.Errorf("Format and data are required."), w)
+    return
+  }
+
+  var alt float32
+  if a, ok := postData["altitude"].(float64); ok {
+    alt = float32(a)
+  }
+
+  m, err := mission.Read(strings.ToLower(format), strings.NewReader(data), alt)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  items := make([]*CoreApi.MissionItem, len(m.Items))
+  for i, item := range m.Items {
+    items[i] = CoreApi.MissionItemFromInt(item)
+  }
+
+  api.uploadMission(veh, items, w)
+}
+
+// Reads the mission back from the vehicle as a file.
+func (api *DroneAPI) handleExportMission(veh *vehicle.Vehicle, format string, w *http.ResponseWriter) {
+  format = strings.ToLower(format)
+  if mission.ContentType(format) == "" {
+    api.SendAPIError(fmt.Errorf("Format must be one of %s.", strings.Join(mission.Formats, ", ")), w)
+    return
+  }
+
+  current, err := veh.DownloadMission()
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  m := &mission.Mission{}
+  for _, item := range current.Items {
+    m.Items = append(m.Items, item.Int())
+  }
+
+  if home, ok := veh.Telem()["Home"].(CoreApi.Home); ok && (home.Latitude != 0 || home.Longitude != 0) {
+    m.Home = &mavlink.MissionItemInt{
+      Command: mavlink.MAV_CMD_NAV_WAYPOINT,
+      Frame: mavlink.MAV_FRAME_GLOBAL,
+      X: mission.EncodeCoord(mavlink.MAV_FRAME_GLOBAL, float64(home.Latitude)),
+      Y: mission.EncodeCoord(mavlink.MAV_FRAME_GLOBAL, float64(home.Longitude)),
+      Z: home.Altitude,
+      Autocontinue: 1,
+    }
+  }
+
+  data, err := mission.Marshal(format, m)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  (*w).Header().Set("Content-Type", mission.ContentType(format))
+  (*w).Header().Set("Content-Disposition", "attachment; filename=\"mission." + format + "\"")
+  (*w).WriteHeader(200)
+  (*w).Write(data)
+}
@@ -0,0 +1,113 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "time"
+
+  "vehicle"
+  CoreApi "vehicle/api"
+)
+
+// Setpoint from a request, see CoreApi.Setpoint for the fields by type.
+func parseSetpoint(postData map[string]interface{}) (*CoreApi.Setpoint, error) {
+  raw, err := json.Marshal(postData)
+  if err != nil {
+    return nil, err
+  }
+
+  var sp CoreApi.Setpoint
+  if err := json.Unmarshal(raw, &sp); err != nil {
+    return nil, fmt.Errorf("Setpoint fields must be numbers.")
+  }
+
+  switch {
+  case sp.Type == CoreApi.SETPOINT_GLOBAL && (postData["lat"] == nil || postData["lon"] == nil):
+    return nil, fmt.Errorf("Lat and lon are required.")
+  case sp.Type == CoreApi.SETPOINT_ATTITUDE && postData["thrust"] == nil:
+    return nil, fmt.Errorf("Thrust is required.")
+  }
+
+  return &sp, nil
+}
+
+func (api *DroneAPI) handleGetOffboard(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  api.SendAPIJSON(veh.Offboard(), w)
+}
+
+//
+// Starts streaming a setpoint and switches to Offboard, ie
+//   {"type": "velocity", "vx": 1, "timeout": 1000}
+// After that, the client has to keep feeding setpoint or heartbeat at least
+// every timeout ms, or the vehicle is put in Hold.
+//
+func (api *DroneAPI) handleStartOffboard(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  sp, err := parseSetpoint(postData)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  var timeout time.Duration
+  if postData["timeout"] != nil {
+    timeout = time.Duration(postData["timeout"].(float64)) * time.Millisecond
+  }
+
+  if cmd, err := veh.StartOffboard(sp, timeout); err != nil {
+    api.SendAPIError(err, w)
+  } else if cmd != nil {
+    api.commandBlock(veh, cmd, postData, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleOffboardSetpoint(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  sp, err := parseSetpoint(postData)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  if err := veh.FeedOffboard(sp); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleOffboardHeartbeat(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  if err := veh.FeedOffboard(nil); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    api.SendAPIJSON(ret, w)
+  }
+}
+
+func (api *DroneAPI) handleStopOffboard(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  if cmd := veh.StopOffboard(); cmd == nil {
+    api.SendAPIError(fmt.Errorf("Offboard is not running."), w)
+  } else {
+    api.commandBlock(veh, cmd, postData, w)
+  }
+}
@@ -0,0 +1,153 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "fmt"
+  "net/http"
+  "sort"
+  "strings"
+
+  "params"
+  "vehicle"
+)
+
+// All of the vehicle's params as a file, to import into others.
+func (api *DroneAPI) handleExportParams(veh *vehicle.Vehicle, format string, w *http.ResponseWriter) {
+  format = strings.ToLower(format)
+  if params.ContentType(format) == "" {
+    api.SendAPIError(fmt.Errorf("Format must be one of %s.", strings.Join(params.Formats, ", ")), w)
+    return
+  }
+
+  snapshot, err := veh.ParamSnapshot()
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  data, err := params.Marshal(format, snapshot)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  (*w).Header().Set("Content-Type", params.ContentType(format))
+  (*w).Header().Set("Content-Disposition", "attachment; filename=\"vehicle." + format + "\"")
+  (*w).WriteHeader(200)
+  (*w).Write(data)
+}
+
+// {"format": "params", "data": "<contents of the .params file>"}
+func readParamsFile(postData map[string]interface{}) (*params.Snapshot, error) {
+  format, _ := postData["format"].(string)
+  data, _ := postData["data"].(string)
+  if format == "" || data == "" {
+    return nil, fmt.Errorf("Format and data are required.")
+  }
+  return params.Read(strings.ToLower(format), strings.NewReader(data))
+}
+
+// Compares a params file against the vehicle, without changing anything.
+func (api *DroneAPI) handleDiffParams(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  file, err := readParamsFile(postData)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  if diff, err := veh.DiffParams(file); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    api.SendAPIJSON(diff, w)
+  }
+}
+
+//
+// Sets the params in a file that differ from the vehicle's. Params the
+// vehicle doesn't have are skipped, and listed as Unknown. Each change has
+// its own result, and with async the import is a job.
+//
+func (api *DroneAPI) handleImportParams(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  file, err := readParamsFile(postData)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  imp, err := veh.ImportParams(file)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  if async, _ := postData["async"].(bool); async {
+    api.sendJob(veh.ParamImportJob(imp), w)
+    return
+  }
+
+  <-imp.Done()
+  ret := imp.Summary()
+  if imp.Failed() > 0 {
+    ret["Status"] = "Failed"
+  } else {
+    ret["Status"] = "OK"
+  }
+  api.SendAPIJSON(ret, w)
+}
+
+//
+// Sets many params at once, ie
+//   {"params": {"MPC_XY_VEL_MAX": 8, "MPC_Z_VEL_MAX_UP": 2}}
+// and replies with how each went. With async the sets are a job.
+//
+func (api *DroneAPI) handleSetParams(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  values, _ := postData["params"].(map[string]interface{})
+  if len(values) == 0 {
+    api.SendAPIError(fmt.Errorf("Params are required."), w)
+    return
+  }
+
+  names := make([]string, 0, len(values))
+  for name := range values {
+    names = append(names, name)
+  }
+  sort.Strings(names)
+
+  changes := make([]vehicle.ParamChange, len(names))
+  for i, name := range names {
+    val, ok := values[name].(float64)
+    if !ok {
+      api.SendAPIError(fmt.Errorf("Param %s must be a number.", name), w)
+      return
+    }
+    changes[i] = vehicle.ParamChange{Name: name, Value: val}
+  }
+
+  batch := veh.SetParams(changes)
+
+  if async, _ := postData["async"].(bool); async {
+    api.sendJob(veh.ParamBatchJob("SetParams", batch, batch.Summary), w)
+    return
+  }
+
+  <-batch.Done()
+  ret := batch.Summary()
+  if batch.Failed() > 0 {
+    ret["Status"] = "Failed"
+  } else {
+    ret["Status"] = "OK"
+  }
+  api.SendAPIJSON(ret, w)
+}
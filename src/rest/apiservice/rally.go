@@ -0,0 +1,69 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+
+  "mission"
+  "vehicle"
+)
+
+func (api *DroneAPI) handleGetRally(veh *vehicle.Vehicle, w *http.ResponseWriter) {
+  points, err := veh.DownloadRally()
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  if points == nil {
+    points = []mission.RallyPoint{}
+  }
+  api.SendAPIJSON(points, w)
+}
+
+//
+// Replaces the rally points, ie
+//   {"points": [{"latitude": 36.1, "longitude": -115.1, "altitude": 30}, ...]}
+// An empty list clears them.
+//
+func (api *DroneAPI) handleSetRally(veh *vehicle.Vehicle, postData map[string]interface{}, w *http.ResponseWriter) {
+  if postData["points"] == nil {
+    api.SendAPIError(fmt.Errorf("Points are required."), w)
+    return
+  }
+
+  raw, err := json.Marshal(postData["points"])
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  var points []mission.RallyPoint
+  if err := json.Unmarshal(raw, &points); err != nil {
+    api.SendAPIError(fmt.Errorf("Points must be a list of latitude, longitude and altitude."), w)
+    return
+  }
+
+  if err := veh.UploadRally(points); err != nil {
+    api.SendAPIError(err, w)
+  } else {
+    ret := make(map[string]interface{})
+    ret["Status"] = "OK"
+    ret["Count"] = len(points)
+    api.SendAPIJSON(ret, w)
+  }
+}
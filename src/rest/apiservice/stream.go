@@ -0,0 +1,308 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package apiservice
+
+import (
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "strconv"
+  "strings"
+  "time"
+
+  "logger"
+  "rest/websocket"
+  "vehicle"
+  CoreApi "vehicle/api"
+)
+
+const (
+  STREAM_DEFAULT_RATE = 5.0   // Hz
+  STREAM_MAX_RATE     = 50.0  // Hz
+
+  streamRefresh = 1 * time.Second // how often a fleet stream looks for new drones
+)
+
+//
+// What a stream client wants. Given in the query string,
+//   /drone/:id/stream?topics=Attitude,Position&rate=10
+// and changed at any time by sending
+//   {"topics": ["Attitude", "Position"], "rate": 10}
+// over the socket. No topics means all of them.
+//
+type streamSub struct {
+  Topics  []string
+  Rate    float64
+}
+
+func (s *streamSub) normalize() error {
+  if s.Rate == 0 {
+    s.Rate = STREAM_DEFAULT_RATE
+  } else if s.Rate < 0 || s.Rate > STREAM_MAX_RATE {
+    return fmt.Errorf("Rate must be between 0 and %v Hz.", STREAM_MAX_RATE)
+  }
+
+  if len(s.Topics) == 0 {
+    s.Topics = CoreApi.TelemTopics
+    return nil
+  }
+
+  // accept any case, but use the names from the telemetry
+  topics := make([]string, 0, len(s.Topics))
+  for _, want := range s.Topics {
+    found := false
+    for _, t := range CoreApi.TelemTopics {
+      if strings.EqualFold(want, t) {
+        topics = append(topics, t)
+        found = true
+        break
+      }
+    }
+    if !found {
+      return fmt.Errorf("Unknown topic %s.", want)
+    }
+  }
+  s.Topics = topics
+  return nil
+}
+
+func (s *streamSub) interval() time.Duration {
+  return time.Duration(float64(time.Second) / s.Rate)
+}
+
+func parseStreamSub(req *http.Request) (streamSub, error) {
+  var sub streamSub
+  q := req.URL.Query()
+
+  if t := q.Get("topics"); t != "" {
+    sub.Topics = strings.Split(t, ",")
+  }
+
+  if r := q.Get("rate"); r != "" {
+    rate, err := strconv.ParseFloat(r, 64)
+    if err != nil {
+      return sub, fmt.Errorf("Rate must be a number.")
+    }
+    sub.Rate = rate
+  }
+
+  return sub, sub.normalize()
+}
+
+// a vehicle being streamed, and what its client last saw
+type streamSource struct {
+  veh     *vehicle.Vehicle
+  watcher *CoreApi.TelemWatcher
+  last    map[string]interface{}
+  fresh   bool // client has not seen anything yet, send all topics
+}
+
+// subscribed topics that changed since they were last sent
+func (src *streamSource) delta(sub *streamSub) map[string]interface{} {
+  changed := src.watcher.Changed()
+  if src.fresh {
+    changed = CoreApi.TelemTopics
+    src.fresh = false
+  }
+
+  var topics []string
+  for _, t := range changed {
+    for _, want := range sub.Topics {
+      if t == want {
+        topics = append(topics, t)
+        break
+      }
+    }
+  }
+  if len(topics) == 0 {
+    return nil
+  }
+
+  delta := make(map[string]interface{})
+  for t, val := range src.veh.TelemTopics(topics) {
+    if last, ok := src.last[t]; !ok || last != val {
+      delta[t] = val
+      src.last[t] = val
+    }
+  }
+  return delta
+}
+
+func (api *DroneAPI) handleStream(veh *vehicle.Vehicle, id string, w *http.ResponseWriter, req *http.Request) {
+  sub, err := parseStreamSub(req)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  conn, err := websocket.Upgrade(*w, req)
+  if err != nil {
+    logger.Warn("Stream upgrade failed:", err)
+    return
+  }
+
+  api.stream(conn, sub, false, func() map[string]*vehicle.Vehicle {
+    return map[string]*vehicle.Vehicle{id: veh}
+  })
+}
+
+func (api *DroneAPI) handleFleetStream(w *http.ResponseWriter, req *http.Request) {
+  sub, err := parseStreamSub(req)
+  if err != nil {
+    api.SendAPIError(err, w)
+    return
+  }
+
+  conn, err := websocket.Upgrade(*w, req)
+  if err != nil {
+    logger.Warn("Stream upgrade failed:", err)
+    return
+  }
+
+  api.stream(conn, sub, true, api.manager.Vehicles)
+}
+
+//
+// Pushes telemetry deltas to conn as the vehicles update, no faster than the
+// client's rate. A single drone sends {"Telem": {topic: value}}, the fleet sends
+// {"Drones": {name: {topic: value}}}.
+//
+func (api *DroneAPI) stream(conn *websocket.Conn, sub streamSub, fleet bool, vehicles func() map[string]*vehicle.Vehicle) {
+  defer conn.Close()
+
+  signal := make(chan struct{}, 1)
+  sources := make(map[string]*streamSource)
+
+  defer func() {
+    for _, src := range sources {
+      src.veh.UnwatchTelem(src.watcher)
+    }
+  }()
+
+  // keep sources in line with the vehicles online
+  syncSources := func() {
+    vehs := vehicles()
+    for name, src := range sources {
+      if vehs[name] != src.veh {
+        src.veh.UnwatchTelem(src.watcher)
+        delete(sources, name)
+      }
+    }
+    for name, veh := range vehs {
+      if _, ok := sources[name]; !ok && veh != nil {
+        src := &streamSource{
+          veh: veh,
+          watcher: CoreApi.NewTelemWatcher(signal),
+          last: make(map[string]interface{}),
+          fresh: true,
+        }
+        veh.WatchTelem(src.watcher)
+        sources[name] = src
+      }
+    }
+  }
+
+  // client messages change the subscription
+  subs := make(chan streamSub)
+  done := make(chan struct{})
+  quit := make(chan struct{})
+  defer close(quit)
+
+  go func() {
+    defer close(done)
+    for {
+      b, err := conn.ReadMessage()
+      if err != nil {
+        return
+      }
+
+      var s streamSub
+      if err := json.Unmarshal(b, &s); err == nil {
+        err = s.normalize()
+      }
+      if err != nil {
+        conn.WriteJSON(map[string]string{"error": err.Error()})
+        continue
+      }
+
+      select {
+      case subs <- s:
+      case <-quit:
+        return
+      }
+    }
+  }()
+
+  refresh := time.NewTicker(streamRefresh)
+  defer refresh.Stop()
+
+  syncSources()
+  var next time.Time
+
+  for {
+    // anything fresh goes out right away
+    send := false
+    for _, src := range sources {
+      send = send || src.fresh
+    }
+
+    if !send {
+      select {
+      case <-done:
+        return
+      case s := <-subs:
+        sub = s
+        for _, src := range sources {
+          src.fresh = true
+        }
+      case <-refresh.C:
+        syncSources()
+        continue
+      case <-signal:
+      }
+    }
+
+    // hold off until the client's rate allows another push
+    if wait := next.Sub(time.Now()); wait > 0 {
+      select {
+      case <-done:
+        return
+      case <-time.After(wait):
+      }
+    }
+    next = time.Now().Add(sub.interval())
+
+    batch := make(map[string]interface{})
+    for name, src := range sources {
+      if delta := src.delta(&sub); len(delta) > 0 {
+        batch[name] = delta
+      }
+    }
+    if len(batch) == 0 {
+      continue
+    }
+
+    var err error
+    if fleet {
+      err = conn.WriteJSON(map[string]interface{}{"Drones": batch})
+    } else {
+      for _, delta := range batch {
+        err = conn.WriteJSON(map[string]interface{}{"Telem": delta})
+      }
+    }
+    if err != nil {
+      return
+    }
+  }
+}
@@ -0,0 +1,240 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+//
+// Server side of RFC 6455, just enough to push JSON to browsers and read
+// small messages back. No extensions, no subprotocols.
+//
+
+package websocket
+
+import (
+  "bufio"
+  "crypto/sha1"
+  "encoding/base64"
+  "encoding/binary"
+  "encoding/json"
+  "errors"
+  "io"
+  "net"
+  "net/http"
+  "strings"
+  "sync"
+)
+
+const (
+  OP_CONTINUATION = 0x0
+  OP_TEXT         = 0x1
+  OP_BINARY       = 0x2
+  OP_CLOSE        = 0x8
+  OP_PING         = 0x9
+  OP_PONG         = 0xA
+
+  MAX_MESSAGE_SIZE = 64 * 1024 // we only expect small control messages from clients
+
+  acceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+)
+
+var (
+  ErrBadHandshake = errors.New("Not a websocket handshake.")
+  ErrTooLarge     = errors.New("Websocket message too large.")
+  ErrUnmasked     = errors.New("Client frames must be masked.")
+)
+
+type Conn struct {
+  conn      net.Conn
+  br        *bufio.Reader
+  writeLock sync.Mutex
+}
+
+func headerContains(h http.Header, name, token string) bool {
+  for _, v := range h[http.CanonicalHeaderKey(name)] {
+    for _, s := range strings.Split(v, ",") {
+      if strings.EqualFold(strings.TrimSpace(s), token) {
+        return true
+      }
+    }
+  }
+  return false
+}
+
+func AcceptKey(key string) string {
+  h := sha1.New()
+  h.Write([]byte(key + acceptGUID))
+  return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+//
+// Takes over the connection of a GET request asking to upgrade. On failure
+// a 400 has already been written.
+//
+func Upgrade(w http.ResponseWriter, req *http.Request) (*Conn, error) {
+  key := req.Header.Get("Sec-WebSocket-Key")
+
+  if req.Method != "GET" || key == "" ||
+    !headerContains(req.Header, "Connection", "upgrade") ||
+    !headerContains(req.Header, "Upgrade", "websocket") ||
+    req.Header.Get("Sec-WebSocket-Version") != "13" {
+    http.Error(w, ErrBadHandshake.Error(), 400)
+    return nil, ErrBadHandshake
+  }
+
+  hj, ok := w.(http.Hijacker)
+  if !ok {
+    http.Error(w, ErrBadHandshake.Error(), 400)
+    return nil, ErrBadHandshake
+  }
+
+  conn, rw, err := hj.Hijack()
+  if err != nil {
+    return nil, err
+  }
+
+  resp := "HTTP/1.1 101 Switching Protocols\r\n" +
+    "Upgrade: websocket\r\n" +
+    "Connection: Upgrade\r\n" +
+    "Sec-WebSocket-Accept: " + AcceptKey(key) + "\r\n\r\n"
+
+  if _, err := conn.Write([]byte(resp)); err != nil {
+    conn.Close()
+    return nil, err
+  }
+
+  return &Conn{conn: conn, br: rw.Reader}, nil
+}
+
+func (c *Conn) writeFrame(op byte, payload []byte) error {
+  c.writeLock.Lock()
+  defer c.writeLock.Unlock()
+
+  hdr := []byte{0x80 | op, 0}
+  switch n := len(payload); {
+  case n < 126:
+    hdr[1] = byte(n)
+  case n <= 0xffff:
+    hdr[1] = 126
+    hdr = append(hdr, 0, 0)
+    binary.BigEndian.PutUint16(hdr[2:], uint16(n))
+  default:
+    hdr[1] = 127
+    hdr = append(hdr, 0, 0, 0, 0, 0, 0, 0, 0)
+    binary.BigEndian.PutUint64(hdr[2:], uint64(n))
+  }
+
+  if _, err := c.conn.Write(append(hdr, payload...)); err != nil {
+    return err
+  }
+  return nil
+}
+
+func (c *Conn) WriteText(b []byte) error {
+  return c.writeFrame(OP_TEXT, b)
+}
+
+func (c *Conn) WriteJSON(v interface{}) error {
+  b, err := json.Marshal(v)
+  if err != nil {
+    return err
+  }
+  return c.WriteText(b)
+}
+
+// reads one frame, unmasking its payload
+func (c *Conn) readFrame() (fin bool, op byte, payload []byte, err error) {
+  var hdr [2]byte
+  if _, err = io.ReadFull(c.br, hdr[:]); err != nil {
+    return
+  }
+
+  fin = hdr[0]&0x80 != 0
+  op = hdr[0] & 0x0f
+
+  if hdr[1]&0x80 == 0 {
+    err = ErrUnmasked
+    return
+  }
+
+  n := uint64(hdr[1] & 0x7f)
+  switch n {
+  case 126:
+    var ext [2]byte
+    if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+      return
+    }
+    n = uint64(binary.BigEndian.Uint16(ext[:]))
+  case 127:
+    var ext [8]byte
+    if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+      return
+    }
+    n = binary.BigEndian.Uint64(ext[:])
+  }
+
+  if n > MAX_MESSAGE_SIZE {
+    err = ErrTooLarge
+    return
+  }
+
+  var mask [4]byte
+  if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+    return
+  }
+
+  payload = make([]byte, n)
+  if _, err = io.ReadFull(c.br, payload); err != nil {
+    return
+  }
+  for i := range payload {
+    payload[i] ^= mask[i%4]
+  }
+  return
+}
+
+//
+// Returns the next text or binary message. Pings are answered here, and
+// io.EOF is returned once the client closes.
+//
+func (c *Conn) ReadMessage() ([]byte, error) {
+  var msg []byte
+
+  for {
+    fin, op, payload, err := c.readFrame()
+    if err != nil {
+      return nil, err
+    }
+
+    switch op {
+    case OP_PING:
+      if err := c.writeFrame(OP_PONG, payload); err != nil {
+        return nil, err
+      }
+    case OP_PONG:
+    case OP_CLOSE:
+      c.writeFrame(OP_CLOSE, payload)
+      return nil, io.EOF
+    case OP_TEXT, OP_BINARY, OP_CONTINUATION:
+      msg = append(msg, payload...)
+      if len(msg) > MAX_MESSAGE_SIZE {
+        return nil, ErrTooLarge
+      }
+      if fin {
+        return msg, nil
+      }
+    }
+  }
+}
+
+func (c *Conn) Close() error {
+  c.writeFrame(OP_CLOSE, nil)
+  return c.conn.Close()
+}
@@ -0,0 +1,109 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package websocket
+
+import (
+  "bufio"
+  "io"
+  "net"
+  "net/http"
+  "net/http/httptest"
+  "strings"
+  "testing"
+)
+
+// client frames have to be masked
+func maskedFrame(op byte, payload string) []byte {
+  mask := []byte{1, 2, 3, 4}
+  frame := []byte{0x80 | op, 0x80 | byte(len(payload))}
+  frame = append(frame, mask...)
+  for i := 0; i < len(payload); i++ {
+    frame = append(frame, payload[i]^mask[i%4])
+  }
+  return frame
+}
+
+func TestAcceptKey(t *testing.T) {
+  // example from RFC 6455
+  if got := AcceptKey("dGhlIHNhbXBsZSBub25jZQ=="); got != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+    t.Errorf("AcceptKey fail, got %q", got)
+  }
+}
+
+func TestEcho(t *testing.T) {
+  srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+    conn, err := Upgrade(w, req)
+    if err != nil {
+      return
+    }
+    defer conn.Close()
+
+    for {
+      msg, err := conn.ReadMessage()
+      if err != nil {
+        return
+      }
+      conn.WriteText(msg)
+    }
+  }))
+  defer srv.Close()
+
+  c, err := net.Dial("tcp", strings.TrimPrefix(srv.URL, "http://"))
+  if err != nil {
+    t.Fatal(err)
+  }
+  defer c.Close()
+
+  c.Write([]byte("GET / HTTP/1.1\r\nHost: test\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+    "Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n\r\n"))
+
+  br := bufio.NewReader(c)
+  resp, err := http.ReadResponse(br, nil)
+  if err != nil {
+    t.Fatal(err)
+  }
+  if resp.StatusCode != 101 || resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+    t.Fatalf("handshake fail, got %d %v", resp.StatusCode, resp.Header)
+  }
+
+  // a ping is answered before the echo
+  c.Write(maskedFrame(OP_PING, "hi"))
+  c.Write(maskedFrame(OP_TEXT, `{"rate":2}`))
+
+  for _, want := range []struct {
+    op      byte
+    payload string
+  }{{OP_PONG, "hi"}, {OP_TEXT, `{"rate":2}`}} {
+    hdr := make([]byte, 2)
+    if _, err := io.ReadFull(br, hdr); err != nil {
+      t.Fatal(err)
+    }
+    payload := make([]byte, hdr[1])
+    if _, err := io.ReadFull(br, payload); err != nil {
+      t.Fatal(err)
+    }
+    if hdr[0] != 0x80|want.op || string(payload) != want.payload {
+      t.Errorf("frame fail, got %x %q, want %x %q", hdr[0], payload, 0x80|want.op, want.payload)
+    }
+  }
+}
+
+func TestBadHandshake(t *testing.T) {
+  w := httptest.NewRecorder()
+  req := httptest.NewRequest("GET", "/", nil)
+
+  if _, err := Upgrade(w, req); err != ErrBadHandshake || w.Code != 400 {
+    t.Errorf("Upgrade expected ErrBadHandshake, got %v, %d", err, w.Code)
+  }
+}
@@ -23,7 +23,6 @@ import (
   "math"
 
   "mavlink/parser"
-  "utils"
 )
 
 //
@@ -160,14 +159,8 @@ type SubSystem struct {
 
 type Param struct {
   Index    uint
-  Value    float32 // Even though params can be different values, we always normalize them to a float.
-  Encode   uint8 // used to encode the param for MAVLink
-}
-
-type VehicleCommand struct {
-  Status    uint
-  TimesSent uint
-  Command   *mavlink.CommandLong
+  Raw      float32 // as sent, see ParamValue
+  Type     uint8 // MAV_PARAM_TYPE
 }
 
 type VehicleLog struct {
@@ -203,6 +196,7 @@ type VehicleApi struct {
   sysId     uint8   // MAVLink Target ID
   fmuId     uint64  // Unique ID generated by FMU
   caps      uint64  // Capbilities Mask
+  autopilot uint8   // MAV_AUTOPILOT
   fmuGit    string  // Git hash for FMU firmware
   gotCaps   bool
   params    map[string]*Param
@@ -210,6 +204,11 @@ type VehicleApi struct {
   paramsRequested bool
   paramForceInit bool
 
+  watchers  map[*TelemWatcher]bool
+  watchLock sync.Mutex
+
+  events    *EventLog
+
   lock      sync.RWMutex
 }
 
@@ -234,6 +233,24 @@ func (v *VehicleApi) GetVehicleTelem() map[string]interface{} {
   return telem
 }
 
+// Like GetVehicleTelem, limited to topics.
+func (v *VehicleApi) GetTelemTopics(topics []string) map[string]interface{} {
+  telem := v.GetVehicleTelem()
+  for _, t := range TelemTopics {
+    found := false
+    for _, want := range topics {
+      if want == t {
+        found = true
+        break
+      }
+    }
+    if !found {
+      delete(telem, t)
+    }
+  }
+  return telem
+}
+
 func (v *VehicleApi) GetGlobal() map[string]float32 {
   v.lock.Lock()
   defer v.lock.Unlock()
@@ -274,6 +291,8 @@ func NewVehicleApi(id string) *VehicleApi {
   api.totalParams = 0
   api.paramsRequested = false
   api.paramForceInit = false
+  api.watchers = make(map[*TelemWatcher]bool)
+  api.events = NewEventLog()
   return api
 }
 
@@ -294,6 +313,7 @@ func (v *VehicleApi) UpdateSubSystem(name string) error {
     if !subsystem.Online {
       subsystem.Online = true
       logger.DroneLog(v.id, "Subsystem", name, "online.")
+      v.events.Publish(EVENT_SUBSYSTEM, SubSystemEvent{name, true})
     }
     subsystem.Updated = time.Now()
     return nil
@@ -320,6 +340,7 @@ func (v *VehicleApi) CheckSubSystems() {
     if (subsystem.Online) && (time.Now().Sub(subsystem.Updated) > 5 * time.Second) {
       subsystem.Online = false
       logger.DroneLog(v.id, "Subsystem", name, "offline.")
+      v.events.Publish(EVENT_SUBSYSTEM, SubSystemEvent{name, false})
     }
   }
 }
@@ -365,6 +386,8 @@ func (v *VehicleApi) CheckSysOnline() {
   if v.status.Online && (time.Now().Sub(v.info.LastUpdate) > 5 * time.Second) {
     v.status.Online = false
     logger.DroneLog(v.id, "FMU Offline")
+    v.events.Publish(EVENT_OFFLINE, nil)
+    v.notify("Status")
   }
 }
 
@@ -376,57 +399,36 @@ func (v *VehicleApi) UpdateFromHeartbeat(m *mavlink.Heartbeat) {
     v.info.LastOnline = time.Now()
     v.status.Online = true
     logger.DroneLog(v.id, "FMU Online")
+    v.events.Publish(EVENT_ONLINE, nil)
   }
 
   v.info.LastUpdate = time.Now()
+  defer v.notify("Info", "Status", "Mode")
 
-  switch m.Type {
-  default: fallthrough
-  case mavlink.MAV_TYPE_GENERIC:        v.info.Type = "Generic Vehicle"
-  case mavlink.MAV_TYPE_FIXED_WING:     v.info.Type = "Fixed Wing"
-  case mavlink.MAV_TYPE_QUADROTOR:      v.info.Type = "Quadrotor"
-  case mavlink.MAV_TYPE_HEXAROTOR:      v.info.Type = "Hexarotor"
-  case mavlink.MAV_TYPE_OCTOROTOR:      v.info.Type = "Octorotor"
-  case mavlink.MAV_TYPE_VTOL_DUOROTOR:  fallthrough
-  case mavlink.MAV_TYPE_VTOL_QUADROTOR: v.info.Type = "VTOL Tailsitter"
-  case mavlink.MAV_TYPE_VTOL_TILTROTOR: v.info.Type = "VTOL Tiltrotor"
-  }
-
-  switch m.Autopilot {
-  default: fallthrough
-  case mavlink.MAV_AUTOPILOT_GENERIC:   v.info.Firmware = "Generic Autopilot"
-  case mavlink.MAV_AUTOPILOT_SLUGS:     v.info.Firmware = "SLUGS"
-  case mavlink.MAV_AUTOPILOT_ARDUPILOTMEGA: v.info.Firmware = "APM"
-  case mavlink.MAV_AUTOPILOT_OPENPILOT: v.info.Firmware = "OpenPilot"
-  case mavlink.MAV_AUTOPILOT_PPZ:       v.info.Firmware = "Paparazzi UAV"
-  case mavlink.MAV_AUTOPILOT_FP:        v.info.Firmware = "FlexiPilot"
-  case mavlink.MAV_AUTOPILOT_PX4:       v.info.Firmware = "PX4"
-  case mavlink.MAV_AUTOPILOT_SMACCMPILOT: v.info.Firmware = "SMACCMPilot"
-  case mavlink.MAV_AUTOPILOT_AUTOQUAD:  v.info.Firmware = "AutoQuad"
-  case mavlink.MAV_AUTOPILOT_ARMAZILA:  v.info.Firmware = "Armazila"
-  case mavlink.MAV_AUTOPILOT_AEROB:     v.info.Firmware = "Aerob"
-  case mavlink.MAV_AUTOPILOT_ASLUAV:    v.info.Firmware = "ASLUAV"
-  }
+  v.info.Type = vehicleTypeName(m.Type)
+  v.info.Firmware = firmwareName(m.Autopilot)
+  v.autopilot = m.Autopilot
 
   v.info.Protocol = "MAVLink v" + strconv.Itoa(int(m.MavlinkVersion))
 
-  switch m.SystemStatus {
-  default: fallthrough
-  case mavlink.MAV_STATE_UNINIT:        v.status.State = "Unknown"
-  case mavlink.MAV_STATE_BOOT:          v.status.State = "Initializing"
-  case mavlink.MAV_STATE_CALIBRATING:   v.status.State = "Calibrating"
-  case mavlink.MAV_STATE_STANDBY:       v.status.State = "Standby"
-  case mavlink.MAV_STATE_ACTIVE:        v.status.State = "Active"
-  case mavlink.MAV_STATE_CRITICAL:      v.status.State = "Failsafe"
-  case mavlink.MAV_STATE_EMERGENCY:     v.status.State = "Mayday"
-  case mavlink.MAV_STATE_POWEROFF:      v.status.State = "Powering Down"
-  }
-
-  if v.status.State == "Active" {
-    v.status.Armed = true
-  } else {
-    v.status.Armed = false
+  v.status.State = stateName(m.SystemStatus)
+
+  armed := m.SystemStatus == mavlink.MAV_STATE_ACTIVE
+  if armed != v.status.Armed {
+    if armed {
+      v.events.Publish(EVENT_ARMED, nil)
+    } else {
+      v.events.Publish(EVENT_DISARMED, nil)
+    }
   }
+  v.status.Armed = armed
+
+  prevMode := v.mode
+  defer func() {
+    if v.mode != prevMode {
+      v.events.Publish(EVENT_MODE, ModeEvent{string(v.mode), string(prevMode)})
+    }
+  }()
 
   if m.CustomMode & 0x00FF0000 == 0x010000 {
     v.mode = "Manual"
@@ -467,6 +469,7 @@ func (v *VehicleApi) UpdateFromStatus(m *mavlink.SysStatus) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Status")
 
   v.status.Power = uint(m.BatteryRemaining)
 }
@@ -475,6 +478,7 @@ func (v *VehicleApi) UpdateFromGps(m *mavlink.GpsRawInt) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Gps")
 
   v.gps.Satellites = uint(m.SatellitesVisible)
   v.gps.Altitude = float32(m.Alt) / 1000.0
@@ -486,6 +490,7 @@ func (v *VehicleApi) UpdateFromAttitude(m *mavlink.Attitude) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Attitude")
 
   v.attitude.Roll = m.Roll * (180.0 / math.Pi)
   v.attitude.Pitch = m.Pitch * (180.0 / math.Pi)
@@ -496,6 +501,7 @@ func (v *VehicleApi) UpdateFromLocalPos(m *mavlink.LocalPositionNed) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Position")
 
   v.position.X = m.X
   v.position.Y = m.Y
@@ -506,6 +512,7 @@ func (v *VehicleApi) UpdateFromGlobalPos(m *mavlink.GlobalPositionInt) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Position")
 
   v.position.Latitude = float32(m.Lat) / 1e7
   v.position.Longitude = float32(m.Lon) / 1e7
@@ -517,6 +524,7 @@ func (v *VehicleApi) UpdateFromMotors(m *mavlink.ServoOutputRaw) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Motors")
 
   v.motors[0] = m.Servo1Raw
   v.motors[1] = m.Servo2Raw
@@ -532,6 +540,7 @@ func (v *VehicleApi) UpdateFromInput(m *mavlink.RcChannels) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Input")
 
   v.input.Channels[0] = m.Chan1Raw
   v.input.Channels[1] = m.Chan2Raw
@@ -559,6 +568,7 @@ func (v *VehicleApi) UpdateFromVfr(m *mavlink.VfrHud) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Rates")
 
   v.rates.Airspeed = m.Airspeed
   v.rates.Groundspeed = m.Groundspeed
@@ -570,6 +580,7 @@ func (v *VehicleApi) UpdateFromSensors(m *mavlink.HighresImu) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Sensors")
 
   v.sensors.AccX = m.Xacc
   v.sensors.AccY = m.Yacc
@@ -588,6 +599,7 @@ func (v *VehicleApi) UpdateFromAttitudeTarget(m *mavlink.AttitudeTarget) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Target")
 
   v.target.Attitude[0] = m.Q[0]
   v.target.Attitude[1] = m.Q[1]
@@ -600,6 +612,7 @@ func (v *VehicleApi) UpdateFromLocalTarget(m *mavlink.PositionTargetLocalNed) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Target")
 
   v.target.X = m.X
   v.target.Y = m.Y
@@ -610,6 +623,7 @@ func (v *VehicleApi) UpdateFromGlobalTarget(m *mavlink.PositionTargetGlobalInt)
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Target")
 
   v.target.Latitude = float32(m.LatInt) / 1e7
   v.target.Longitude = float32(m.LonInt) / 1e7
@@ -620,6 +634,7 @@ func (v *VehicleApi) UpdateFromHome(m *mavlink.HomePosition) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Home")
 
   v.home.X = m.X
   v.home.Y = m.Y
@@ -633,6 +648,7 @@ func (v *VehicleApi) UpdateFromExtSys(m *mavlink.ExtendedSysState) {
   v.lock.Lock()
   defer v.lock.Unlock()
   v.info.LastUpdate = time.Now()
+  defer v.notify("Status")
 
   switch m.VtolState {
   default: fallthrough
@@ -655,6 +671,12 @@ func (v *VehicleApi) UpdateFromExtSys(m *mavlink.ExtendedSysState) {
 // Command and control
 //
 
+func (v *VehicleApi) GetFmuId() uint64 {
+  v.lock.RLock()
+  defer v.lock.RUnlock()
+  return v.fmuId
+}
+
 func (v *VehicleApi) GetSystemId() uint8 {
   v.lock.RLock()
   defer v.lock.RUnlock()
@@ -685,6 +707,23 @@ func (v *VehicleApi) PackComandLong(Op uint16, params [7]float32) *mavlink.Comma
   return c
 }
 
+// x and y are as in the frame, ie degE7 for global frames.
+func (v *VehicleApi) PackCommandInt(Op uint16, frame uint8, params [4]float32, x, y int32, z float32) *mavlink.CommandInt {
+  return &mavlink.CommandInt{
+    Param1: params[0],
+    Param2: params[1],
+    Param3: params[2],
+    Param4: params[3],
+    X: x,
+    Y: y,
+    Z: z,
+    Command: Op,
+    TargetSystem: v.GetSystemId(),
+    TargetComponent: 0,
+    Frame: frame,
+  }
+}
+
 func (v *VehicleApi) RequestVehicleInfo() *mavlink.CommandLong {
   return v.PackComandLong(
     mavlink.MAV_CMD_REQUEST_AUTOPILOT_CAPABILITIES,
@@ -716,6 +755,13 @@ func (v *VehicleApi) UpdateFromAutopilotVersion(m *mavlink.AutopilotVersion) {
 }
 
 func (v *VehicleApi) CheckCapability(cap uint64) bool {
+  v.lock.RLock()
+  defer v.lock.RUnlock()
+  return v.hasCapability(cap)
+}
+
+// CheckCapability, for callers already holding the lock.
+func (v *VehicleApi) hasCapability(cap uint64) bool {
   return (v.caps & cap) > 0
 }
 
@@ -726,83 +772,72 @@ func (v *VehicleApi) PrintCapabilities() {
   logger.DroneLog(v.id, "Firmware:", v.info.Firmware)
   logger.DroneLog(v.id, "Version:", v.fmuGit)
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_MISSION_FLOAT) {
     logger.DroneLog(v.id, "\tCOMMAND LONG SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_FLOAT) {
     logger.DroneLog(v.id, "\tFLOAT PARAMS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_MISSION_INT) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_MISSION_INT) {
     logger.DroneLog(v.id, "\tMISSION INT SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_COMMAND_INT) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_COMMAND_INT) {
     logger.DroneLog(v.id, "\tCOMMAND INT SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_UNION) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_UNION) {
     logger.DroneLog(v.id, "\tUNION PARAMS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_FTP) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_FTP) {
     logger.DroneLog(v.id, "\tFTP FROM NONVOLATILE STORAGE SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_ATTITUDE_TARGET) {
     logger.DroneLog(v.id, "\tATTITUDE TARGET SETPOINTS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_LOCAL_NED) {
     logger.DroneLog(v.id, "\tLOCAL POSITION TARGET SETPOINTS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_POSITION_TARGET_GLOBAL_INT) {
     logger.DroneLog(v.id, "\tGLOBAL POSITION TARGET SETPOINTS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_TERRAIN) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_TERRAIN) {
     logger.DroneLog(v.id, "\tTERRAIN ESTIMATION SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_SET_ACTUATOR_TARGET) {
     logger.DroneLog(v.id, "\tMOTOR TARGET SETPOINTS SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_FLIGHT_TERMINATION) {
     logger.DroneLog(v.id, "\tFLIGHT TERMINATION SUPPORTED")
   }
 
-  if v.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION) {
+  if v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_COMPASS_CALIBRATION) {
     logger.DroneLog(v.id, "\tCOMPASS CALIBRATION SUPPORTED")
   }
 }
 
-func (v *VehicleApi) UpdateFromAck(m *mavlink.CommandAck, queue *utils.PQueue) {
-  if queue.Size() > 0 {
-    cmdInt, pri := queue.Head()
-    cmd := cmdInt.(*VehicleCommand)
+func (v *VehicleApi) UpdateFromAck(m *mavlink.CommandAck) {
+  logger.DroneLog(v.id, "Command", mavlink.MavCmd(m.Command), "result:", mavlink.MavResult(m.Result))
+  v.events.Publish(EVENT_COMMAND, CommandEvent{m.Command, mavlink.MavCmd(m.Command).String(),
+    int(m.Result), CommandStatus(int(m.Result))})
+}
 
-    // Only update if they are the same command.
-    if pri == int(m.Command) {
-      cmd.Status = uint(m.Result)
-    }
-  }
+// Publish a command that was given up on without an ack.
+func (v *VehicleApi) CommandTimedOut(cmd uint16) {
+  v.events.Publish(EVENT_COMMAND, CommandEvent{cmd, mavlink.MavCmd(cmd).String(), -1, "Command timed out."})
+}
 
-  switch m.Result {
-  case mavlink.MAV_RESULT_ACCEPTED:
-    logger.DroneLog(v.id, "Command Accepted:", m.Command)
-  case mavlink.MAV_RESULT_TEMPORARILY_REJECTED:
-    logger.DroneLog(v.id, "Command Rejected:", m.Command)
-  case mavlink.MAV_RESULT_DENIED:
-    logger.DroneLog(v.id, "Command Cannot be completed:", m.Command)
-  default: fallthrough
-  case mavlink.MAV_RESULT_UNSUPPORTED:
-    logger.DroneLog(v.id, "Command Unknown:", m.Command)
-  case mavlink.MAV_RESULT_FAILED:
-    logger.DroneLog(v.id, "Tried to execute command, but failed", m.Command)
-  }
+func (v *VehicleApi) Events() *EventLog {
+  return v.events
 }
 
 func (v *VehicleApi) PackAttPosMocap(q [4]float32, x, y, z float32) *mavlink.AttPosMocap {
@@ -830,49 +865,81 @@ func (v *VehicleApi) RequestParam(param uint) *mavlink.ParamRequestRead {
   }
 }
 
-func (v *VehicleApi) GetParam(param string) (float32, error) {
+// Asks for a param by name rather than index.
+func (v *VehicleApi) RequestNamedParam(name string) *mavlink.ParamRequestRead {
+  var id [16]byte
+  copy(id[:], name)
+  return &mavlink.ParamRequestRead{
+    ParamIndex: -1,
+    TargetSystem: v.GetSystemId(),
+    ParamId: id,
+  }
+}
+
+// Whether param values are sent as unions, rather than cast to floats.
+// PX4 does, even before it has told us its capabilities.
+func (v *VehicleApi) paramUnion() bool {
+  return v.hasCapability(mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_UNION) ||
+    v.autopilot == mavlink.MAV_AUTOPILOT_PX4
+}
+
+func (v *VehicleApi) paramValue(p *Param) ParamValue {
+  return DecodeParam(p.Raw, p.Type, v.paramUnion())
+}
+
+func (v *VehicleApi) GetParam(param string) (ParamValue, error) {
   v.lock.RLock()
   defer v.lock.RUnlock()
 
   if p, f := v.params[param]; f {
-    return p.Value, nil
+    return v.paramValue(p), nil
   } else {
-    return 0.0, fmt.Errorf("Param not found.")
+    return ParamValue{}, fmt.Errorf("Param not found.")
   }
 }
 
-func (v *VehicleApi) GetParamIndex(id uint) (float32, error) {
+func (v *VehicleApi) GetParamIndex(id uint) (ParamValue, error) {
   v.lock.RLock()
   defer v.lock.RUnlock()
 
   for _, e := range v.params {
     if e.Index == id {
-      return e.Value, nil
+      return v.paramValue(e), nil
+    }
+  }
+
+  return ParamValue{}, fmt.Errorf("Param not found.")
+}
+
+func (v *VehicleApi) ParamNameAt(id uint) (string, error) {
+  v.lock.RLock()
+  defer v.lock.RUnlock()
+
+  for s, e := range v.params {
+    if e.Index == id {
+      return s, nil
     }
   }
 
-  return 0.0, fmt.Errorf("Param not found.")
+  return "", fmt.Errorf("Param not found.")
 }
 
-func (v *VehicleApi) SetParam(param string, value float32) *mavlink.ParamSet {
+func (v *VehicleApi) SetParam(param string, value ParamValue) *mavlink.ParamSet {
   // convert to [16]byte
   var uid [16]byte = [16]byte{0}
   for i := 0; i < len(param); i += 1 {
     uid[i] = param[i]
   }
 
-  var enc uint8
-  for k, e := range v.params {
-    if k == param {
-      enc = e.Encode
-    }
-  }
+  v.lock.RLock()
+  union := v.paramUnion()
+  v.lock.RUnlock()
 
   return &mavlink.ParamSet{
-    value,
+    value.Encode(union),
     v.GetSystemId(), 0,
     uid,
-    enc,
+    value.Type,
   }
 }
 
@@ -891,18 +958,47 @@ func (v *VehicleApi) ResetParams() {
   v.params = make(map[string]*Param)
 }
 
-func (v *VehicleApi) AllParams() (uint, map[string]float32) {
+func (v *VehicleApi) AllParams() (uint, map[string]ParamValue) {
   v.lock.RLock()
   defer v.lock.RUnlock()
 
-  vals := make(map[string]float32)
+  vals := make(map[string]ParamValue)
   for s, e := range v.params {
-    vals[s] = e.Value
+    vals[s] = v.paramValue(e)
   }
 
   return v.totalParams, vals
 }
 
+// Every param as the vehicle sent it, to be restored later.
+func (v *VehicleApi) RawParams() (uint, map[string]Param) {
+  v.lock.RLock()
+  defer v.lock.RUnlock()
+
+  raw := make(map[string]Param)
+  for s, e := range v.params {
+    raw[s] = *e
+  }
+
+  return v.totalParams, raw
+}
+
+// Replaces the params with ones the vehicle sent before, as if it had just
+// sent them all.
+func (v *VehicleApi) RestoreParams(total uint, raw map[string]Param) {
+  v.lock.Lock()
+  defer v.lock.Unlock()
+
+  v.params = make(map[string]*Param)
+  for s, e := range raw {
+    p := e
+    v.params[s] = &p
+  }
+  v.totalParams = total
+  v.paramsRequested = true
+  v.paramForceInit = false
+}
+
 func (v *VehicleApi) CheckParams() (uint, map[uint]bool) {
   v.lock.RLock()
   defer v.lock.RUnlock()
@@ -942,20 +1038,32 @@ func (v *VehicleApi) UpdateFromParam(m *mavlink.ParamValue) {
 
   v.totalParams = uint(m.ParamCount)
 
-  // we need to deep copy the param string, to avoid copying over nils
-  str := ""
-  for _, c := range m.ParamId {
-    if c == 0 {
-      break
-    }
-    str += string(c)
-  }
+  str := ParamName(m.ParamId)
 
   // log.Println(m.ParamIndex, str)
 
+  index := uint(m.ParamIndex)
+  if old, found := v.params[str]; found && m.ParamIndex == 65535 {
+    // echoes of a PARAM_SET may not say where the param is
+    index = old.Index
+  }
+
   v.params[str] = &Param{
-    uint(m.ParamIndex),
+    index,
     m.ParamValue,
     m.ParamType,
   }
 }
+
+// The param id as a string, without its padding.
+func ParamName(id [16]byte) string {
+  // we need to deep copy the param string, to avoid copying over nils
+  str := ""
+  for _, c := range id {
+    if c == 0 {
+      break
+    }
+    str += string(c)
+  }
+  return str
+}
@@ -0,0 +1,163 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "sync"
+  "time"
+
+  "mavlink/parser"
+)
+
+const (
+  COMMAND_PENDING     = 10 // Must be greater than the MAV_RESULTs
+  COMMAND_TIMEOUT     = -1
+  COMMAND_SEND_FAILED = -2
+  COMMAND_CANCELLED   = -3
+  COMMAND_EXPIRED     = -4 // waited too long to be sent
+  COMMAND_PREEMPTED   = -5 // replaced before it was acked
+)
+
+//
+// How a command went. Result is a MAV_RESULT, or one of the COMMAND_ codes
+// above. Progress is only meaningful while the result is IN_PROGRESS.
+//
+type CommandResult struct {
+  Command   uint16
+  Result    int
+  Progress  uint8
+  Status    string
+}
+
+//
+// A command on its way to the vehicle. It resolves exactly once: with its own
+// COMMAND_ACK, when we give up waiting for one, or when it couldn't be sent.
+// IN_PROGRESS acks update it without resolving it.
+//
+type VehicleCommand struct {
+  Command   *mavlink.CommandLong
+  Int       *mavlink.CommandInt // sent instead, if set and the vehicle takes COMMAND_INT
+
+  lock      sync.Mutex
+  result    CommandResult
+  updated   time.Time // last progress ack
+  done      chan struct{}
+}
+
+func NewVehicleCommand(cmd *mavlink.CommandLong) *VehicleCommand {
+  return &VehicleCommand{
+    Command: cmd,
+    result: CommandResult{cmd.Command, COMMAND_PENDING, 0, CommandStatus(COMMAND_PENDING)},
+    done: make(chan struct{}),
+  }
+}
+
+func CommandStatus(result int) string {
+  switch result {
+  case COMMAND_PENDING:
+    return "Command pending."
+  case COMMAND_TIMEOUT:
+    return "Command timed out."
+  case COMMAND_SEND_FAILED:
+    return "Command could not be sent."
+  case COMMAND_CANCELLED:
+    return "Command cancelled."
+  case COMMAND_EXPIRED:
+    return "Command expired before it could be sent."
+  case COMMAND_PREEMPTED:
+    return "Command preempted by a newer one."
+  case mavlink.MAV_RESULT_IN_PROGRESS:
+    return "Command in progress."
+  }
+  return CommandResultName(result)
+}
+
+// Closed once the command is resolved.
+func (c *VehicleCommand) Done() <-chan struct{} {
+  return c.done
+}
+
+// Latest state of the command, resolved or not.
+func (c *VehicleCommand) Result() CommandResult {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+  return c.result
+}
+
+// Waits up to timeout for the command to resolve. Returns the latest state
+// either way, and whether it was resolved.
+func (c *VehicleCommand) Wait(timeout time.Duration) (CommandResult, bool) {
+  if c.resolved() {
+    return c.Result(), true
+  }
+
+  select {
+  case <-c.done:
+    return c.Result(), true
+  case <-time.After(timeout):
+    return c.Result(), false
+  }
+}
+
+// Whether the vehicle is working on it, and when it last said so.
+func (c *VehicleCommand) InProgress() (bool, time.Time) {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+  return c.result.Result == mavlink.MAV_RESULT_IN_PROGRESS, c.updated
+}
+
+// Applies an ack for this command. Returns true if it resolved the command.
+func (c *VehicleCommand) Ack(m *mavlink.CommandAck) bool {
+  if m.Result == mavlink.MAV_RESULT_IN_PROGRESS {
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    if c.resolved() {
+      return false
+    }
+    c.updated = time.Now()
+    c.result.Result = int(m.Result)
+    c.result.Progress = m.Progress
+    c.result.Status = CommandStatus(int(m.Result))
+    return false
+  }
+  return c.resolve(int(m.Result))
+}
+
+// Gives up on the command with a COMMAND_ code.
+func (c *VehicleCommand) Fail(code int) bool {
+  return c.resolve(code)
+}
+
+func (c *VehicleCommand) resolve(result int) bool {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  if c.resolved() {
+    return false
+  }
+
+  c.result.Result = result
+  c.result.Status = CommandStatus(result)
+  close(c.done)
+  return true
+}
+
+func (c *VehicleCommand) resolved() bool {
+  select {
+  case <-c.done:
+    return true
+  default:
+    return false
+  }
+}
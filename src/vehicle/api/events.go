@@ -0,0 +1,143 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "sync"
+  "time"
+)
+
+const (
+  EVENT_HISTORY = 200 // events kept for replay
+  EVENT_BUFFER  = 64  // events a subscriber may fall behind before it is dropped
+
+  EVENT_STATUSTEXT = "statustext" // Data is *VehicleLog
+  EVENT_ONLINE     = "online"
+  EVENT_OFFLINE    = "offline"
+  EVENT_SUBSYSTEM  = "subsystem"  // Data is SubSystemEvent
+  EVENT_ARMED      = "armed"
+  EVENT_DISARMED   = "disarmed"
+  EVENT_MODE       = "mode"       // Data is ModeEvent
+  EVENT_COMMAND    = "command"    // Data is CommandEvent
+  EVENT_OFFBOARD   = "offboard"   // Data is OffboardEvent
+)
+
+type VehicleEvent struct {
+  Id        uint64
+  Event     string
+  Time      time.Time
+  Data      interface{} `json:",omitempty"`
+}
+
+type SubSystemEvent struct {
+  Name      string
+  Online    bool
+}
+
+type ModeEvent struct {
+  Mode      string
+  Previous  string
+}
+
+type CommandEvent struct {
+  Command   uint16
+  Name      string
+  Result    int // MAV_RESULT, or -1 if the vehicle never answered
+  Status    string
+}
+
+//
+// Bounded history of vehicle events, with any number of subscribers.
+// Ids increase by one per event, so a subscriber that drops out can pick
+// up where it left off as long as the history still reaches back that far.
+//
+type EventLog struct {
+  lock      sync.Mutex
+  history   []*VehicleEvent
+  lastId    uint64
+  subs      map[chan *VehicleEvent]bool
+}
+
+func NewEventLog() *EventLog {
+  return &EventLog{subs: make(map[chan *VehicleEvent]bool)}
+}
+
+func (l *EventLog) Publish(event string, data interface{}) {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+
+  l.lastId++
+  e := &VehicleEvent{l.lastId, event, time.Now(), data}
+
+  l.history = append(l.history, e)
+  if len(l.history) > EVENT_HISTORY {
+    l.history = l.history[len(l.history)-EVENT_HISTORY:]
+  }
+
+  for c := range l.subs {
+    select {
+    case c <- e:
+    default:
+      // too slow, it can reconnect and replay from its last id
+      close(c)
+      delete(l.subs, c)
+    }
+  }
+}
+
+// Events after id still in the history. An id we have not handed out yet
+// (ie from before a restart) gets the whole history.
+func (l *EventLog) Since(id uint64) []*VehicleEvent {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+  return l.since(id)
+}
+
+func (l *EventLog) since(id uint64) []*VehicleEvent {
+  if id > l.lastId {
+    id = 0
+  }
+
+  var events []*VehicleEvent
+  for _, e := range l.history {
+    if e.Id > id {
+      events = append(events, e)
+    }
+  }
+  return events
+}
+
+//
+// Returns the events after lastId, and a channel carrying everything
+// published from then on. The channel is closed if the subscriber falls
+// too far behind.
+//
+func (l *EventLog) Subscribe(lastId uint64) ([]*VehicleEvent, chan *VehicleEvent) {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+
+  c := make(chan *VehicleEvent, EVENT_BUFFER)
+  l.subs[c] = true
+  return l.since(lastId), c
+}
+
+func (l *EventLog) Unsubscribe(c chan *VehicleEvent) {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+
+  if l.subs[c] {
+    close(c)
+    delete(l.subs, c)
+  }
+}
@@ -0,0 +1,71 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "testing"
+)
+
+func TestEventReplay(t *testing.T) {
+  l := NewEventLog()
+
+  for i := 0; i < EVENT_HISTORY + 10; i++ {
+    l.Publish(EVENT_MODE, nil)
+  }
+
+  if all := l.Since(0); len(all) != EVENT_HISTORY || all[0].Id != 11 {
+    t.Fatalf("history fail, got %d events", len(all))
+  }
+
+  replay, c := l.Subscribe(EVENT_HISTORY + 5)
+  defer l.Unsubscribe(c)
+
+  if len(replay) != 5 || replay[0].Id != EVENT_HISTORY + 6 {
+    t.Errorf("replay fail, got %d events", len(replay))
+  }
+
+  // ids from before a restart replay everything
+  if replay := l.Since(1000); len(replay) != EVENT_HISTORY {
+    t.Errorf("unknown id replay fail, got %d events", len(replay))
+  }
+
+  l.Publish(EVENT_ARMED, nil)
+  if e := <-c; e.Event != EVENT_ARMED || e.Id != EVENT_HISTORY + 11 {
+    t.Errorf("subscribe fail, got %v", e)
+  }
+}
+
+func TestEventSlowSubscriber(t *testing.T) {
+  l := NewEventLog()
+
+  _, slow := l.Subscribe(0)
+  _, fast := l.Subscribe(0)
+  defer l.Unsubscribe(fast)
+
+  for i := 0; i < EVENT_BUFFER + 1; i++ {
+    l.Publish(EVENT_STATUSTEXT, nil)
+    <-fast
+  }
+
+  n := 0
+  for range slow {
+    n++
+  }
+  if n != EVENT_BUFFER {
+    t.Errorf("slow subscriber expected %d events before close, got %d", EVENT_BUFFER, n)
+  }
+
+  // already dropped, must not panic
+  l.Unsubscribe(slow)
+}
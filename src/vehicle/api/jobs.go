@@ -0,0 +1,148 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "fmt"
+  "strconv"
+  "sync"
+  "time"
+
+  "mavlink/parser"
+)
+
+const (
+  JOB_HISTORY = 100 // jobs kept for polling
+
+  JOB_PENDING   = "Pending"
+  JOB_RUNNING   = "Running"
+  JOB_DONE      = "Done"
+  JOB_FAILED    = "Failed"
+  JOB_CANCELLED = "Cancelled"
+)
+
+//
+// Endpoint: /drone/:name/jobs/:job
+//
+type JobStatus struct {
+  Id        string
+  Name      string
+  State     string
+  Created   time.Time
+  Result    interface{} `json:",omitempty"`
+}
+
+//
+// Something started by a request that may take longer than the request
+// should. Its state is read off whatever it is waiting on, each time it is
+// asked for.
+//
+type Job struct {
+  Id        string
+  Name      string
+  Created   time.Time
+
+  status    func() (string, interface{})
+  cancel    func() bool // nil if it can't be
+}
+
+func (j *Job) Status() JobStatus {
+  state, result := j.status()
+  return JobStatus{j.Id, j.Name, state, j.Created, result}
+}
+
+func (j *Job) Finished() bool {
+  state, _ := j.status()
+  return state != JOB_PENDING && state != JOB_RUNNING
+}
+
+func (j *Job) Cancel() error {
+  if j.cancel == nil {
+    return fmt.Errorf("Job can't be cancelled.")
+  } else if j.Finished() {
+    return fmt.Errorf("Job already finished.")
+  } else if !j.cancel() {
+    return fmt.Errorf("Job can no longer be cancelled.")
+  }
+  return nil
+}
+
+//
+// The most recent jobs of a vehicle, by id.
+//
+type JobList struct {
+  lock      sync.Mutex
+  jobs      []*Job
+  lastId    uint64
+}
+
+func NewJobList() *JobList {
+  return &JobList{}
+}
+
+func (l *JobList) Add(name string, status func() (string, interface{}), cancel func() bool) *Job {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+
+  l.lastId++
+  j := &Job{strconv.FormatUint(l.lastId, 10), name, time.Now(), status, cancel}
+
+  l.jobs = append(l.jobs, j)
+  if len(l.jobs) > JOB_HISTORY {
+    l.jobs = l.jobs[len(l.jobs) - JOB_HISTORY:]
+  }
+
+  return j
+}
+
+// Job with id, or nil if there never was one or it has been forgotten.
+func (l *JobList) Get(id string) *Job {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+
+  for _, j := range l.jobs {
+    if j.Id == id {
+      return j
+    }
+  }
+  return nil
+}
+
+// Jobs still known, oldest first.
+func (l *JobList) All() []*Job {
+  l.lock.Lock()
+  defer l.lock.Unlock()
+  return append([]*Job(nil), l.jobs...)
+}
+
+// A job for a single command, following its ack.
+func (l *JobList) AddCommand(cmd *VehicleCommand, cancel func() bool) *Job {
+  status := func() (string, interface{}) {
+    res := cmd.Result()
+
+    switch res.Result {
+    case COMMAND_PENDING:
+      return JOB_PENDING, res
+    case mavlink.MAV_RESULT_IN_PROGRESS:
+      return JOB_RUNNING, res
+    case mavlink.MAV_RESULT_ACCEPTED:
+      return JOB_DONE, res
+    case COMMAND_CANCELLED:
+      return JOB_CANCELLED, res
+    }
+    return JOB_FAILED, res
+  }
+
+  return l.Add(mavlink.MavCmd(cmd.Command.Command).String(), status, cancel)
+}
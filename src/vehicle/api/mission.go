@@ -0,0 +1,87 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "mavlink/parser"
+  "mission"
+)
+
+//
+// Endpoint /drone/:name/mission
+//
+// One mission item, as a MISSION_ITEM_INT but with X and Y in plain units:
+// degrees for global frames, meters for local ones.
+//
+type MissionItem struct {
+  Seq           uint16
+  Command       uint16
+  Frame         uint8
+  Current       bool
+  Autocontinue  bool
+  Param1        float32
+  Param2        float32
+  Param3        float32
+  Param4        float32
+  X             float64
+  Y             float64
+  Z             float32
+}
+
+type Mission struct {
+  Current   uint16 // active item, per MISSION_CURRENT
+  Items     []*MissionItem
+}
+
+func boolToUint8(b bool) uint8 {
+  if b {
+    return 1
+  }
+  return 0
+}
+
+// As a MISSION_ITEM_INT, without a target.
+func (item *MissionItem) Int() *mavlink.MissionItemInt {
+  return &mavlink.MissionItemInt{
+    Param1: item.Param1,
+    Param2: item.Param2,
+    Param3: item.Param3,
+    Param4: item.Param4,
+    X: mission.EncodeCoord(item.Frame, item.X),
+    Y: mission.EncodeCoord(item.Frame, item.Y),
+    Z: item.Z,
+    Seq: item.Seq,
+    Command: item.Command,
+    Frame: item.Frame,
+    Current: boolToUint8(item.Current),
+    Autocontinue: boolToUint8(item.Autocontinue),
+  }
+}
+
+func MissionItemFromInt(m *mavlink.MissionItemInt) *MissionItem {
+  return &MissionItem{
+    Seq: m.Seq,
+    Command: m.Command,
+    Frame: m.Frame,
+    Current: m.Current != 0,
+    Autocontinue: m.Autocontinue != 0,
+    Param1: m.Param1,
+    Param2: m.Param2,
+    Param3: m.Param3,
+    Param4: m.Param4,
+    X: mission.DecodeCoord(m.Frame, m.X),
+    Y: mission.DecodeCoord(m.Frame, m.Y),
+    Z: m.Z,
+  }
+}
@@ -0,0 +1,96 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+//
+// Names the API gives vehicle types, autopilots, states and command results.
+// Clients compare these, so they are kept here instead of coming from the
+// generated String and Description, which follow the MAVLink definitions and
+// change with them.
+//
+
+package api
+
+import (
+  "mavlink/parser"
+)
+
+var vehicleTypeNames = map[uint8]string{
+  mavlink.MAV_TYPE_FIXED_WING:     "Fixed Wing",
+  mavlink.MAV_TYPE_QUADROTOR:      "Quadrotor",
+  mavlink.MAV_TYPE_HEXAROTOR:      "Hexarotor",
+  mavlink.MAV_TYPE_OCTOROTOR:      "Octorotor",
+  mavlink.MAV_TYPE_VTOL_DUOROTOR:  "VTOL Tailsitter",
+  mavlink.MAV_TYPE_VTOL_QUADROTOR: "VTOL Tailsitter",
+  mavlink.MAV_TYPE_VTOL_TILTROTOR: "VTOL Tiltrotor",
+}
+
+var firmwareNames = map[uint8]string{
+  mavlink.MAV_AUTOPILOT_SLUGS:         "SLUGS",
+  mavlink.MAV_AUTOPILOT_ARDUPILOTMEGA: "APM",
+  mavlink.MAV_AUTOPILOT_OPENPILOT:     "OpenPilot",
+  mavlink.MAV_AUTOPILOT_PPZ:           "Paparazzi UAV",
+  mavlink.MAV_AUTOPILOT_FP:            "FlexiPilot",
+  mavlink.MAV_AUTOPILOT_PX4:           "PX4",
+  mavlink.MAV_AUTOPILOT_SMACCMPILOT:   "SMACCMPilot",
+  mavlink.MAV_AUTOPILOT_AUTOQUAD:      "AutoQuad",
+  mavlink.MAV_AUTOPILOT_ARMAZILA:      "Armazila",
+  mavlink.MAV_AUTOPILOT_AEROB:         "Aerob",
+  mavlink.MAV_AUTOPILOT_ASLUAV:        "ASLUAV",
+}
+
+var stateNames = map[uint8]string{
+  mavlink.MAV_STATE_BOOT:        "Initializing",
+  mavlink.MAV_STATE_CALIBRATING: "Calibrating",
+  mavlink.MAV_STATE_STANDBY:     "Standby",
+  mavlink.MAV_STATE_ACTIVE:      "Active",
+  mavlink.MAV_STATE_CRITICAL:    "Failsafe",
+  mavlink.MAV_STATE_EMERGENCY:   "Mayday",
+  mavlink.MAV_STATE_POWEROFF:    "Powering Down",
+}
+
+var commandResultNames = map[int]string{
+  mavlink.MAV_RESULT_ACCEPTED:             "Command accepted.",
+  mavlink.MAV_RESULT_TEMPORARILY_REJECTED: "Command was rejected by the vehicle, but is supported.",
+  mavlink.MAV_RESULT_DENIED:               "Command was rejected by the vehicle.",
+  mavlink.MAV_RESULT_UNSUPPORTED:          "Command is not supported.",
+  mavlink.MAV_RESULT_FAILED:               "Command was received, but failed.",
+}
+
+func vehicleTypeName(t uint8) string {
+  if name, ok := vehicleTypeNames[t]; ok {
+    return name
+  }
+  return "Generic Vehicle"
+}
+
+func firmwareName(autopilot uint8) string {
+  if name, ok := firmwareNames[autopilot]; ok {
+    return name
+  }
+  return "Generic Autopilot"
+}
+
+func stateName(state uint8) string {
+  if name, ok := stateNames[state]; ok {
+    return name
+  }
+  return "Unknown"
+}
+
+// Name of a MAV_RESULT.
+func CommandResultName(result int) string {
+  if name, ok := commandResultNames[result]; ok {
+    return name
+  }
+  return "Command failed to be received."
+}
@@ -0,0 +1,148 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "fmt"
+  "math"
+
+  "mavlink/parser"
+  "mission"
+)
+
+const (
+  SETPOINT_POSITION = "position" // local NED, meters from the EKF origin
+  SETPOINT_VELOCITY = "velocity" // local NED, m/s
+  SETPOINT_GLOBAL   = "global"   // lat/lon, meters above home
+  SETPOINT_ATTITUDE = "attitude" // radians, thrust 0 .. 1
+
+  // SET_POSITION_TARGET_* type_mask bits, set to ignore a field
+  targetIgnorePosition = 0x007
+  targetIgnoreVelocity = 0x038
+  targetIgnoreAccel    = 0x1C0
+  targetIgnoreYaw      = 0x400
+  targetIgnoreYawRate  = 0x800
+
+  // SET_ATTITUDE_TARGET type_mask bits
+  attitudeIgnoreBodyRates = 0x07
+)
+
+//
+// What the vehicle should be doing in offboard. Which fields count depends
+// on Type:
+//   position  X, Y, Z (local NED meters), Yaw
+//   velocity  Vx, Vy, Vz (local NED m/s), YawRate
+//   global    Lat, Lon, Alt (meters above home), Yaw
+//   attitude  Roll, Pitch, Yaw, Thrust (0 .. 1)
+// Angles are in radians.
+//
+type Setpoint struct {
+  Type      string
+  X         float32 `json:",omitempty"`
+  Y         float32 `json:",omitempty"`
+  Z         float32 `json:",omitempty"`
+  Vx        float32 `json:",omitempty"`
+  Vy        float32 `json:",omitempty"`
+  Vz        float32 `json:",omitempty"`
+  Lat       float64 `json:",omitempty"`
+  Lon       float64 `json:",omitempty"`
+  Alt       float32 `json:",omitempty"`
+  Roll      float32 `json:",omitempty"`
+  Pitch     float32 `json:",omitempty"`
+  Yaw       float32 `json:",omitempty"`
+  YawRate   float32 `json:",omitempty"`
+  Thrust    float32 `json:",omitempty"`
+}
+
+//
+// Endpoint: /drone/:name/offboard
+//
+type OffboardStatus struct {
+  Active    bool
+  Setpoint  *Setpoint
+  Timeout   uint // ms without a feed before we give up, and Hold
+}
+
+type OffboardEvent struct {
+  Active    bool
+  Reason    string
+}
+
+// The message to stream for sp.
+func (v *VehicleApi) PackSetpoint(sp *Setpoint) (mavlink.Message, error) {
+  switch sp.Type {
+  case SETPOINT_POSITION:
+    return v.PackPositionTarget(sp.X, sp.Y, sp.Z, sp.Yaw), nil
+  case SETPOINT_VELOCITY:
+    return v.PackVelocityTarget(sp.Vx, sp.Vy, sp.Vz, sp.YawRate), nil
+  case SETPOINT_GLOBAL:
+    return v.PackGlobalTarget(sp.Lat, sp.Lon, sp.Alt, sp.Yaw), nil
+  case SETPOINT_ATTITUDE:
+    return v.PackAttitudeTarget(sp.Roll, sp.Pitch, sp.Yaw, sp.Thrust), nil
+  }
+  return nil, fmt.Errorf("Type must be position, velocity, global or attitude.")
+}
+
+func (v *VehicleApi) PackPositionTarget(x, y, z, yaw float32) *mavlink.SetPositionTargetLocalNed {
+  return &mavlink.SetPositionTargetLocalNed{
+    X: x, Y: y, Z: z, Yaw: yaw,
+    TypeMask: targetIgnoreVelocity | targetIgnoreAccel | targetIgnoreYawRate,
+    TargetSystem: v.GetSystemId(),
+    CoordinateFrame: mavlink.MAV_FRAME_LOCAL_NED,
+  }
+}
+
+func (v *VehicleApi) PackVelocityTarget(vx, vy, vz, yawRate float32) *mavlink.SetPositionTargetLocalNed {
+  return &mavlink.SetPositionTargetLocalNed{
+    Vx: vx, Vy: vy, Vz: vz, YawRate: yawRate,
+    TypeMask: targetIgnorePosition | targetIgnoreAccel | targetIgnoreYaw,
+    TargetSystem: v.GetSystemId(),
+    CoordinateFrame: mavlink.MAV_FRAME_LOCAL_NED,
+  }
+}
+
+func (v *VehicleApi) PackGlobalTarget(lat, lon float64, alt, yaw float32) *mavlink.SetPositionTargetGlobalInt {
+  frame := uint8(mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT_INT)
+  return &mavlink.SetPositionTargetGlobalInt{
+    LatInt: mission.EncodeCoord(frame, lat),
+    LonInt: mission.EncodeCoord(frame, lon),
+    Alt: alt, Yaw: yaw,
+    TypeMask: targetIgnoreVelocity | targetIgnoreAccel | targetIgnoreYawRate,
+    TargetSystem: v.GetSystemId(),
+    CoordinateFrame: frame,
+  }
+}
+
+func (v *VehicleApi) PackAttitudeTarget(roll, pitch, yaw, thrust float32) *mavlink.SetAttitudeTarget {
+  return &mavlink.SetAttitudeTarget{
+    Q: eulerToQuaternion(roll, pitch, yaw),
+    Thrust: thrust,
+    TypeMask: attitudeIgnoreBodyRates,
+    TargetSystem: v.GetSystemId(),
+  }
+}
+
+// w, x, y, z, from ZYX euler angles
+func eulerToQuaternion(roll, pitch, yaw float32) [4]float32 {
+  cr, sr := math.Cos(float64(roll) / 2), math.Sin(float64(roll) / 2)
+  cp, sp := math.Cos(float64(pitch) / 2), math.Sin(float64(pitch) / 2)
+  cy, sy := math.Cos(float64(yaw) / 2), math.Sin(float64(yaw) / 2)
+
+  return [4]float32{
+    float32(cr * cp * cy + sr * sp * sy),
+    float32(sr * cp * cy - cr * sp * sy),
+    float32(cr * sp * cy + sr * cp * sy),
+    float32(cr * cp * sy - sr * sp * cy),
+  }
+}
@@ -0,0 +1,151 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "fmt"
+  "math"
+  "strings"
+
+  "mavlink/parser"
+)
+
+//
+// A param value as the type the vehicle keeps it in. Value holds any of
+// them exactly, ints up to 32 bits included, which a float32 can't.
+//
+// PARAM_VALUE and PARAM_SET carry every value in a float field, in one of
+// two ways. With MAV_PROTOCOL_CAPABILITY_PARAM_UNION (PX4) the field holds
+// the bytes of the value, so an INT32 is its bits read as a float. Otherwise
+// (ArduPilot) the value is cast to a float, and ints past 2^24 lose precision.
+//
+type ParamValue struct {
+  Type    uint8   // MAV_PARAM_TYPE
+  Value   float64
+}
+
+func ParamTypeName(kind uint8) string {
+  return strings.TrimPrefix(mavlink.MavParamType(kind).String(), "MAV_PARAM_TYPE_")
+}
+
+// Whether values of kind fit in the 4 bytes of a union.
+func paramUnionType(kind uint8) bool {
+  switch kind {
+  case mavlink.MAV_PARAM_TYPE_INT8, mavlink.MAV_PARAM_TYPE_UINT8,
+    mavlink.MAV_PARAM_TYPE_INT16, mavlink.MAV_PARAM_TYPE_UINT16,
+    mavlink.MAV_PARAM_TYPE_INT32, mavlink.MAV_PARAM_TYPE_UINT32:
+    return true
+  }
+  return false
+}
+
+// The value in a PARAM_VALUE's float field.
+func DecodeParam(raw float32, kind uint8, union bool) ParamValue {
+  if !union || !paramUnionType(kind) {
+    return ParamValue{kind, float64(raw)}
+  }
+
+  bits := math.Float32bits(raw)
+  var val float64
+  switch kind {
+  case mavlink.MAV_PARAM_TYPE_INT8:
+    val = float64(int8(bits))
+  case mavlink.MAV_PARAM_TYPE_UINT8:
+    val = float64(uint8(bits))
+  case mavlink.MAV_PARAM_TYPE_INT16:
+    val = float64(int16(bits))
+  case mavlink.MAV_PARAM_TYPE_UINT16:
+    val = float64(uint16(bits))
+  case mavlink.MAV_PARAM_TYPE_INT32:
+    val = float64(int32(bits))
+  case mavlink.MAV_PARAM_TYPE_UINT32:
+    val = float64(bits)
+  }
+  return ParamValue{kind, val}
+}
+
+//
+// value as a kind, or an error if kind can't hold it. Floats are rounded to
+// the precision they are sent with.
+//
+func NewParamValue(kind uint8, value float64) (ParamValue, error) {
+  if math.IsNaN(value) || math.IsInf(value, 0) {
+    return ParamValue{}, fmt.Errorf("Value must be a number.")
+  }
+
+  var min, max float64
+  switch kind {
+  case mavlink.MAV_PARAM_TYPE_REAL32, mavlink.MAV_PARAM_TYPE_REAL64:
+    return ParamValue{kind, float64(float32(value))}, nil
+  case mavlink.MAV_PARAM_TYPE_INT8:
+    min, max = math.MinInt8, math.MaxInt8
+  case mavlink.MAV_PARAM_TYPE_UINT8:
+    min, max = 0, math.MaxUint8
+  case mavlink.MAV_PARAM_TYPE_INT16:
+    min, max = math.MinInt16, math.MaxInt16
+  case mavlink.MAV_PARAM_TYPE_UINT16:
+    min, max = 0, math.MaxUint16
+  case mavlink.MAV_PARAM_TYPE_INT32:
+    min, max = math.MinInt32, math.MaxInt32
+  case mavlink.MAV_PARAM_TYPE_UINT32:
+    min, max = 0, math.MaxUint32
+  default:
+    // 64 bit ints only ever go as floats
+    return ParamValue{kind, float64(float32(value))}, nil
+  }
+
+  if value != math.Trunc(value) {
+    return ParamValue{}, fmt.Errorf("Value must be a whole number for %s.", ParamTypeName(kind))
+  } else if value < min || value > max {
+    return ParamValue{}, fmt.Errorf("Value must be between %g and %g for %s.", min, max, ParamTypeName(kind))
+  }
+  return ParamValue{kind, value}, nil
+}
+
+// The float field to send the value in.
+func (p ParamValue) Encode(union bool) float32 {
+  if !union || !paramUnionType(p.Type) {
+    return float32(p.Value)
+  }
+
+  var bits uint32
+  switch p.Type {
+  case mavlink.MAV_PARAM_TYPE_INT8:
+    bits = uint32(uint8(int8(p.Value)))
+  case mavlink.MAV_PARAM_TYPE_UINT8:
+    bits = uint32(uint8(p.Value))
+  case mavlink.MAV_PARAM_TYPE_INT16:
+    bits = uint32(uint16(int16(p.Value)))
+  case mavlink.MAV_PARAM_TYPE_UINT16:
+    bits = uint32(uint16(p.Value))
+  case mavlink.MAV_PARAM_TYPE_INT32:
+    bits = uint32(int32(p.Value))
+  case mavlink.MAV_PARAM_TYPE_UINT32:
+    bits = uint32(p.Value)
+  }
+  return math.Float32frombits(bits)
+}
+
+func (p ParamValue) TypeName() string {
+  return ParamTypeName(p.Type)
+}
+
+// Value to reply with. Floats stay float32, so 0.1 is written as 0.1 rather
+// than as the float64 nearest the float32 nearest 0.1.
+func (p ParamValue) Number() interface{} {
+  if p.Type == mavlink.MAV_PARAM_TYPE_REAL32 {
+    return float32(p.Value)
+  }
+  return p.Value
+}
@@ -0,0 +1,98 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "encoding/json"
+  "math"
+  "testing"
+
+  "mavlink/parser"
+)
+
+func TestParamEncodings(t *testing.T) {
+  values := []ParamValue{
+    {mavlink.MAV_PARAM_TYPE_INT32, 16777217}, // past what a float32 holds
+    {mavlink.MAV_PARAM_TYPE_INT32, -2},
+    {mavlink.MAV_PARAM_TYPE_UINT32, math.MaxUint32},
+    {mavlink.MAV_PARAM_TYPE_INT8, -100},
+    {mavlink.MAV_PARAM_TYPE_UINT16, 65535},
+    {mavlink.MAV_PARAM_TYPE_REAL32, 0.5},
+  }
+
+  for _, v := range values {
+    if got := DecodeParam(v.Encode(true), v.Type, true); got != v {
+      t.Errorf("union round trip of %v, got %v", v, got)
+    }
+  }
+
+  // union ints are their bits, cast ints are their value
+  v := ParamValue{mavlink.MAV_PARAM_TYPE_INT32, 1}
+  if raw := v.Encode(true); math.Float32bits(raw) != 1 {
+    t.Errorf("expected union bits, got %v", raw)
+  }
+  if raw := v.Encode(false); raw != 1 {
+    t.Errorf("expected cast, got %v", raw)
+  }
+  if got := DecodeParam(math.Float32frombits(0xFFFFFFFE), mavlink.MAV_PARAM_TYPE_INT32, true); got.Value != -2 {
+    t.Errorf("expected -2, got %v", got)
+  }
+  if got := DecodeParam(3, mavlink.MAV_PARAM_TYPE_INT32, false); got.Value != 3 {
+    t.Errorf("expected 3, got %v", got)
+  }
+}
+
+func TestNewParamValue(t *testing.T) {
+  checks := []struct {
+    kind    uint8
+    value   float64
+    ok      bool
+  }{
+    {mavlink.MAV_PARAM_TYPE_INT32, 16777217, true},
+    {mavlink.MAV_PARAM_TYPE_INT32, 1.5, false},
+    {mavlink.MAV_PARAM_TYPE_INT32, math.MaxInt32 + 1, false},
+    {mavlink.MAV_PARAM_TYPE_UINT8, 255, true},
+    {mavlink.MAV_PARAM_TYPE_UINT8, -1, false},
+    {mavlink.MAV_PARAM_TYPE_INT8, -129, false},
+    {mavlink.MAV_PARAM_TYPE_REAL32, 1.5, true},
+    {mavlink.MAV_PARAM_TYPE_REAL32, math.NaN(), false},
+  }
+
+  for _, c := range checks {
+    if _, err := NewParamValue(c.kind, c.value); (err == nil) != c.ok {
+      t.Errorf("%s %g: expected ok %v, got %v", ParamTypeName(c.kind), c.value, c.ok, err)
+    }
+  }
+
+  if v, _ := NewParamValue(mavlink.MAV_PARAM_TYPE_REAL32, 0.1); v.Value != float64(float32(0.1)) {
+    t.Errorf("expected float32 precision, got %v", v.Value)
+  }
+}
+
+func TestParamNumber(t *testing.T) {
+  checks := []struct {
+    val     ParamValue
+    json    string
+  }{
+    {DecodeParam(0.1, mavlink.MAV_PARAM_TYPE_REAL32, true), "0.1"},
+    {DecodeParam(math.Float32frombits(16777217), mavlink.MAV_PARAM_TYPE_INT32, true), "16777217"},
+    {DecodeParam(255, mavlink.MAV_PARAM_TYPE_UINT8, false), "255"},
+  }
+
+  for _, c := range checks {
+    if raw, err := json.Marshal(c.val.Number()); err != nil || string(raw) != c.json {
+      t.Errorf("%s: expected %s, got %s %v", c.val.TypeName(), c.json, raw, err)
+    }
+  }
+}
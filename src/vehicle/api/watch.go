@@ -0,0 +1,91 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package api
+
+import (
+  "sync"
+)
+
+// Telemetry topics, as keyed in GetVehicleTelem.
+var TelemTopics = []string{
+  "Info", "Status", "Mode", "Gps", "Attitude", "Position",
+  "Motors", "Input", "Rates", "Target", "Sensors", "Home",
+}
+
+//
+// Collects which telemetry topics were updated since it was last drained,
+// and signals C whenever one is. C never blocks the vehicle, so several
+// updates may show up as a single signal.
+//
+type TelemWatcher struct {
+  C       chan struct{}
+  lock    sync.Mutex
+  changed map[string]bool
+}
+
+// Signals go to c, which may be shared between watchers. A nil c gets
+// a channel of its own.
+func NewTelemWatcher(c chan struct{}) *TelemWatcher {
+  if c == nil {
+    c = make(chan struct{}, 1)
+  }
+  return &TelemWatcher{C: c, changed: make(map[string]bool)}
+}
+
+func (w *TelemWatcher) mark(topics ...string) {
+  w.lock.Lock()
+  for _, t := range topics {
+    w.changed[t] = true
+  }
+  w.lock.Unlock()
+
+  select {
+  case w.C <- struct{}{}:
+  default:
+  }
+}
+
+// Topics updated since the last call.
+func (w *TelemWatcher) Changed() []string {
+  w.lock.Lock()
+  defer w.lock.Unlock()
+
+  var topics []string
+  for t := range w.changed {
+    topics = append(topics, t)
+    delete(w.changed, t)
+  }
+  return topics
+}
+
+func (v *VehicleApi) AddWatcher(w *TelemWatcher) {
+  v.watchLock.Lock()
+  defer v.watchLock.Unlock()
+  v.watchers[w] = true
+}
+
+func (v *VehicleApi) RemoveWatcher(w *TelemWatcher) {
+  v.watchLock.Lock()
+  defer v.watchLock.Unlock()
+  delete(v.watchers, w)
+}
+
+// Tell the watchers about updated topics. Safe to call with v.lock held.
+func (v *VehicleApi) notify(topics ...string) {
+  v.watchLock.Lock()
+  defer v.watchLock.Unlock()
+  for w := range v.watchers {
+    w.mark(topics...)
+  }
+}
@@ -0,0 +1,225 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "io"
+  "testing"
+  "time"
+
+  "mavlink/parser"
+  "vehicle/api"
+)
+
+func TestCommandFutures(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2) // progress is an extension
+
+  // same MAV_CMD, so only the order tells their acks apart
+  arm := fake.v.SetModeAndArm(false, true, "", true)
+  hold := fake.v.SetModeAndArm(true, false, "Hold", false)
+
+  fake.v.sysOnlineHandler()
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE,
+    Result: mavlink.MAV_RESULT_IN_PROGRESS, Progress: 40})
+
+  if res, done := arm.Wait(0); done || res.Result != mavlink.MAV_RESULT_IN_PROGRESS || res.Progress != 40 {
+    t.Errorf("progress fail, got %v", res)
+  }
+
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_ACCEPTED})
+  fake.v.sysOnlineHandler()
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_DENIED})
+
+  if res, done := arm.Wait(0); !done || res.Result != mavlink.MAV_RESULT_ACCEPTED {
+    t.Errorf("arm fail, got %v", res)
+  }
+  if res, done := hold.Wait(0); !done || res.Result != mavlink.MAV_RESULT_DENIED {
+    t.Errorf("hold fail, got %v", res)
+  }
+
+  home := fake.v.DoGenericCommand(mavlink.MAV_CMD_DO_SET_HOME, [7]float32{})
+  now := time.Now()
+  for i := 0; i < 8; i++ {
+    fake.v.commands.tick(now.Add(time.Duration(i) * time.Second))
+  }
+  if res, done := home.Wait(0); !done || res.Result != api.COMMAND_TIMEOUT {
+    t.Errorf("timeout fail, got %v", res)
+  }
+}
+
+func TestCommandSendFailed(t *testing.T) {
+  r, w := io.Pipe()
+  r.Close()
+
+  v := NewVehicle("test", w)
+  cmd := v.DoGenericCommand(mavlink.MAV_CMD_NAV_LAND, [7]float32{})
+  v.sysOnlineHandler()
+
+  if res, done := cmd.Wait(0); !done || res.Result != api.COMMAND_SEND_FAILED {
+    t.Errorf("send fail, got %v", res)
+  }
+}
+
+func TestCommandJobCancel(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+
+  // land is an emergency, so it goes out straight away
+  sent := fake.v.CommandJob(fake.v.DoGenericCommand(mavlink.MAV_CMD_NAV_LAND, [7]float32{}))
+  queued := fake.v.CommandJob(fake.v.DoGenericCommand(mavlink.MAV_CMD_NAV_TAKEOFF, [7]float32{}))
+  done := fake.v.CommandJob(fake.v.DoGenericCommand(mavlink.MAV_CMD_DO_SET_HOME, [7]float32{}))
+
+  fake.v.sysOnlineHandler()
+  if s := sent.Status(); s.State != api.JOB_PENDING || s.Name != "MAV_CMD_NAV_LAND" {
+    t.Errorf("pending job fail, got %v", s)
+  }
+
+  if err := queued.Cancel(); err != nil || queued.Status().State != api.JOB_CANCELLED {
+    t.Errorf("cancel queued fail %q, got %v", err, queued.Status())
+  }
+  if err := sent.Cancel(); err != nil || sent.Status().State != api.JOB_CANCELLED {
+    t.Errorf("cancel sent fail %q, got %v", err, sent.Status())
+  }
+
+  // the late ack for the cancelled land changes nothing
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_NAV_LAND, Result: mavlink.MAV_RESULT_ACCEPTED})
+  if sent.Status().State != api.JOB_CANCELLED {
+    t.Errorf("cancelled job resolved by ack, got %v", sent.Status())
+  }
+
+  fake.v.sysOnlineHandler()
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_HOME, Result: mavlink.MAV_RESULT_ACCEPTED})
+  if s := done.Status(); s.State != api.JOB_DONE {
+    t.Errorf("done job fail, got %v", s)
+  }
+  if err := done.Cancel(); err == nil {
+    t.Error("expected cancelling a finished job to fail")
+  }
+
+  if fake.v.Jobs().Get(queued.Id) != queued || len(fake.v.Jobs().All()) != 3 {
+    t.Errorf("job list fail, got %v", fake.v.Jobs().All())
+  }
+}
+
+func TestCommandScheduling(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  v := fake.v
+
+  sent := func() uint16 {
+    v.commands.lock.Lock()
+    defer v.commands.lock.Unlock()
+    if v.commands.current == nil {
+      return 0
+    }
+    return v.commands.current.cmd.Command.Command
+  }
+  ack := func(cmd uint16) {
+    fake.send(&mavlink.CommandAck{Command: cmd, Result: mavlink.MAV_RESULT_ACCEPTED})
+    v.commands.tick(time.Now())
+  }
+
+  calibrate := v.DoGenericCommand(mavlink.MAV_CMD_PREFLIGHT_CALIBRATION, [7]float32{})
+  mode := v.DoGenericCommand(mavlink.MAV_CMD_DO_SET_MODE, [7]float32{})
+  first := v.DoGenericCommand(mavlink.MAV_CMD_DO_REPOSITION, [7]float32{1})
+  second := v.DoGenericCommand(mavlink.MAV_CMD_DO_REPOSITION, [7]float32{2})
+
+  if res := first.Result(); res.Result != api.COMMAND_PREEMPTED {
+    t.Errorf("replace fail, got %v", res)
+  }
+
+  // navigation goes before configuration, first come first served
+  v.commands.tick(time.Now())
+  if op := sent(); op != mavlink.MAV_CMD_DO_SET_MODE {
+    t.Fatalf("expected DO_SET_MODE in flight, got %d", op)
+  }
+  ack(mavlink.MAV_CMD_DO_SET_MODE)
+  if op := sent(); op != mavlink.MAV_CMD_DO_REPOSITION {
+    t.Fatalf("expected DO_REPOSITION in flight, got %d", op)
+  }
+
+  // an emergency takes over from the command in flight
+  terminate := v.DoGenericCommand(mavlink.MAV_CMD_DO_FLIGHTTERMINATION, [7]float32{1})
+  if op := sent(); op != mavlink.MAV_CMD_DO_FLIGHTTERMINATION {
+    t.Fatalf("expected DO_FLIGHTTERMINATION in flight, got %d", op)
+  }
+  if res := second.Result(); res.Result != api.COMMAND_PREEMPTED {
+    t.Errorf("preempt fail, got %v", res)
+  }
+  ack(mavlink.MAV_CMD_DO_FLIGHTTERMINATION)
+
+  if res := mode.Result(); res.Result != mavlink.MAV_RESULT_ACCEPTED {
+    t.Errorf("mode fail, got %v", res)
+  }
+  if res := terminate.Result(); res.Result != mavlink.MAV_RESULT_ACCEPTED {
+    t.Errorf("terminate fail, got %v", res)
+  }
+
+  // whatever class is in flight, and queued navigation goes too
+  if op := sent(); op != mavlink.MAV_CMD_PREFLIGHT_CALIBRATION {
+    t.Fatalf("expected PREFLIGHT_CALIBRATION in flight, got %d", op)
+  }
+  speed := v.DoGenericCommand(mavlink.MAV_CMD_DO_CHANGE_SPEED, [7]float32{})
+  v.DoGenericCommand(mavlink.MAV_CMD_NAV_RETURN_TO_LAUNCH, [7]float32{})
+  if res := calibrate.Result(); res.Result != api.COMMAND_PREEMPTED {
+    t.Errorf("preempt in flight fail, got %v", res)
+  }
+  if res := speed.Result(); res.Result != api.COMMAND_PREEMPTED {
+    t.Errorf("preempt queued fail, got %v", res)
+  }
+  if op := sent(); op != mavlink.MAV_CMD_NAV_RETURN_TO_LAUNCH {
+    t.Fatalf("expected NAV_RETURN_TO_LAUNCH in flight, got %d", op)
+  }
+
+  // anything left waiting too long goes stale
+  home := v.DoGenericCommand(mavlink.MAV_CMD_DO_SET_HOME, [7]float32{})
+  v.commands.tick(time.Now().Add(2 * time.Minute))
+  if res := home.Result(); res.Result != api.COMMAND_EXPIRED {
+    t.Errorf("expire fail, got %v", res)
+  }
+}
+
+func TestPositionCommand(t *testing.T) {
+  params := [4]float32{-1}
+  lat, lon := 36.16990123, -115.13981234
+
+  // without the capability, COMMAND_LONG
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.v.DoPositionCommand(mavlink.MAV_CMD_DO_REPOSITION, mavlink.MAV_FRAME_GLOBAL, params, lat, lon, 700)
+  fake.v.commands.tick(time.Now())
+  if m, ok := fake.command(mavlink.MAV_CMD_DO_REPOSITION).(*mavlink.CommandLong); !ok || m.Param5 != float32(lat) || m.Param7 != 700 {
+    t.Errorf("expected COMMAND_LONG, got %v", m)
+  }
+
+  fake = newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{}) // offline vehicles lose their capabilities
+  fake.send(&mavlink.AutopilotVersion{Capabilities: mavlink.MAV_PROTOCOL_CAPABILITY_COMMAND_INT})
+
+  cmd := fake.v.DoPositionCommand(mavlink.MAV_CMD_DO_REPOSITION, mavlink.MAV_FRAME_GLOBAL, params, lat, lon, 700)
+  fake.v.commands.tick(time.Now())
+
+  m, ok := fake.command(mavlink.MAV_CMD_DO_REPOSITION).(*mavlink.CommandInt)
+  if !ok || m.X != 361699012 || m.Y != -1151398123 || m.Z != 700 || m.Frame != mavlink.MAV_FRAME_GLOBAL || m.Param1 != -1 {
+    t.Fatalf("expected COMMAND_INT, got %v", m)
+  }
+
+  // capable, but not of this command
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_REPOSITION, Result: mavlink.MAV_RESULT_UNSUPPORTED})
+  if _, ok := fake.command(mavlink.MAV_CMD_DO_REPOSITION).(*mavlink.CommandLong); !ok {
+    t.Fatal("expected fallback to COMMAND_LONG")
+  }
+
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_REPOSITION, Result: mavlink.MAV_RESULT_ACCEPTED})
+  if res, done := cmd.Wait(time.Second); !done || res.Result != mavlink.MAV_RESULT_ACCEPTED {
+    t.Errorf("fallback fail, got %v", res)
+  }
+}
@@ -0,0 +1,308 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "sync"
+  "time"
+
+  "logger"
+  "mavlink/parser"
+  "vehicle/api"
+)
+
+type CommandClass int
+
+// Highest priority first.
+const (
+  COMMAND_EMERGENCY CommandClass = iota
+  COMMAND_NAVIGATION
+  COMMAND_CONFIGURATION
+  commandClasses
+)
+
+type CommandPolicy struct {
+  Retries   int           // resends without an ack before giving up
+  Timeout   time.Duration // wait for an ack before resending
+  Progress  time.Duration // once IN_PROGRESS, longest the vehicle may go quiet
+  Expire    time.Duration // longest wait in the queue before it is stale, 0 for ever
+  Replace   bool          // a newer one with the same MAV_CMD replaces it while queued
+}
+
+var commandPolicies = [commandClasses]CommandPolicy{
+  COMMAND_EMERGENCY:     {Retries: 10, Timeout: 250 * time.Millisecond, Progress: 10 * time.Second},
+  COMMAND_NAVIGATION:    {Retries: 5, Timeout: 500 * time.Millisecond, Progress: 10 * time.Second, Expire: 10 * time.Second},
+  COMMAND_CONFIGURATION: {Retries: 5, Timeout: 500 * time.Millisecond, Progress: 10 * time.Second, Expire: 60 * time.Second},
+}
+
+// Commands that don't fit their class's policy.
+var commandPolicyOverrides = map[uint16]CommandPolicy{
+  mavlink.MAV_CMD_DO_REPOSITION:   {Retries: 5, Timeout: 500 * time.Millisecond, Progress: 10 * time.Second, Expire: 10 * time.Second, Replace: true},
+  mavlink.MAV_CMD_DO_CHANGE_SPEED: {Retries: 5, Timeout: 500 * time.Millisecond, Progress: 10 * time.Second, Expire: 10 * time.Second, Replace: true},
+  mavlink.MAV_CMD_PREFLIGHT_CALIBRATION: {Retries: 2, Timeout: 1 * time.Second, Progress: 90 * time.Second, Expire: 60 * time.Second},
+}
+
+func commandClass(cmd uint16) CommandClass {
+  switch cmd {
+  case mavlink.MAV_CMD_COMPONENT_ARM_DISARM, mavlink.MAV_CMD_DO_FLIGHTTERMINATION,
+    mavlink.MAV_CMD_DO_PARACHUTE, mavlink.MAV_CMD_NAV_RETURN_TO_LAUNCH, mavlink.MAV_CMD_NAV_LAND:
+    return COMMAND_EMERGENCY
+  case mavlink.MAV_CMD_DO_SET_MODE, mavlink.MAV_CMD_DO_CHANGE_SPEED, mavlink.MAV_CMD_DO_REPOSITION,
+    mavlink.MAV_CMD_DO_PAUSE_CONTINUE, mavlink.MAV_CMD_MISSION_START:
+    return COMMAND_NAVIGATION
+  }
+
+  if cmd < mavlink.MAV_CMD_NAV_LAST {
+    return COMMAND_NAVIGATION
+  }
+  return COMMAND_CONFIGURATION
+}
+
+func commandPolicy(cmd uint16) CommandPolicy {
+  if p, found := commandPolicyOverrides[cmd]; found {
+    return p
+  }
+  return commandPolicies[commandClass(cmd)]
+}
+
+type scheduledCommand struct {
+  cmd       *api.VehicleCommand
+  class     CommandClass
+  policy    CommandPolicy
+  queued    time.Time
+  sent      time.Time // last send
+  sends     int
+  asInt     bool      // sending cmd.Int
+}
+
+//
+// Decides which command goes to the vehicle next. Only one is in flight at a
+// time, since acks only say which MAV_CMD they are for. The queue is FIFO per
+// class, and the highest class with anything queued goes first.
+//
+// Commands are preempted rather than left to go stale: some replace any
+// queued command with the same MAV_CMD (see CommandPolicy.Replace), and an
+// emergency command replaces the command in flight along with all queued
+// navigation commands. Anything queued for longer than its policy allows
+// expires.
+//
+type commandScheduler struct {
+  v         *Vehicle
+  lock      sync.Mutex
+  queues    [commandClasses][]*scheduledCommand
+  current   *scheduledCommand
+}
+
+func newCommandScheduler(v *Vehicle) *commandScheduler {
+  return &commandScheduler{v: v}
+}
+
+func (s *commandScheduler) push(cmd *api.VehicleCommand) {
+  op := cmd.Command.Command
+  s.enqueue(&scheduledCommand{
+    cmd: cmd,
+    class: commandClass(op),
+    policy: commandPolicy(op),
+    queued: time.Now(),
+  })
+}
+
+// Pushes cmd as class, with its policy, whatever the command. For failsafes
+// that can't wait behind what clients have asked for.
+func (s *commandScheduler) pushAs(cmd *api.VehicleCommand, class CommandClass) {
+  s.enqueue(&scheduledCommand{
+    cmd: cmd,
+    class: class,
+    policy: commandPolicies[class],
+    queued: time.Now(),
+  })
+}
+
+func (s *commandScheduler) enqueue(sc *scheduledCommand) {
+  op := sc.cmd.Command.Command
+
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  if sc.class == COMMAND_EMERGENCY {
+    if s.current != nil && s.current.class != COMMAND_EMERGENCY {
+      s.preempt(s.current)
+      s.current = nil
+    }
+    for _, e := range s.queues[COMMAND_NAVIGATION] {
+      s.preempt(e)
+    }
+    s.queues[COMMAND_NAVIGATION] = nil
+  }
+
+  if sc.policy.Replace {
+    s.queues[sc.class] = s.filter(sc.class, func(e *scheduledCommand) bool {
+      if e.cmd.Command.Command == op {
+        s.preempt(e)
+        return false
+      }
+      return true
+    })
+  }
+
+  s.queues[sc.class] = append(s.queues[sc.class], sc)
+
+  if sc.class == COMMAND_EMERGENCY {
+    // don't wait for the next pass
+    s.run(time.Now())
+  }
+}
+
+func (s *commandScheduler) preempt(e *scheduledCommand) {
+  if e.cmd.Fail(api.COMMAND_PREEMPTED) {
+    logger.DroneLog(s.v.id, "Command", mavlink.MavCmd(e.cmd.Command.Command), "preempted")
+  }
+}
+
+// Queue of class without the entries keep says no to.
+func (s *commandScheduler) filter(class CommandClass, keep func(*scheduledCommand) bool) []*scheduledCommand {
+  var q []*scheduledCommand
+  for _, e := range s.queues[class] {
+    if keep(e) {
+      q = append(q, e)
+    }
+  }
+  return q
+}
+
+// Sends, resends or gives up on commands as their policies say, as of now.
+// Called regularly once the vehicle is up.
+func (s *commandScheduler) tick(now time.Time) {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+  s.run(now)
+}
+
+func (s *commandScheduler) run(now time.Time) {
+  for class := range s.queues {
+    s.queues[class] = s.filter(CommandClass(class), func(e *scheduledCommand) bool {
+      if e.policy.Expire > 0 && now.Sub(e.queued) > e.policy.Expire {
+        e.cmd.Fail(api.COMMAND_EXPIRED)
+        return false
+      }
+      return true
+    })
+  }
+
+  if s.current != nil {
+    select {
+    case <-s.current.cmd.Done():
+      // got its ack, send the next item
+      s.current = nil
+    default:
+    }
+  }
+
+  if s.current == nil {
+    for class, q := range s.queues {
+      if len(q) > 0 {
+        s.current = q[0]
+        s.current.asInt = q[0].cmd.Int != nil &&
+          s.v.api.CheckCapability(mavlink.MAV_PROTOCOL_CAPABILITY_COMMAND_INT)
+        s.queues[class] = q[1:]
+        break
+      }
+    }
+  }
+
+  cur := s.current
+  if cur == nil {
+    return
+  }
+
+  if progress, updated := cur.cmd.InProgress(); progress {
+    // The vehicle has it, so don't resend. It does have to keep us posted.
+    if now.Sub(updated) > cur.policy.Progress {
+      s.timedOut(cur)
+    }
+  } else if cur.sends > 0 && now.Sub(cur.sent) < cur.policy.Timeout {
+    // still waiting on the ack
+  } else if cur.sends > cur.policy.Retries {
+    s.timedOut(cur)
+  } else if err := s.v.SendMessage(cur.message()); err != nil {
+    logger.DroneLog(s.v.id, err)
+    cur.cmd.Fail(api.COMMAND_SEND_FAILED)
+    s.current = nil
+  } else {
+    cur.sends++
+    cur.sent = now
+  }
+}
+
+func (e *scheduledCommand) message() mavlink.Message {
+  if e.asInt {
+    return e.cmd.Int
+  }
+  return e.cmd.Command
+}
+
+func (s *commandScheduler) timedOut(e *scheduledCommand) {
+  if e.cmd.Fail(api.COMMAND_TIMEOUT) {
+    s.v.api.CommandTimedOut(e.cmd.Command.Command)
+  }
+  s.current = nil
+}
+
+// Applies an ack to the command in flight, if it is the one being acked.
+func (s *commandScheduler) ack(m *mavlink.CommandAck) {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  cur := s.current
+  if cur == nil || cur.cmd.Command.Command != m.Command {
+    return
+  }
+
+  if cur.asInt && m.Result == mavlink.MAV_RESULT_UNSUPPORTED {
+    // Capable or not, the vehicle won't take this one as a COMMAND_INT.
+    logger.DroneLog(s.v.id, "Command", mavlink.MavCmd(m.Command), "unsupported as COMMAND_INT, sending COMMAND_LONG")
+    cur.asInt = false
+    cur.sends = 0
+    s.run(time.Now())
+    return
+  }
+
+  cur.cmd.Ack(m)
+}
+
+// Takes cmd back if the vehicle hasn't acked it yet. If it was already sent
+// the vehicle may still act on it, but we stop resending it and ignore its ack.
+func (s *commandScheduler) cancel(cmd *api.VehicleCommand) bool {
+  s.lock.Lock()
+  defer s.lock.Unlock()
+
+  if s.current != nil && s.current.cmd == cmd {
+    if progress, _ := cmd.InProgress(); progress || !cmd.Fail(api.COMMAND_CANCELLED) {
+      return false
+    }
+    s.current = nil
+    return true
+  }
+
+  for class, q := range s.queues {
+    for i, e := range q {
+      if e.cmd == cmd {
+        s.queues[class] = append(q[:i:i], q[i+1:]...)
+        return cmd.Fail(api.COMMAND_CANCELLED)
+      }
+    }
+  }
+
+  return false
+}
@@ -0,0 +1,305 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "bytes"
+  "io"
+  "math"
+  "sync/atomic"
+  "time"
+
+  "mavlink/parser"
+  "vehicle/api"
+)
+
+//
+// Just enough of an autopilot for the vehicle tests: it holds missions,
+// fences, rally points and params, acks nothing by itself, and keeps the
+// commands, setpoints and inputs it gets for tests to check. It reads what
+// the vehicle sends from a pipe and answers through ProcessPacket.
+//
+type fakeAutopilot struct {
+  v         *Vehicle
+  version   uint8
+  items     map[uint8][]*mavlink.MissionItemInt // by MAV_MISSION_TYPE
+  kind      uint8 // of the upload in progress
+  count     uint16
+  dropCount int // MISSION_COUNTs to ignore, to exercise retries
+  reject    uint8 // MAV_MISSION_RESULT to answer uploads with
+  skew      int32 // added to the latitude of items read back
+  strayAcks bool // answer download requests with a leftover ACCEPTED ack first
+  commands  chan mavlink.Message // COMMAND_LONGs and COMMAND_INTs received
+  setpoints chan mavlink.Message // SET_POSITION_TARGET_*s and SET_ATTITUDE_TARGETs received
+  inputs    chan mavlink.Message // RC_CHANNELS_OVERRIDEs and MANUAL_CONTROLs received
+  areas     chan *mavlink.SafetySetAllowedArea // received
+  params    map[[16]byte]*mavlink.ParamValue // as the autopilot sends them
+  dropSets  int // PARAM_SETs to ignore, to exercise retries
+  keep      map[string]bool // params that won't take a PARAM_SET, but echo it
+  round     bool // store float params to 2 decimals
+  hash      uint32 // _HASH_CHECK, bumped on each set. 0 for none
+  lists     int32 // PARAM_REQUEST_LISTs received
+}
+
+func newFakeAutopilot(version uint8) *fakeAutopilot {
+  r, w := io.Pipe()
+  fake := &fakeAutopilot{
+    version: version,
+    items: make(map[uint8][]*mavlink.MissionItemInt),
+    commands: make(chan mavlink.Message, 16),
+    setpoints: make(chan mavlink.Message, 64),
+    inputs: make(chan mavlink.Message, 64),
+    areas: make(chan *mavlink.SafetySetAllowedArea, 4),
+    params: make(map[[16]byte]*mavlink.ParamValue),
+    keep: make(map[string]bool),
+  }
+  fake.v = NewVehicle("test", w)
+
+  // Keep reading while replies are handled, or a vehicle sending several
+  // messages in a row blocks on the pipe holding its link, which the
+  // replies need.
+  received := make(chan mavlink.Message, 64)
+  go func() {
+    dec := mavlink.NewDecoder(r)
+    for {
+      p, err := dec.Decode()
+      if err != nil {
+        close(received)
+        return
+      }
+      if m, err := mavlink.DecodeMessage(p); err == nil {
+        received <- m
+      }
+    }
+  }()
+  go func() {
+    for m := range received {
+      fake.handle(m)
+    }
+  }()
+
+  return fake
+}
+
+func (f *fakeAutopilot) send(m mavlink.Message) {
+  var buf bytes.Buffer
+  enc := mavlink.NewEncoder(&buf)
+  enc.Version = f.version
+  enc.Encode(1, 1, m)
+  f.v.ProcessPacket(buf.Bytes())
+}
+
+// Next COMMAND_LONG or COMMAND_INT received for cmd, skipping others.
+func (f *fakeAutopilot) command(cmd uint16) mavlink.Message {
+  for {
+    select {
+    case m := <-f.commands:
+      if l, ok := m.(*mavlink.CommandLong); ok && l.Command == cmd {
+        return m
+      } else if i, ok := m.(*mavlink.CommandInt); ok && i.Command == cmd {
+        return m
+      }
+    case <-time.After(time.Second):
+      return nil
+    }
+  }
+}
+
+func (f *fakeAutopilot) handle(m mavlink.Message) {
+  switch m := m.(type) {
+  case *mavlink.CommandLong, *mavlink.CommandInt:
+    select {
+    case f.commands <- m:
+    default:
+    }
+
+  case *mavlink.SetPositionTargetLocalNed, *mavlink.SetPositionTargetGlobalInt, *mavlink.SetAttitudeTarget:
+    select {
+    case f.setpoints <- m:
+    default:
+    }
+
+  case *mavlink.ParamSet:
+    if f.dropSets > 0 {
+      f.dropSets--
+      return
+    }
+    if p, found := f.params[m.ParamId]; found {
+      if f.keep[api.ParamName(m.ParamId)] {
+        // unchanged
+      } else if f.round && p.ParamType == mavlink.MAV_PARAM_TYPE_REAL32 {
+        p.ParamValue = float32(math.Round(float64(m.ParamValue) * 100) / 100)
+      } else {
+        p.ParamValue = m.ParamValue
+      }
+      if f.hash != 0 {
+        f.hash++
+      }
+      f.send(p)
+    }
+
+  case *mavlink.ParamRequestList:
+    atomic.AddInt32(&f.lists, 1)
+    f.sendParams()
+
+  case *mavlink.ParamRequestRead:
+    f.sendParam(m)
+
+  case *mavlink.RcChannelsOverride, *mavlink.ManualControl:
+    select {
+    case f.inputs <- m:
+    default:
+    }
+
+  case *mavlink.SafetySetAllowedArea:
+    select {
+    case f.areas <- m:
+    default:
+    }
+
+  case *mavlink.MissionCount:
+    if f.dropCount > 0 {
+      f.dropCount--
+      return
+    }
+    if f.reject != mavlink.MAV_MISSION_ACCEPTED {
+      f.send(&mavlink.MissionAck{Type: f.reject, MissionType: m.MissionType})
+      return
+    }
+    f.kind = m.MissionType
+    f.count = m.Count
+    f.items[f.kind] = nil
+    f.send(&mavlink.MissionRequestInt{Seq: 0, MissionType: f.kind})
+
+  case *mavlink.MissionItemInt:
+    items := f.items[f.kind]
+    if m.MissionType != f.kind || int(m.Seq) != len(items) {
+      return
+    }
+    f.items[f.kind] = append(items, m)
+    if len(items) + 1 < int(f.count) {
+      f.send(&mavlink.MissionRequestInt{Seq: m.Seq + 1, MissionType: f.kind})
+    } else {
+      f.send(&mavlink.MissionAck{Type: mavlink.MAV_MISSION_ACCEPTED, MissionType: f.kind})
+    }
+
+  case *mavlink.MissionRequestList:
+    f.strayAck(m.MissionType)
+    f.send(&mavlink.MissionCount{Count: uint16(len(f.items[m.MissionType])), MissionType: m.MissionType})
+
+  case *mavlink.MissionRequestInt:
+    f.strayAck(m.MissionType)
+    if items := f.items[m.MissionType]; int(m.Seq) < len(items) {
+      item := *items[m.Seq]
+      item.X += f.skew
+      f.send(&item)
+    }
+
+  case *mavlink.MissionClearAll:
+    f.items[m.MissionType] = nil
+    f.send(&mavlink.MissionAck{Type: mavlink.MAV_MISSION_ACCEPTED, MissionType: m.MissionType})
+
+  case *mavlink.MissionSetCurrent:
+    f.send(&mavlink.MissionCurrent{Seq: m.Seq})
+  }
+}
+
+func (f *fakeAutopilot) strayAck(kind uint8) {
+  if f.strayAcks {
+    f.send(&mavlink.MissionAck{Type: mavlink.MAV_MISSION_ACCEPTED, MissionType: kind})
+  }
+}
+
+// Next SAFETY_SET_ALLOWED_AREA received.
+func (f *fakeAutopilot) area() *mavlink.SafetySetAllowedArea {
+  select {
+  case m := <-f.areas:
+    return m
+  case <-time.After(time.Second):
+    return nil
+  }
+}
+
+// Next setpoint received that match says yes to.
+func (f *fakeAutopilot) setpoint(match func(mavlink.Message) bool) mavlink.Message {
+  for {
+    select {
+    case m := <-f.setpoints:
+      if match(m) {
+        return m
+      }
+    case <-time.After(time.Second):
+      return nil
+    }
+  }
+}
+
+// Gives the autopilot a param, and tells the vehicle about it.
+func (f *fakeAutopilot) param(name string, raw float32, kind uint8) {
+  p := &mavlink.ParamValue{ParamValue: raw, ParamCount: uint16(len(f.params) + 1),
+    ParamIndex: uint16(len(f.params)), ParamType: kind}
+  copy(p.ParamId[:], name)
+  f.params[p.ParamId] = p
+  f.send(p)
+}
+
+// Every param, then the hash, as PX4 answers PARAM_REQUEST_LIST.
+func (f *fakeAutopilot) sendParams() {
+  for i := 0; i < len(f.params); i++ {
+    for _, p := range f.params {
+      if int(p.ParamIndex) == i {
+        p.ParamCount = uint16(len(f.params))
+        f.send(p)
+      }
+    }
+  }
+  f.sendHash()
+}
+
+func (f *fakeAutopilot) sendParam(m *mavlink.ParamRequestRead) {
+  if m.ParamIndex == -1 {
+    if api.ParamName(m.ParamId) == paramHashName {
+      f.sendHash()
+    } else if p, found := f.params[m.ParamId]; found {
+      f.send(p)
+    }
+    return
+  }
+  for _, p := range f.params {
+    if int(p.ParamIndex) == int(m.ParamIndex) {
+      f.send(p)
+    }
+  }
+}
+
+func (f *fakeAutopilot) sendHash() {
+  if f.hash == 0 {
+    return
+  }
+  p := &mavlink.ParamValue{ParamValue: math.Float32frombits(f.hash), ParamCount: uint16(len(f.params)),
+    ParamIndex: 65535, ParamType: mavlink.MAV_PARAM_TYPE_UINT32}
+  copy(p.ParamId[:], paramHashName)
+  f.send(p)
+}
+
+// An autopilot with the params, and the hash, of another.
+func (f *fakeAutopilot) clone() *fakeAutopilot {
+  c := newFakeAutopilot(f.version)
+  for id, p := range f.params {
+    copied := *p
+    c.params[id] = &copied
+  }
+  c.hash = f.hash
+  return c
+}
@@ -0,0 +1,108 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+
+  "mavlink/parser"
+  "mission"
+)
+
+//
+// Pushes the fence to the autopilot as MAV_MISSION_TYPE_FENCE items, and
+// its bounds and altitude limit as the SAFETY_SET_ALLOWED_AREA. The items are
+// read back, as rally points are, since a fence half there is worse than none.
+// Once the vehicle has taken it, we also hold targets sent through the API to
+// it, see CheckFence.
+//
+func (v *Vehicle) UploadFence(f *mission.Fence) error {
+  if err := f.Validate(); err != nil {
+    return err
+  }
+
+  want := f.Items()
+  if err := v.mission.Upload(mavlink.MAV_MISSION_TYPE_FENCE, want); err != nil {
+    return err
+  }
+
+  items, err := v.mission.Download(mavlink.MAV_MISSION_TYPE_FENCE)
+  if err != nil {
+    return fmt.Errorf("Fence sent, but could not be read back: %v", err)
+  }
+  back, err := mission.FenceFromItems(items)
+  if err != nil {
+    return fmt.Errorf("Fence sent, but read back wrong: %v", err)
+  }
+
+  got := back.Items()
+  if len(got) != len(want) {
+    return fmt.Errorf("Sent %d fence items, vehicle has %d.", len(want), len(got))
+  }
+  for i := range want {
+    if got[i].Command != want[i].Command || got[i].X != want[i].X || got[i].Y != want[i].Y || got[i].Param1 != want[i].Param1 {
+      return fmt.Errorf("Fence item %d read back differently.", i)
+    }
+  }
+
+  sw, ne, ok := f.Bounds()
+  if !ok && f.MaxAltitude > 0 {
+    // nowhere in particular to stay, but not too high
+    sw, ne, ok = [2]float64{-90, -180}, [2]float64{90, 180}, true
+  }
+  if ok {
+    err := v.SendMessage(&mavlink.SafetySetAllowedArea{
+      P1x: float32(sw[0]),
+      P1y: float32(sw[1]),
+      P1z: 0,
+      P2x: float32(ne[0]),
+      P2y: float32(ne[1]),
+      P2z: f.MaxAltitude,
+      TargetSystem: v.api.GetSystemId(),
+      TargetComponent: 0,
+      Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT,
+    })
+    if err != nil {
+      return err
+    }
+  }
+
+  v.fenceLock.Lock()
+  defer v.fenceLock.Unlock()
+  if f.Empty() {
+    v.fence = nil
+  } else {
+    v.fence = f
+  }
+  return nil
+}
+
+func (v *Vehicle) ClearFence() error {
+  return v.UploadFence(&mission.Fence{})
+}
+
+// Fence last uploaded, nil if none. Not to be modified.
+func (v *Vehicle) Fence() *mission.Fence {
+  v.fenceLock.RLock()
+  defer v.fenceLock.RUnlock()
+  return v.fence
+}
+
+// Why a target at lat, lon and alt (meters above home) is outside the fence.
+func (v *Vehicle) CheckFence(lat, lon float64, alt float32) error {
+  if f := v.Fence(); f != nil {
+    return f.Check(lat, lon, alt)
+  }
+  return nil
+}
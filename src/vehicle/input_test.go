@@ -0,0 +1,61 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "math"
+  "testing"
+  "time"
+
+  "mavlink/parser"
+)
+
+func TestManualControlInput(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+
+  fake.v.SendManualControl(100, -200, 500, math.MaxInt16, 0x3, true, 300, 20)
+  time.Sleep(500 * time.Millisecond)
+
+  // sent right away, then at 20Hz until the timeout
+  n := len(fake.inputs)
+  if n < 5 || n > 10 {
+    t.Errorf("expected ~7 inputs at 20Hz for 300ms, got %d", n)
+  }
+  m, ok := (<-fake.inputs).(*mavlink.ManualControl)
+  if !ok || m.X != 100 || m.Y != -200 || m.Z != 500 || m.R != math.MaxInt16 || m.Buttons != 0x3 {
+    t.Errorf("expected MANUAL_CONTROL, got %v", m)
+  }
+
+  for len(fake.inputs) > 0 {
+    <-fake.inputs
+  }
+  time.Sleep(200 * time.Millisecond)
+  if len(fake.inputs) > 0 {
+    t.Errorf("inputs after the timeout, got %d", len(fake.inputs))
+  }
+
+  // radio still works the same way, and disabling stops it
+  fake.v.SendRCOverride([8]uint16{1500, 1500, 1000}, true, 0, 0)
+  if m, ok := (<-fake.inputs).(*mavlink.RcChannelsOverride); !ok || m.Chan3Raw != 1000 {
+    t.Errorf("expected RC_CHANNELS_OVERRIDE, got %v", m)
+  }
+  fake.v.SendRCOverride([8]uint16{}, false, 0, 0)
+  for len(fake.inputs) > 0 {
+    <-fake.inputs
+  }
+  time.Sleep(300 * time.Millisecond)
+  if len(fake.inputs) > 0 {
+    t.Errorf("inputs after disabling, got %d", len(fake.inputs))
+  }
+}
@@ -0,0 +1,378 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+  "sync"
+  "time"
+
+  "logger"
+  "mavlink/parser"
+  "mission"
+)
+
+const (
+  MISSION_TIMEOUT = 1500 * time.Millisecond // wait for each reply before resending
+  MISSION_RETRIES = 5
+)
+
+//
+// Runs the MAVLink mission protocol against the vehicle, for missions,
+// fences and rally points alike (see MAV_MISSION_TYPE). Only one transfer
+// runs at a time, the others wait their turn. While one is running, mission
+// messages from the vehicle are fed to it through inbox.
+//
+type missionManager struct {
+  v         *Vehicle
+  transfer  sync.Mutex
+
+  lock      sync.Mutex
+  inbox     chan mavlink.Message
+  current   uint16
+}
+
+func newMissionManager(v *Vehicle) *missionManager {
+  return &missionManager{v: v}
+}
+
+// Called from processPacket with every mission message.
+func (m *missionManager) handle(msg mavlink.Message) {
+  m.lock.Lock()
+  defer m.lock.Unlock()
+
+  if cur, ok := msg.(*mavlink.MissionCurrent); ok {
+    m.current = cur.Seq
+  }
+
+  if m.inbox != nil {
+    select {
+    case m.inbox <- msg:
+    default:
+      // transfer is behind, it will ask again
+    }
+  }
+}
+
+func (m *missionManager) Current() uint16 {
+  m.lock.Lock()
+  defer m.lock.Unlock()
+  return m.current
+}
+
+//
+// Starts a transfer of kind. Anything but a plain mission needs mission_type,
+// an extension field that a MAVLink v1 link would drop.
+//
+func (m *missionManager) begin(kind uint8) error {
+  if kind != mavlink.MAV_MISSION_TYPE_MISSION {
+    m.v.linkLock.Lock()
+    version := m.v.mavlinkWriter.Version
+    m.v.linkLock.Unlock()
+
+    if version < mavlink.MAVLINK_V2 {
+      return fmt.Errorf("Transferring %s requires a MAVLink v2 link.", missionTypeName(kind))
+    }
+  }
+
+  m.transfer.Lock()
+  m.lock.Lock()
+  m.inbox = make(chan mavlink.Message, 16)
+  m.lock.Unlock()
+  return nil
+}
+
+func (m *missionManager) end() {
+  m.lock.Lock()
+  m.inbox = nil
+  m.lock.Unlock()
+  m.transfer.Unlock()
+}
+
+// Mission type of a reply, so transfers of one type ignore the others.
+func missionType(msg mavlink.Message) uint8 {
+  switch r := msg.(type) {
+  case *mavlink.MissionCount: return r.MissionType
+  case *mavlink.MissionRequest: return r.MissionType
+  case *mavlink.MissionRequestInt: return r.MissionType
+  case *mavlink.MissionItem: return r.MissionType
+  case *mavlink.MissionItemInt: return r.MissionType
+  case *mavlink.MissionAck: return r.MissionType
+  }
+  return mavlink.MAV_MISSION_TYPE_MISSION
+}
+
+//
+// Sends msg, then hands every reply of kind to accept until it says we're
+// done. If nothing accepted turns up within MISSION_TIMEOUT, msg is sent again.
+//
+func (m *missionManager) exchange(kind uint8, msg mavlink.Message, accept func(mavlink.Message) (bool, error)) error {
+  for tries := 0; tries <= MISSION_RETRIES; tries++ {
+    if err := m.v.SendMessage(msg); err != nil {
+      return err
+    }
+
+    timeout := time.After(MISSION_TIMEOUT)
+    for waiting := true; waiting; {
+      select {
+      case reply := <-m.inbox:
+        if missionType(reply) != kind {
+          continue
+        }
+        done, err := accept(reply)
+        if err != nil || done {
+          return err
+        }
+      case <-timeout:
+        waiting = false
+      }
+    }
+  }
+
+  return fmt.Errorf("Vehicle did not respond to %s.", msg.MsgName())
+}
+
+func missionAckError(ack *mavlink.MissionAck) error {
+  if ack.Type == mavlink.MAV_MISSION_ACCEPTED {
+    return nil
+  }
+  return fmt.Errorf("Vehicle rejected the %s: %s.", missionTypeName(ack.MissionType),
+    mavlink.MavMissionResult(ack.Type).Description())
+}
+
+func missionTypeName(kind uint8) string {
+  switch kind {
+  case mavlink.MAV_MISSION_TYPE_FENCE: return "fence"
+  case mavlink.MAV_MISSION_TYPE_RALLY: return "rally points"
+  default: return "mission"
+  }
+}
+
+// For vehicles that still ask with MISSION_REQUEST.
+func missionItemFloat(item *mavlink.MissionItemInt) *mavlink.MissionItem {
+  return &mavlink.MissionItem{
+    Param1: item.Param1,
+    Param2: item.Param2,
+    Param3: item.Param3,
+    Param4: item.Param4,
+    X: float32(mission.DecodeCoord(item.Frame, item.X)),
+    Y: float32(mission.DecodeCoord(item.Frame, item.Y)),
+    Z: item.Z,
+    Seq: item.Seq,
+    Command: item.Command,
+    TargetSystem: item.TargetSystem,
+    TargetComponent: item.TargetComponent,
+    Frame: item.Frame,
+    Current: item.Current,
+    Autocontinue: item.Autocontinue,
+    MissionType: item.MissionType,
+  }
+}
+
+func missionItemInt(item *mavlink.MissionItem) *mavlink.MissionItemInt {
+  return &mavlink.MissionItemInt{
+    Param1: item.Param1,
+    Param2: item.Param2,
+    Param3: item.Param3,
+    Param4: item.Param4,
+    X: mission.EncodeCoord(item.Frame, float64(item.X)),
+    Y: mission.EncodeCoord(item.Frame, float64(item.Y)),
+    Z: item.Z,
+    Seq: item.Seq,
+    Command: item.Command,
+    Frame: item.Frame,
+    Current: item.Current,
+    Autocontinue: item.Autocontinue,
+    MissionType: item.MissionType,
+  }
+}
+
+//
+// Upload replaces the items of kind on the vehicle. The vehicle asks for
+// each item in turn, and acks once it has all of them.
+//
+func (m *missionManager) Upload(kind uint8, items []*mavlink.MissionItemInt) error {
+  if len(items) == 0 {
+    return m.Clear(kind)
+  }
+
+  if err := m.begin(kind); err != nil {
+    return err
+  }
+  defer m.end()
+
+  target := m.v.api.GetSystemId()
+  for i, item := range items {
+    item.Seq = uint16(i)
+    item.TargetSystem = target
+    item.TargetComponent = 0
+    item.MissionType = kind
+  }
+
+  // After the count goes out the vehicle drives the transfer, asking for
+  // each item. Whatever we sent last is resent if it goes quiet.
+  var next mavlink.Message = &mavlink.MissionCount{
+    Count: uint16(len(items)),
+    TargetSystem: target,
+    TargetComponent: 0,
+    MissionType: kind,
+  }
+
+  for next != nil {
+    sending := next
+    next = nil
+
+    err := m.exchange(kind, sending, func(reply mavlink.Message) (bool, error) {
+      var seq uint16
+      switch r := reply.(type) {
+      case *mavlink.MissionRequestInt:
+        seq = r.Seq
+      case *mavlink.MissionRequest:
+        seq = r.Seq
+      case *mavlink.MissionAck:
+        return true, missionAckError(r)
+      default:
+        return false, nil
+      }
+
+      if int(seq) >= len(items) {
+        return true, fmt.Errorf("Vehicle asked for %s item %d of %d.", missionTypeName(kind), seq, len(items))
+      }
+
+      if _, ok := reply.(*mavlink.MissionRequest); ok {
+        next = missionItemFloat(items[seq])
+      } else {
+        next = items[seq]
+      }
+      return true, nil
+    })
+    if err != nil {
+      return err
+    }
+  }
+
+  logger.DroneLog(m.v.id, "Uploaded", missionTypeName(kind) + ",", len(items), "items")
+  return nil
+}
+
+// Download reads the items of kind back from the vehicle.
+func (m *missionManager) Download(kind uint8) ([]*mavlink.MissionItemInt, error) {
+  if err := m.begin(kind); err != nil {
+    return nil, err
+  }
+  defer m.end()
+
+  target := m.v.api.GetSystemId()
+
+  var count uint16
+  err := m.exchange(kind, &mavlink.MissionRequestList{
+    TargetSystem: target,
+    TargetComponent: 0,
+    MissionType: kind,
+  }, func(reply mavlink.Message) (bool, error) {
+    switch r := reply.(type) {
+    case *mavlink.MissionCount:
+      count = r.Count
+      return true, nil
+    case *mavlink.MissionAck:
+      return downloadAck(r)
+    }
+    return false, nil
+  })
+  if err != nil {
+    return nil, err
+  }
+
+  items := make([]*mavlink.MissionItemInt, count)
+  for seq := uint16(0); seq < count; seq++ {
+    err := m.exchange(kind, &mavlink.MissionRequestInt{
+      Seq: seq,
+      TargetSystem: target,
+      TargetComponent: 0,
+      MissionType: kind,
+    }, func(reply mavlink.Message) (bool, error) {
+      switch r := reply.(type) {
+      case *mavlink.MissionItemInt:
+        if r.Seq == seq {
+          items[seq] = r
+          return true, nil
+        }
+      case *mavlink.MissionItem:
+        if r.Seq == seq {
+          items[seq] = missionItemInt(r)
+          return true, nil
+        }
+      case *mavlink.MissionAck:
+        return downloadAck(r)
+      }
+      return false, nil
+    })
+    if err != nil {
+      return nil, err
+    }
+  }
+
+  // Let the vehicle know we're done, it doesn't answer this.
+  return items, m.v.SendMessage(&mavlink.MissionAck{
+    TargetSystem: target,
+    TargetComponent: 0,
+    Type: mavlink.MAV_MISSION_ACCEPTED,
+    MissionType: kind,
+  })
+}
+
+// Nothing sent in a download is acked as accepted, so an ACCEPTED ack is
+// left over from an earlier exchange, and is ignored. Any other ack ends it.
+func downloadAck(ack *mavlink.MissionAck) (bool, error) {
+  if ack.Type == mavlink.MAV_MISSION_ACCEPTED {
+    return false, nil
+  }
+  return true, missionAckError(ack)
+}
+
+// Clear removes all items of kind from the vehicle.
+func (m *missionManager) Clear(kind uint8) error {
+  if err := m.begin(kind); err != nil {
+    return err
+  }
+  defer m.end()
+
+  return m.exchange(kind, &mavlink.MissionClearAll{
+    TargetSystem: m.v.api.GetSystemId(),
+    TargetComponent: 0,
+    MissionType: kind,
+  }, func(reply mavlink.Message) (bool, error) {
+    if ack, ok := reply.(*mavlink.MissionAck); ok {
+      return true, missionAckError(ack)
+    }
+    return false, nil
+  })
+}
+
+// SetCurrent makes seq the active mission item, confirmed by MISSION_CURRENT.
+func (m *missionManager) SetCurrent(seq uint16) error {
+  m.begin(mavlink.MAV_MISSION_TYPE_MISSION)
+  defer m.end()
+
+  return m.exchange(mavlink.MAV_MISSION_TYPE_MISSION, &mavlink.MissionSetCurrent{
+    Seq: seq,
+    TargetSystem: m.v.api.GetSystemId(),
+    TargetComponent: 0,
+  }, func(reply mavlink.Message) (bool, error) {
+    if cur, ok := reply.(*mavlink.MissionCurrent); ok && cur.Seq == seq {
+      return true, nil
+    }
+    return false, nil
+  })
+}
@@ -0,0 +1,165 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "testing"
+
+  "mavlink/parser"
+  "mission"
+  "vehicle/api"
+)
+
+func TestMissionRoundTrip(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V1)
+  fake.dropCount = 1
+
+  items := []*api.MissionItem{
+    {Command: mavlink.MAV_CMD_NAV_TAKEOFF, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, X: 36.1699, Y: -115.1398, Z: 10, Autocontinue: true},
+    {Command: mavlink.MAV_CMD_NAV_WAYPOINT, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, X: 36.1701, Y: -115.1401, Z: 15, Autocontinue: true},
+    {Command: mavlink.MAV_CMD_NAV_LAND, Frame: mavlink.MAV_FRAME_LOCAL_NED, X: 1.5, Y: -2.25, Autocontinue: true},
+  }
+
+  if err := fake.v.UploadMission(items); err != nil {
+    t.Fatalf("Upload fail %q", err)
+  }
+
+  sent := fake.items[mavlink.MAV_MISSION_TYPE_MISSION]
+  if len(sent) != 3 || sent[0].X != 361699000 || sent[2].Y != -22500 {
+    t.Fatalf("Upload sent wrong items %v", sent)
+  }
+
+  mission, err := fake.v.DownloadMission()
+  if err != nil {
+    t.Fatalf("Download fail %q", err)
+  }
+
+  for i, item := range mission.Items {
+    want := items[i]
+    if item.Seq != uint16(i) || item.Command != want.Command || item.X != want.X || item.Y != want.Y || item.Z != want.Z {
+      t.Errorf("item %d read back as %v, want %v", i, item, want)
+    }
+  }
+
+  if err := fake.v.SetMissionCurrent(2); err != nil || fake.v.mission.Current() != 2 {
+    t.Errorf("SetCurrent fail %q", err)
+  }
+
+  if err := fake.v.ClearMission(); err != nil || len(fake.items[mavlink.MAV_MISSION_TYPE_MISSION]) != 0 {
+    t.Errorf("Clear fail %q", err)
+  }
+}
+
+func TestMissionRejected(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V1)
+
+  fake.reject = mavlink.MAV_MISSION_NO_SPACE
+
+  err := fake.v.UploadMission([]*api.MissionItem{{Command: mavlink.MAV_CMD_NAV_WAYPOINT}})
+  if err == nil {
+    t.Errorf("expected upload to be rejected")
+  }
+}
+
+func TestMissionStrayAck(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V1)
+
+  items := []*api.MissionItem{
+    {Command: mavlink.MAV_CMD_NAV_TAKEOFF, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, X: 36.1699, Y: -115.1398, Z: 10},
+    {Command: mavlink.MAV_CMD_NAV_LAND, Frame: mavlink.MAV_FRAME_GLOBAL_RELATIVE_ALT, X: 36.1701, Y: -115.1401},
+  }
+  if err := fake.v.UploadMission(items); err != nil {
+    t.Fatalf("Upload fail %q", err)
+  }
+
+  // say the upload's ack was repeated, and turns up mid download
+  fake.strayAcks = true
+  mission, err := fake.v.DownloadMission()
+  if err != nil || len(mission.Items) != 2 || mission.Items[1].X != items[1].X {
+    t.Fatalf("Download fail %q, got %v", err, mission)
+  }
+}
+
+func TestRallyReadback(t *testing.T) {
+  points := []mission.RallyPoint{
+    {Latitude: 36.1699, Longitude: -115.1398, Altitude: 30},
+    {Latitude: 36.18, Longitude: -115.15, Altitude: 45.5},
+  }
+
+  // mission_type needs v2
+  if err := newFakeAutopilot(mavlink.MAVLINK_V1).v.UploadRally(points); err == nil {
+    t.Error("expected rally upload over v1 to fail")
+  }
+
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{}) // switches the link to v2
+
+  if err := fake.v.UploadRally(points); err != nil {
+    t.Fatalf("UploadRally fail %q", err)
+  }
+  if len(fake.items[mavlink.MAV_MISSION_TYPE_RALLY]) != 2 || len(fake.items[mavlink.MAV_MISSION_TYPE_MISSION]) != 0 {
+    t.Errorf("rally points stored as the wrong mission type %v", fake.items)
+  }
+
+  got, err := fake.v.DownloadRally()
+  if err != nil || len(got) != 2 || got[1] != points[1] {
+    t.Errorf("DownloadRally fail %q, got %v", err, got)
+  }
+
+  fake.skew = 10
+  if err := fake.v.UploadRally(points); err == nil {
+    t.Error("expected readback mismatch to fail")
+  }
+}
+
+func TestFenceReadback(t *testing.T) {
+  fence := &mission.Fence{
+    Polygons: []mission.FencePolygon{
+      {Inclusion: true, Points: [][2]float64{{36.16, -115.15}, {36.18, -115.15}, {36.18, -115.13}}},
+    },
+    Circles: []mission.FenceCircle{
+      {Inclusion: false, Latitude: 36.17, Longitude: -115.14, Radius: 20},
+    },
+    MaxAltitude: 120,
+  }
+
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{}) // switches the link to v2
+
+  if err := fake.v.UploadFence(fence); err != nil {
+    t.Fatalf("UploadFence fail %q", err)
+  }
+  if len(fake.items[mavlink.MAV_MISSION_TYPE_FENCE]) != 4 || fake.v.Fence() != fence {
+    t.Errorf("fence not stored, got %v", fake.items)
+  }
+  if a := fake.area(); a == nil || a.P1x != 36.16 || a.P2y != -115.13 || a.P2z != 120 {
+    t.Errorf("allowed area fail, got %v", a)
+  }
+
+  // an altitude limit alone still goes to the vehicle
+  if err := fake.v.UploadFence(&mission.Fence{MaxAltitude: 50}); err != nil {
+    t.Fatalf("UploadFence fail %q", err)
+  }
+  if a := fake.area(); a == nil || a.P1x != -90 || a.P2x != 90 || a.P2z != 50 {
+    t.Errorf("altitude only allowed area fail, got %v", a)
+  }
+
+  fake.skew = 10
+  if err := fake.v.UploadFence(fence); err == nil {
+    t.Error("expected readback mismatch to fail")
+  }
+  if f := fake.v.Fence(); f == nil || f.MaxAltitude != 50 {
+    t.Errorf("fence replaced by one that didn't take, got %v", f)
+  }
+}
@@ -0,0 +1,193 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+  "sync"
+  "time"
+
+  "logger"
+  "mavlink/parser"
+  "vehicle/api"
+)
+
+const (
+  OFFBOARD_RATE    = 10 // Hz. PX4 drops out of offboard below 2Hz.
+  OFFBOARD_PRIME   = 500 * time.Millisecond // streaming before asking for the mode
+  OFFBOARD_TIMEOUT = 1 * time.Second // default deadman
+)
+
+//
+// Streams the latest setpoint to the vehicle at OFFBOARD_RATE for as long as
+// the client keeps feeding us, with setpoints or bare heartbeats. If the
+// client goes quiet for longer than its timeout we stop streaming and, if the
+// vehicle is in Offboard, put it in Hold rather than leave it acting on a
+// stale setpoint. If the vehicle leaves Offboard itself, say for the pilot,
+// we just stop streaming and leave its mode alone.
+//
+type offboard struct {
+  v         *Vehicle
+  lock      sync.Mutex
+  setpoint  *api.Setpoint
+  message   mavlink.Message // setpoint, packed
+  fed       time.Time
+  timeout   time.Duration
+  stop      chan struct{} // nil unless streaming
+}
+
+func newOffboard(v *Vehicle) *offboard {
+  return &offboard{v: v}
+}
+
+//
+// Starts streaming setpoint, and switches the vehicle into Offboard once it
+// has had a taste of them. If we are already streaming, the setpoint and
+// timeout are just replaced, and there is no mode change to wait on (nil).
+//
+func (v *Vehicle) StartOffboard(setpoint *api.Setpoint, timeout time.Duration) (*api.VehicleCommand, error) {
+  o := v.offboard
+  if timeout <= 0 {
+    timeout = OFFBOARD_TIMEOUT
+  }
+
+  m, err := v.packSetpoint(setpoint)
+  if err != nil {
+    return nil, err
+  }
+
+  o.lock.Lock()
+  o.setpoint = setpoint
+  o.message = m
+  o.timeout = timeout
+  o.fed = time.Now()
+  started := o.stop == nil
+  if started {
+    o.stop = make(chan struct{})
+    go o.stream(o.stop)
+  }
+  o.lock.Unlock()
+
+  if !started {
+    return nil, nil
+  }
+
+  logger.DroneLog(v.id, "Offboard streaming started")
+  v.api.Events().Publish(api.EVENT_OFFBOARD, api.OffboardEvent{Active: true, Reason: "Started."})
+
+  // PX4 refuses offboard unless setpoints are already coming in.
+  time.Sleep(OFFBOARD_PRIME)
+  return v.SetModeAndArm(true, false, "Offboard", false), nil
+}
+
+// Replaces the setpoint being streamed, or with nil just keeps the deadman at bay.
+func (v *Vehicle) FeedOffboard(setpoint *api.Setpoint) error {
+  var m mavlink.Message
+  if setpoint != nil {
+    var err error
+    if m, err = v.packSetpoint(setpoint); err != nil {
+      return err
+    }
+  }
+
+  o := v.offboard
+  o.lock.Lock()
+  defer o.lock.Unlock()
+
+  if o.stop == nil {
+    return fmt.Errorf("Offboard is not running.")
+  }
+
+  if setpoint != nil {
+    o.setpoint = setpoint
+    o.message = m
+  }
+  o.fed = time.Now()
+  return nil
+}
+
+// Global setpoints have to be inside the fence, like any other target.
+func (v *Vehicle) packSetpoint(sp *api.Setpoint) (mavlink.Message, error) {
+  if sp.Type == api.SETPOINT_GLOBAL {
+    if err := v.CheckFence(sp.Lat, sp.Lon, sp.Alt); err != nil {
+      return nil, err
+    }
+  }
+  return v.api.PackSetpoint(sp)
+}
+
+// Stops streaming and puts the vehicle in Hold. Returns nil if we weren't.
+// Without setpoints the vehicle fails out of offboard on its own, so the
+// Hold goes ahead of anything queued.
+func (v *Vehicle) StopOffboard() *api.VehicleCommand {
+  if !v.offboard.halt(nil, "Stopped.") {
+    return nil
+  }
+  return v.failsafeHold()
+}
+
+func (v *Vehicle) Offboard() api.OffboardStatus {
+  o := v.offboard
+  o.lock.Lock()
+  defer o.lock.Unlock()
+
+  return api.OffboardStatus{
+    Active: o.stop != nil,
+    Setpoint: o.setpoint,
+    Timeout: uint(o.timeout / time.Millisecond),
+  }
+}
+
+// Stops the stream, if it is the one started with stop (nil for any).
+// Returns false if it wasn't running.
+func (o *offboard) halt(stop chan struct{}, reason string) bool {
+  o.lock.Lock()
+  if o.stop == nil || (stop != nil && o.stop != stop) {
+    o.lock.Unlock()
+    return false
+  }
+  close(o.stop)
+  o.stop = nil
+  o.lock.Unlock()
+
+  logger.DroneLog(o.v.id, "Offboard streaming stopped:", reason)
+  o.v.api.Events().Publish(api.EVENT_OFFBOARD, api.OffboardEvent{Active: false, Reason: reason})
+  return true
+}
+
+func (o *offboard) stream(stop chan struct{}) {
+  ticker := time.NewTicker(time.Second / OFFBOARD_RATE)
+  defer ticker.Stop()
+
+  for {
+    select {
+    case <-stop:
+      return
+    case now := <-ticker.C:
+      o.lock.Lock()
+      m := o.message
+      stale := now.Sub(o.fed) > o.timeout
+      o.lock.Unlock()
+
+      if stale {
+        if o.halt(stop, "Client timed out.") && o.v.api.Mode() == "Offboard" {
+          o.v.failsafeHold()
+        }
+        return
+      }
+
+      o.v.sendMAVLink(m)
+    }
+  }
+}
@@ -0,0 +1,151 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "testing"
+  "time"
+
+  "mavlink/parser"
+  "vehicle/api"
+)
+
+// PX4 custom modes, as heartbeats carry them.
+const (
+  px4Position = 0x030000
+  px4Offboard = 0x060000
+)
+
+// Whether m asks for Hold, which is PX4's Auto with the Loiter sub mode.
+func isHold(m mavlink.Message) bool {
+  l, ok := m.(*mavlink.CommandLong)
+  return ok && l.Param2 == 4 && l.Param3 == 3
+}
+
+func TestOffboardDeadman(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  v := fake.v
+
+  if err := v.FeedOffboard(nil); err == nil {
+    t.Error("fed offboard without starting it")
+  }
+  if _, err := v.StartOffboard(&api.Setpoint{Type: "hover"}, 0); err == nil {
+    t.Error("started offboard with an unknown setpoint")
+  }
+
+  mode, err := v.StartOffboard(&api.Setpoint{Type: api.SETPOINT_VELOCITY, Vx: 1}, 700 * time.Millisecond)
+  if err != nil || mode == nil {
+    t.Fatalf("start fail, got %v", err)
+  }
+
+  // streaming before the mode switch
+  if len(fake.setpoints) < OFFBOARD_RATE / 4 {
+    t.Errorf("expected setpoints while priming, got %d", len(fake.setpoints))
+  }
+  if m, ok := (<-fake.setpoints).(*mavlink.SetPositionTargetLocalNed); !ok || m.Vx != 1 {
+    t.Errorf("expected velocity setpoint, got %v", m)
+  }
+
+  v.commands.tick(time.Now())
+  if fake.command(mavlink.MAV_CMD_DO_SET_MODE) == nil {
+    t.Fatal("expected mode switch")
+  }
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_ACCEPTED})
+  if res, _ := mode.Wait(time.Second); res.Result != mavlink.MAV_RESULT_ACCEPTED {
+    t.Errorf("mode switch fail, got %v", res)
+  }
+  fake.send(&mavlink.Heartbeat{CustomMode: px4Offboard})
+  fake.send(&mavlink.AutopilotVersion{}) // or the vehicle keeps asking for it
+
+  // a new setpoint replaces the old one, and feeds the deadman
+  if err := v.FeedOffboard(&api.Setpoint{Type: api.SETPOINT_POSITION, X: 5}); err != nil {
+    t.Fatal(err)
+  }
+  if fake.setpoint(func(m mavlink.Message) bool {
+    p, ok := m.(*mavlink.SetPositionTargetLocalNed)
+    return ok && p.X == 5
+  }) == nil {
+    t.Error("expected position setpoint")
+  }
+  if st := v.Offboard(); !st.Active || st.Setpoint.Type != api.SETPOINT_POSITION || st.Timeout != 700 {
+    t.Errorf("status fail, got %v", st)
+  }
+
+  // then the client goes quiet, leaving commands queued
+  reposition := v.DoGenericCommand(mavlink.MAV_CMD_DO_REPOSITION, [7]float32{})
+  takeoff := v.DoGenericCommand(mavlink.MAV_CMD_NAV_TAKEOFF, [7]float32{})
+  time.Sleep(time.Second)
+  if v.Offboard().Active {
+    t.Fatal("still streaming after the timeout")
+  }
+  for len(fake.setpoints) > 0 {
+    <-fake.setpoints
+  }
+  if fake.setpoint(func(mavlink.Message) bool { return true }) != nil {
+    t.Error("setpoints after the timeout")
+  }
+
+  // the Hold doesn't wait behind them
+  if m := fake.command(mavlink.MAV_CMD_DO_SET_MODE); !isHold(m) {
+    t.Errorf("expected Hold after the timeout, got %v", m)
+  }
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_ACCEPTED})
+  for _, cmd := range []*api.VehicleCommand{reposition, takeoff} {
+    if res := cmd.Result(); res.Result != api.COMMAND_PREEMPTED {
+      t.Errorf("expected queued %d dropped, got %v", res.Command, res)
+    }
+  }
+
+  if v.StopOffboard() != nil {
+    t.Error("stopped offboard twice")
+  }
+
+  // the pilot takes the vehicle out of Offboard, then the client goes quiet
+  if _, err := v.StartOffboard(&api.Setpoint{Type: api.SETPOINT_VELOCITY}, 700 * time.Millisecond); err != nil {
+    t.Fatal(err)
+  }
+  v.commands.tick(time.Now())
+  if fake.command(mavlink.MAV_CMD_DO_SET_MODE) == nil {
+    t.Fatal("expected mode switch")
+  }
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_ACCEPTED})
+  fake.send(&mavlink.Heartbeat{CustomMode: px4Offboard})
+  if err := v.FeedOffboard(nil); err != nil {
+    t.Fatal(err)
+  }
+  fake.send(&mavlink.Heartbeat{CustomMode: px4Position})
+  if v.Offboard().Active {
+    t.Error("still streaming out of Offboard")
+  }
+  time.Sleep(time.Second)
+  if m := fake.command(mavlink.MAV_CMD_DO_SET_MODE); m != nil {
+    t.Errorf("mode changed after leaving Offboard, got %v", m)
+  }
+
+  // or the vehicle never gets into Offboard at all
+  if _, err := v.StartOffboard(&api.Setpoint{Type: api.SETPOINT_VELOCITY}, 700 * time.Millisecond); err != nil {
+    t.Fatal(err)
+  }
+  v.commands.tick(time.Now())
+  fake.send(&mavlink.CommandAck{Command: mavlink.MAV_CMD_DO_SET_MODE, Result: mavlink.MAV_RESULT_DENIED})
+  time.Sleep(time.Second)
+  if v.Offboard().Active {
+    t.Fatal("still streaming after the timeout")
+  }
+  for m := fake.command(mavlink.MAV_CMD_DO_SET_MODE); m != nil; m = fake.command(mavlink.MAV_CMD_DO_SET_MODE) {
+    if isHold(m) {
+      t.Error("Hold without being in Offboard")
+    }
+  }
+}
@@ -0,0 +1,215 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "math"
+  "sync"
+  "time"
+
+  "logger"
+  "mavlink/parser"
+  "params"
+  "vehicle/api"
+)
+
+const (
+  PARAM_CACHE_TIMEOUT = 1 * time.Second // wait for the vehicle's hash before asking again
+  PARAM_CACHE_TRIES   = 3 // hash requests before deciding the vehicle doesn't hash its params
+)
+
+//
+// Keeps the params of each FMU once they are all in, and when it comes back
+// asks it for the hash of its params (PX4's _HASH_CHECK) instead of for all
+// of them. If the hash is the one they were cached with, the cached params
+// are used, which costs one round trip rather than one per param. Otherwise,
+// or if the vehicle never answers, they are downloaded as usual.
+//
+type paramCache struct {
+  v         *Vehicle
+  store     *params.Cache // nil to not cache
+  lock      sync.Mutex
+  fmuId     uint64        // the FMU the rest is for
+  entry     *params.CacheEntry // cached params, being checked
+  checked   bool          // entry used or ruled out, for this connection
+  hash      *uint32       // the vehicle's hash, while checking
+  asked     time.Time     // last hash request
+  asks      int           // hash requests since the last answer
+  saved     bool          // the cache has what the vehicle has
+  stale     bool          // params changed since the vehicle last gave its hash
+}
+
+func newParamCache(v *Vehicle, store *params.Cache) *paramCache {
+  return &paramCache{v: v, store: store}
+}
+
+// Forgets the connection, so the cache is checked again when it comes back.
+func (c *paramCache) reset() {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+  c.clear(0)
+}
+
+func (c *paramCache) clear(fmuId uint64) {
+  c.fmuId = fmuId
+  c.entry = nil
+  c.checked = false
+  c.hash = nil
+  c.asked = time.Time{}
+  c.asks = 0
+  c.saved = false
+  c.stale = false
+}
+
+//
+// Called instead of downloading the params, until it returns false. Asks the
+// vehicle for its hash if there is an entry for its FMU, and restores the
+// entry if the hash matches.
+//
+func (c *paramCache) restore() bool {
+  fmuId := c.v.api.GetFmuId()
+
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  if c.store == nil || fmuId == 0 {
+    return false
+  }
+  if c.fmuId != fmuId {
+    c.clear(fmuId)
+  }
+  if c.checked {
+    return false
+  }
+
+  if c.entry == nil {
+    e, err := c.store.Load(fmuId)
+    if err != nil {
+      logger.DroneLog(c.v.id, "WARN Param cache not read:", err)
+    }
+    if e == nil {
+      c.checked = true
+      return false
+    }
+    c.entry = e
+  }
+
+  now := time.Now()
+  switch {
+  case c.hash != nil:
+    c.checked = true
+    if *c.hash != c.entry.Hash {
+      logger.DroneLog(c.v.id, "Params changed since they were cached")
+      return false
+    }
+
+    raw := make(map[string]api.Param)
+    for _, p := range c.entry.Params {
+      raw[p.Name] = api.Param{Index: p.Index, Raw: math.Float32frombits(p.Bits), Type: p.Type}
+    }
+    c.v.api.RestoreParams(c.entry.Total, raw)
+    c.saved = true
+    logger.DroneLog(c.v.id, "Params restored from cache,", len(raw), "params")
+
+  case now.Sub(c.asked) < PARAM_CACHE_TIMEOUT:
+    // still waiting on the hash
+
+  case c.asks >= PARAM_CACHE_TRIES:
+    c.checked = true
+    logger.DroneLog(c.v.id, "WARN Vehicle didn't give a param hash, not using the param cache")
+    return false
+
+  default:
+    if c.asks == 0 {
+      logger.DroneLog(c.v.id, "Checking cached params...")
+    }
+    c.ask(now)
+  }
+
+  return true
+}
+
+func (c *paramCache) ask(now time.Time) {
+  c.v.sendMAVLink(c.v.api.RequestNamedParam(paramHashName))
+  c.asked = now
+  c.asks++
+}
+
+// Notes a param from the vehicle. Returns true if it is a hash for the cache
+// check only, and shouldn't be kept as a param.
+func (c *paramCache) received(name string, m *mavlink.ParamValue) bool {
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  if name != paramHashName {
+    c.saved = false
+    c.stale = true
+    return false
+  }
+
+  c.asks = 0
+  if c.entry != nil && !c.checked {
+    hash := math.Float32bits(m.ParamValue)
+    c.hash = &hash
+    return true
+  }
+  c.stale = false
+  return false
+}
+
+// Caches the params once they are all in and hashed. Called while the vehicle
+// is up, so it catches up with sets too.
+func (c *paramCache) save() {
+  fmuId := c.v.api.GetFmuId()
+
+  c.lock.Lock()
+  defer c.lock.Unlock()
+
+  if c.store == nil || fmuId == 0 || c.v.api.ParamForced() {
+    return
+  }
+  if c.saved || c.asks >= PARAM_CACHE_TRIES {
+    return
+  }
+
+  now := time.Now()
+  total, raw := c.v.api.RawParams()
+  hash, found := raw[paramHashName]
+  if c.stale || !found {
+    if now.Sub(c.asked) >= PARAM_CACHE_TIMEOUT {
+      c.ask(now)
+    }
+    return
+  }
+
+  e := &params.CacheEntry{
+    FmuId: fmuId,
+    Hash: math.Float32bits(hash.Raw),
+    Total: total,
+    Saved: now,
+  }
+  for name, p := range raw {
+    e.Params = append(e.Params, params.CachedParam{
+      Name: name,
+      Index: p.Index,
+      Bits: math.Float32bits(p.Raw),
+      Type: p.Type,
+    })
+  }
+
+  if err := c.store.Save(e); err != nil {
+    logger.DroneLog(c.v.id, "WARN Params not cached:", err)
+  }
+  c.saved = true
+}
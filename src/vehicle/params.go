@@ -0,0 +1,100 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+
+  "params"
+  "vehicle/api"
+)
+
+const (
+  autopilotComponent = 1 // MAV_COMP_ID_AUTOPILOT1, whose params we hold
+
+  // PX4's hash of its params, sent as one. Not a setting, so it is left out
+  // of snapshots, and anything compared with or imported from them.
+  paramHashName = "_HASH_CHECK"
+)
+
+// Every param the vehicle has, once they have all loaded.
+func (v *Vehicle) ParamSnapshot() (*params.Snapshot, error) {
+  total, all := v.api.AllParams()
+  if total == 0 {
+    return nil, fmt.Errorf("Params aren't loaded yet.")
+  } else if uint(len(all) + len(v.MissingParams())) < total {
+    return nil, fmt.Errorf("Params are still loading.")
+  }
+
+  values := make([]params.Value, 0, len(all))
+  for name, p := range all {
+    if name != paramHashName {
+      values = append(values, params.Value{Name: name, Value: p.Value, Type: p.Type})
+    }
+  }
+  return params.NewSnapshot(v.api.GetSystemId(), autopilotComponent, values), nil
+}
+
+func (v *Vehicle) DiffParams(file *params.Snapshot) (*params.Diff, error) {
+  live, err := v.ParamSnapshot()
+  if err != nil {
+    return nil, err
+  }
+
+  // files from before the hash was left out may still have one
+  if _, found := file.Get(paramHashName); found {
+    var values []params.Value
+    for _, p := range file.Params {
+      if p.Name != paramHashName {
+        values = append(values, p)
+      }
+    }
+    file = params.NewSnapshot(file.SystemId, file.ComponentId, values)
+  }
+  return params.Compare(file, live), nil
+}
+
+//
+// Sets the params of a file that differ from the vehicle's, as a batch.
+//
+type ParamImport struct {
+  Diff      *params.Diff
+  *ParamBatch
+}
+
+func (v *Vehicle) ImportParams(file *params.Snapshot) (*ParamImport, error) {
+  diff, err := v.DiffParams(file)
+  if err != nil {
+    return nil, err
+  }
+
+  changes := make([]ParamChange, len(diff.Changed))
+  for i, c := range diff.Changed {
+    changes[i] = ParamChange{c.Name, c.New}
+  }
+  return &ParamImport{diff, v.SetParams(changes)}, nil
+}
+
+// What an import has done so far, for its reply or its job.
+func (p *ParamImport) Summary() map[string]interface{} {
+  ret := p.ParamBatch.Summary()
+  ret["Changed"] = len(p.Diff.Changed)
+  ret["Same"] = p.Diff.Same
+  ret["Unknown"] = p.Diff.Unknown
+  return ret
+}
+
+func (v *Vehicle) ParamImportJob(imp *ParamImport) *api.Job {
+  return v.ParamBatchJob("ImportParams", imp.ParamBatch, imp.Summary)
+}
@@ -0,0 +1,286 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+  "math"
+  "sync/atomic"
+  "testing"
+  "time"
+
+  "mavlink/parser"
+  "params"
+)
+
+func TestTypedParams(t *testing.T) {
+  // PX4 sends ints as unions
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{Autopilot: mavlink.MAV_AUTOPILOT_PX4})
+  fake.param("SYS_AUTOSTART", math.Float32frombits(4001), mavlink.MAV_PARAM_TYPE_INT32)
+  fake.param("MC_ROLL_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32)
+
+  if val, err := fake.v.GetParam("SYS_AUTOSTART"); err != nil || val.Value != 4001 || val.TypeName() != "INT32" {
+    t.Errorf("expected INT32 4001, got %v %v", val, err)
+  }
+  if val, _ := fake.v.GetParam("MC_ROLL_P"); val.Value != 6.5 {
+    t.Errorf("expected 6.5, got %v", val)
+  }
+  if name, err := fake.v.ParamNameAt(1); err != nil || name != "MC_ROLL_P" {
+    t.Errorf("expected MC_ROLL_P at 1, got %q %v", name, err)
+  }
+
+  if err := fake.v.SetParam("SYS_AUTOSTART", 16777217); err != nil {
+    t.Fatal(err)
+  }
+  var id [16]byte
+  copy(id[:], "SYS_AUTOSTART")
+  if raw := fake.params[id].ParamValue; math.Float32bits(raw) != 16777217 {
+    t.Errorf("expected union bits for 16777217, got %v", raw)
+  }
+  if val, _ := fake.v.GetParam("SYS_AUTOSTART"); val.Value != 16777217 {
+    t.Errorf("expected 16777217 back, got %v", val)
+  }
+
+  if err := fake.v.SetParam("SYS_AUTOSTART", 1.5); err == nil {
+    t.Error("set an INT32 to 1.5")
+  }
+  if err := fake.v.SetParam("NOT_A_PARAM", 1); err == nil {
+    t.Error("set a param the vehicle doesn't have")
+  }
+
+  // ArduPilot casts
+  fake = newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{Autopilot: mavlink.MAV_AUTOPILOT_ARDUPILOTMEGA})
+  fake.param("SYSID_THISMAV", 1, mavlink.MAV_PARAM_TYPE_INT16)
+
+  if err := fake.v.SetParam("SYSID_THISMAV", 255); err != nil {
+    t.Fatal(err)
+  }
+  id = [16]byte{}
+  copy(id[:], "SYSID_THISMAV")
+  if raw := fake.params[id].ParamValue; raw != 255 {
+    t.Errorf("expected cast 255, got %v", raw)
+  }
+}
+
+func TestImportParams(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{Autopilot: mavlink.MAV_AUTOPILOT_PX4})
+  fake.param("MC_ROLL_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32)
+  fake.param("SYS_AUTOSTART", math.Float32frombits(4001), mavlink.MAV_PARAM_TYPE_INT32)
+  fake.param("NAV_RCL_ACT", math.Float32frombits(2), mavlink.MAV_PARAM_TYPE_INT32)
+  fake.hash = 0x1234
+  fake.sendHash()
+
+  snapshot, err := fake.v.ParamSnapshot()
+  if err != nil || len(snapshot.Params) != 3 {
+    t.Fatalf("snapshot fail, got %v %v", snapshot, err)
+  }
+  if _, found := snapshot.Get(paramHashName); found {
+    t.Error("hash in the snapshot")
+  }
+
+  // another vehicle's hash never matches, and isn't ours to set
+  file := params.NewSnapshot(1, 1, []params.Value{
+    {Name: paramHashName, Value: 0x9999, Type: mavlink.MAV_PARAM_TYPE_UINT32},
+    {Name: "MC_ROLL_P", Value: 6.5, Type: mavlink.MAV_PARAM_TYPE_REAL32},
+    {Name: "SYS_AUTOSTART", Value: 16777217, Type: mavlink.MAV_PARAM_TYPE_INT32},
+    {Name: "NAV_RCL_ACT", Value: 1.5, Type: mavlink.MAV_PARAM_TYPE_INT32},
+    {Name: "NOT_A_PARAM", Value: 1, Type: mavlink.MAV_PARAM_TYPE_INT32},
+  })
+
+  imp, err := fake.v.ImportParams(file)
+  if err != nil {
+    t.Fatal(err)
+  }
+  <-imp.Done()
+
+  results := imp.Results()
+  if len(results) != 2 || imp.Diff.Same != 1 || len(imp.Diff.Unknown) != 1 {
+    t.Fatalf("expected 2 changes, 1 same, 1 unknown, got %+v %+v", imp.Diff, results)
+  }
+  for _, r := range results {
+    if (r.Name == "SYS_AUTOSTART") != (r.Error == "") {
+      t.Errorf("unexpected result %+v", r)
+    }
+  }
+  if val, _ := fake.v.GetParam("SYS_AUTOSTART"); val.Value != 16777217 {
+    t.Errorf("expected 16777217 imported, got %v", val)
+  }
+
+  if diff, _ := fake.v.DiffParams(file); len(diff.Changed) != 1 || diff.Changed[0].Name != "NAV_RCL_ACT" {
+    t.Errorf("expected only the bad change left, got %+v", diff)
+  }
+}
+
+func TestParamBatch(t *testing.T) {
+  fake := newFakeAutopilot(mavlink.MAVLINK_V2)
+  fake.send(&mavlink.Heartbeat{Autopilot: mavlink.MAV_AUTOPILOT_PX4})
+  fake.param("MC_ROLL_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32)
+  fake.param("MC_PITCH_P", 6.5, mavlink.MAV_PARAM_TYPE_REAL32)
+  fake.param("SYS_AUTOSTART", math.Float32frombits(4001), mavlink.MAV_PARAM_TYPE_INT32)
+  fake.param("COM_ARM_WO_GPS", math.Float32frombits(0), mavlink.MAV_PARAM_TYPE_INT32)
+  fake.keep["COM_ARM_WO_GPS"] = true
+  fake.round = true
+  fake.dropSets = 2 // the first two sends go missing
+
+  start := time.Now()
+  b := fake.v.SetParams([]ParamChange{
+    {"MC_ROLL_P", 7.25},
+    {"MC_PITCH_P", 7.00001}, // rounded to 7
+    {"SYS_AUTOSTART", 16777217},
+    {"COM_ARM_WO_GPS", 1},
+    {"NOT_A_PARAM", 1},
+  })
+  <-b.Done()
+
+  expect := []struct {
+    status  string
+    value   float64
+    resent  bool
+  }{
+    {PARAM_OK, 7.25, true},
+    {PARAM_OK, 7, true},
+    {PARAM_OK, 16777217, false},
+    {PARAM_REJECTED, 0, false},
+    {PARAM_INVALID, 0, false},
+  }
+
+  results := b.Results()
+  for i, e := range expect {
+    r := results[i]
+    if r.Status != e.status || r.Value != e.value || (r.Sends > 1) != e.resent {
+      t.Errorf("%s: expected %s %g resent %v, got %+v", r.Name, e.status, e.value, e.resent, r)
+    }
+  }
+  if b.Failed() != 2 {
+    t.Errorf("expected 2 failed, got %d", b.Failed())
+  }
+
+  // only the dropped sets waited, once
+  if elapsed := time.Since(start); elapsed < PARAM_SET_TIMEOUT || elapsed > 2 * PARAM_SET_TIMEOUT {
+    t.Errorf("expected one resend wait, took %v", elapsed)
+  }
+
+  if err := fake.v.SetParam("COM_ARM_WO_GPS", 1); err == nil || err.Error() != "Vehicle kept COM_ARM_WO_GPS at 0." {
+    t.Errorf("expected rejection, got %v", err)
+  }
+}
+
+// Brings the vehicle up as FMU fmuId with cache, and waits for it to have all its params.
+func (f *fakeAutopilot) boot(t *testing.T, cache *params.Cache, fmuId uint64) {
+  f.v.paramCache.lock.Lock()
+  f.v.paramCache.store = cache
+  f.v.paramCache.lock.Unlock()
+
+  start := time.Now()
+  for time.Since(start) < 10 * time.Second {
+    f.send(&mavlink.Heartbeat{Autopilot: mavlink.MAV_AUTOPILOT_PX4})
+    f.send(&mavlink.AutopilotVersion{Uid: fmuId, Capabilities: mavlink.MAV_PROTOCOL_CAPABILITY_PARAM_UNION})
+
+    if total, found := f.v.api.CheckParams(); total > 0 && len(found) - 1 == int(total) {
+      return
+    }
+    time.Sleep(50 * time.Millisecond)
+  }
+  t.Fatal("params never loaded")
+}
+
+// Waits for the vehicle to have cached its params, with hash.
+func waitCached(t *testing.T, cache *params.Cache, fmuId uint64, hash uint32) *params.CacheEntry {
+  for start := time.Now(); time.Since(start) < 5 * time.Second; time.Sleep(50 * time.Millisecond) {
+    if e, _ := cache.Load(fmuId); e != nil && e.Hash == hash {
+      return e
+    }
+  }
+  t.Fatalf("params never cached with hash %x", hash)
+  return nil
+}
+
+func TestParamCache(t *testing.T) {
+  cache, err := params.NewCache(t.TempDir())
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  first := newFakeAutopilot(mavlink.MAVLINK_V2)
+  first.hash = 0x1234
+  for i := 0; i < 50; i++ {
+    p := &mavlink.ParamValue{ParamValue: float32(i), ParamIndex: uint16(i), ParamType: mavlink.MAV_PARAM_TYPE_REAL32}
+    copy(p.ParamId[:], fmt.Sprintf("TEST_P%d", i))
+    first.params[p.ParamId] = p
+  }
+  var id [16]byte
+  copy(id[:], "TEST_P7")
+  first.params[id].ParamValue = math.Float32frombits(0xFFFFFFFF) // an INT32 -1 union, which is NaN as a float
+  first.params[id].ParamType = mavlink.MAV_PARAM_TYPE_INT32
+
+  // first connection downloads them, and caches them
+  first.boot(t, cache, 42)
+  if n := atomic.LoadInt32(&first.lists); n != 1 {
+    t.Errorf("expected one param list request, got %d", n)
+  }
+  e := waitCached(t, cache, 42, 0x1234)
+  if e.Total != 50 || len(e.Params) != 51 {
+    t.Errorf("expected 50 params and the hash cached, got %d of %d", len(e.Params), e.Total)
+  }
+
+  // the same FMU again only has to give its hash
+  again := first.clone()
+  again.boot(t, cache, 42)
+  if n := atomic.LoadInt32(&again.lists); n != 0 {
+    t.Errorf("expected params from the cache, got %d list requests", n)
+  }
+  if val, err := again.v.GetParam("TEST_P7"); err != nil || val.Value != -1 {
+    t.Errorf("expected INT32 -1 from the cache, got %v %v", val, err)
+  }
+  if val, _ := again.v.GetParam("TEST_P49"); val.Value != 49 {
+    t.Errorf("expected 49 from the cache, got %v", val)
+  }
+
+  // a set changes the hash, and the cache catches up
+  if err := again.v.SetParam("TEST_P3", 30); err != nil {
+    t.Fatal(err)
+  }
+  waitCached(t, cache, 42, 0x1235)
+
+  changed := again.clone()
+  changed.boot(t, cache, 42)
+  if n := atomic.LoadInt32(&changed.lists); n != 0 {
+    t.Errorf("expected params from the updated cache, got %d list requests", n)
+  }
+  if val, _ := changed.v.GetParam("TEST_P3"); val.Value != 30 {
+    t.Errorf("expected the set value from the cache, got %v", val)
+  }
+
+  // changed behind our back, say by another GCS, so it has to download them
+  stale := again.clone()
+  stale.hash = 0x9999
+  stale.params[id].ParamValue = math.Float32frombits(5)
+  stale.boot(t, cache, 42)
+  if n := atomic.LoadInt32(&stale.lists); n != 1 {
+    t.Errorf("expected a stale cache to be downloaded over, got %d list requests", n)
+  }
+  if val, _ := stale.v.GetParam("TEST_P7"); val.Value != 5 {
+    t.Errorf("expected the new value, got %v", val)
+  }
+
+  // another FMU has nothing cached
+  other := first.clone()
+  other.boot(t, cache, 43)
+  if n := atomic.LoadInt32(&other.lists); n != 1 {
+    t.Errorf("expected another FMU to be downloaded, got %d list requests", n)
+  }
+}
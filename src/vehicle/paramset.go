@@ -0,0 +1,367 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+  "math"
+  "sync"
+  "time"
+
+  "logger"
+  "mavlink/parser"
+  "params"
+  "vehicle/api"
+)
+
+const (
+  PARAM_SET_RETRIES  = 5 // resends without an echo before giving up
+  PARAM_SET_TIMEOUT  = 500 * time.Millisecond // first wait for the echo, doubled on each resend
+  PARAM_SET_MAX_WAIT = 4 * time.Second
+  PARAM_SET_WINDOW   = 4 // sets in flight at once, so a batch doesn't flood the link
+
+  // how far a float echo may be from what was asked before we call it rejected
+  // rather than rounded
+  paramRounding = 1e-4
+)
+
+const (
+  PARAM_PENDING   = "Pending"
+  PARAM_OK        = "OK"
+  PARAM_REJECTED  = "Rejected"  // the vehicle echoed another value
+  PARAM_INVALID   = "Invalid"   // never sent
+  PARAM_TIMEOUT   = "Timeout"
+  PARAM_CANCELLED = "Cancelled"
+)
+
+type ParamChange struct {
+  Name    string
+  Value   float64
+}
+
+type ParamResult struct {
+  Name    string
+  Status  string
+  Old     float64
+  New     float64 // as asked for
+  Value   float64 // as the vehicle echoed it, which may be rounded
+  Sends   int
+  Error   string `json:",omitempty"`
+}
+
+type paramSet struct {
+  batch     *ParamBatch
+  result    ParamResult
+  typed     api.ParamValue
+  sent      time.Time
+  wait      time.Duration
+}
+
+//
+// Param sets waiting on their PARAM_VALUE echo, by name. A param is only
+// set by one batch at a time, since echoes only say which param they are for.
+//
+type paramSets struct {
+  lock      sync.Mutex
+  pending   map[string]*paramSet
+}
+
+func newParamSets() *paramSets {
+  return &paramSets{pending: make(map[string]*paramSet)}
+}
+
+func (p *paramSets) register(s *paramSet) bool {
+  p.lock.Lock()
+  defer p.lock.Unlock()
+
+  if _, busy := p.pending[s.result.Name]; busy {
+    return false
+  }
+  p.pending[s.result.Name] = s
+  return true
+}
+
+func (p *paramSets) unregister(s *paramSet) {
+  p.lock.Lock()
+  defer p.lock.Unlock()
+
+  if p.pending[s.result.Name] == s {
+    delete(p.pending, s.result.Name)
+  }
+}
+
+// Resolves the set waiting on name, if there is one, with value.
+func (p *paramSets) echo(name string, value api.ParamValue) {
+  p.lock.Lock()
+  s := p.pending[name]
+  delete(p.pending, name)
+  p.lock.Unlock()
+
+  if s != nil {
+    s.batch.echoed(s, value)
+  }
+}
+
+//
+// Params being set together. Each is sent and resent, with backoff, until
+// the vehicle echoes it, up to PARAM_SET_WINDOW at a time. The vehicle
+// echoes its value whether it took the new one or not, so a set only counts
+// if the echo is what was asked for (give or take float rounding).
+//
+type ParamBatch struct {
+  v         *Vehicle
+  lock      sync.Mutex
+  sets      []*paramSet
+  cancelled bool
+  wake      chan struct{}
+  done      chan struct{}
+}
+
+func (v *Vehicle) SetParams(changes []ParamChange) *ParamBatch {
+  b := &ParamBatch{
+    v: v,
+    wake: make(chan struct{}, 1),
+    done: make(chan struct{}),
+  }
+
+  for _, c := range changes {
+    s := &paramSet{batch: b, result: ParamResult{Name: c.Name, Status: PARAM_PENDING, New: c.Value}}
+    if err := v.prepareParamSet(s); err != nil {
+      s.result.Status = PARAM_INVALID
+      s.result.Error = err.Error()
+    }
+    b.sets = append(b.sets, s)
+  }
+
+  go b.run()
+  return b
+}
+
+// Types the change as the vehicle has the param, if it may be made.
+func (v *Vehicle) prepareParamSet(s *paramSet) error {
+  old, err := v.api.GetParam(s.result.Name)
+  if err != nil {
+    return err
+  }
+  s.result.Old = old.Value
+
+  if s.typed, err = api.NewParamValue(old.Type, s.result.New); err != nil {
+    return err
+  }
+  if meta := params.Lookup(s.result.Name); meta != nil {
+    if err := meta.Validate(s.typed.Value); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+func (s *paramSet) resolve(status, err string) {
+  s.result.Status = status
+  s.result.Error = err
+}
+
+func (b *ParamBatch) echoed(s *paramSet, value api.ParamValue) {
+  b.lock.Lock()
+  defer b.lock.Unlock()
+
+  if s.result.Status != PARAM_PENDING {
+    return
+  }
+
+  s.result.Value = value.Value
+  if paramAccepted(s.typed, value) {
+    s.resolve(PARAM_OK, "")
+  } else {
+    s.resolve(PARAM_REJECTED, fmt.Sprintf("Vehicle kept %s at %g.", s.result.Name, value.Value))
+  }
+
+  select {
+  case b.wake <- struct{}{}:
+  default:
+  }
+}
+
+func paramAccepted(want, got api.ParamValue) bool {
+  if params.Equal(want.Type, want.Value, got.Value) {
+    return true
+  }
+  if want.Type == mavlink.MAV_PARAM_TYPE_REAL32 || want.Type == mavlink.MAV_PARAM_TYPE_REAL64 {
+    return math.Abs(got.Value - want.Value) <= paramRounding * math.Max(1, math.Abs(want.Value))
+  }
+  return false
+}
+
+func (b *ParamBatch) run() {
+  defer close(b.done)
+
+  var queue, inflight []*paramSet
+  for _, s := range b.sets {
+    if s.result.Status == PARAM_PENDING {
+      queue = append(queue, s)
+    }
+  }
+
+  for len(queue) > 0 || len(inflight) > 0 {
+    var send []*paramSet
+    now := time.Now()
+    next := PARAM_SET_MAX_WAIT
+
+    b.lock.Lock()
+    if b.cancelled {
+      for _, s := range append(queue, inflight...) {
+        b.v.paramSets.unregister(s)
+        if s.result.Status == PARAM_PENDING {
+          s.resolve(PARAM_CANCELLED, "Cancelled.")
+        }
+      }
+      b.lock.Unlock()
+      return
+    }
+
+    var still []*paramSet
+    for _, s := range inflight {
+      if s.result.Status != PARAM_PENDING {
+        continue
+      }
+      if now.Sub(s.sent) >= s.wait {
+        if s.result.Sends > PARAM_SET_RETRIES {
+          b.v.paramSets.unregister(s)
+          s.resolve(PARAM_TIMEOUT, fmt.Sprintf("No reply from the vehicle for %s.", s.result.Name))
+          continue
+        }
+        send = append(send, s)
+      }
+      still = append(still, s)
+    }
+    inflight = still
+
+    for len(queue) > 0 && len(inflight) < PARAM_SET_WINDOW {
+      s := queue[0]
+      queue = queue[1:]
+      if !b.v.paramSets.register(s) {
+        s.resolve(PARAM_INVALID, fmt.Sprintf("%s is already being set.", s.result.Name))
+        continue
+      }
+      s.wait = PARAM_SET_TIMEOUT / 2 // doubled on the first send
+      inflight = append(inflight, s)
+      send = append(send, s)
+    }
+
+    for _, s := range send {
+      s.result.Sends++
+      s.sent = now
+      if s.wait *= 2; s.wait > PARAM_SET_MAX_WAIT {
+        s.wait = PARAM_SET_MAX_WAIT
+      }
+    }
+    for _, s := range inflight {
+      if wait := s.wait - now.Sub(s.sent); wait < next {
+        next = wait
+      }
+    }
+    b.lock.Unlock()
+
+    // outside the lock, as echoes can come back before the send returns
+    for _, s := range send {
+      if s.result.Sends > 1 {
+        logger.DroneLog(b.v.id, "Resending param", s.result.Name)
+      }
+      b.v.sendMAVLink(b.v.api.SetParam(s.result.Name, s.typed))
+    }
+
+    if len(inflight) > 0 {
+      select {
+      case <-b.wake:
+      case <-time.After(next):
+      }
+    }
+  }
+}
+
+func (b *ParamBatch) Done() <-chan struct{} {
+  return b.done
+}
+
+// Outcome of each change, in the order they were asked for.
+func (b *ParamBatch) Results() []ParamResult {
+  b.lock.Lock()
+  defer b.lock.Unlock()
+
+  results := make([]ParamResult, len(b.sets))
+  for i, s := range b.sets {
+    results[i] = s.result
+  }
+  return results
+}
+
+func (b *ParamBatch) Failed() int {
+  failed := 0
+  for _, r := range b.Results() {
+    if r.Status != PARAM_OK && r.Status != PARAM_PENDING {
+      failed++
+    }
+  }
+  return failed
+}
+
+// Stops sending. Sets already sent may still be taken. False if it is done.
+func (b *ParamBatch) Cancel() bool {
+  b.lock.Lock()
+  defer b.lock.Unlock()
+
+  select {
+  case <-b.done:
+    return false
+  default:
+  }
+  b.cancelled = true
+
+  select {
+  case b.wake <- struct{}{}:
+  default:
+  }
+  return true
+}
+
+func (b *ParamBatch) isCancelled() bool {
+  b.lock.Lock()
+  defer b.lock.Unlock()
+  return b.cancelled
+}
+
+func (b *ParamBatch) Summary() map[string]interface{} {
+  return map[string]interface{}{
+    "Failed": b.Failed(),
+    "Results": b.Results(),
+  }
+}
+
+// A job following a batch, done once every set is.
+func (v *Vehicle) ParamBatchJob(name string, b *ParamBatch, summary func() map[string]interface{}) *api.Job {
+  return v.jobs.Add(name, func() (string, interface{}) {
+    select {
+    case <-b.Done():
+    default:
+      return api.JOB_RUNNING, summary()
+    }
+
+    if b.isCancelled() {
+      return api.JOB_CANCELLED, summary()
+    } else if b.Failed() > 0 {
+      return api.JOB_FAILED, summary()
+    }
+    return api.JOB_DONE, summary()
+  }, b.Cancel)
+}
@@ -0,0 +1,80 @@
+/**
+ * Dronesmith API
+ *
+ * Authors
+ *  Geoff Gardner <geoff@dronesmith.io>
+ *
+ * Copyright (C) 2016 Dronesmith Technologies Inc, all rights reserved.
+ * Unauthorized copying of any source code or assets within this project, via
+ * any medium is strictly prohibited.
+ *
+ * Proprietary and confidential.
+ */
+
+package vehicle
+
+import (
+  "fmt"
+
+  "mavlink/parser"
+  "mission"
+)
+
+//
+// Replaces the vehicle's rally points, then reads them back to make sure
+// they took. RTL depends on them, so a partial write is not good enough.
+//
+func (v *Vehicle) UploadRally(points []mission.RallyPoint) error {
+  if err := mission.ValidateRally(points); err != nil {
+    return err
+  }
+
+  want := mission.RallyItems(points)
+  if err := v.mission.Upload(mavlink.MAV_MISSION_TYPE_RALLY, want); err != nil {
+    return err
+  }
+
+  got, err := v.mission.Download(mavlink.MAV_MISSION_TYPE_RALLY)
+  if err != nil {
+    return fmt.Errorf("Rally points sent, but could not be read back: %v", err)
+  }
+
+  if len(got) != len(want) {
+    return fmt.Errorf("Sent %d rally points, vehicle has %d.", len(want), len(got))
+  }
+  for i := range want {
+    if got[i].Command != want[i].Command || got[i].X != want[i].X || got[i].Y != want[i].Y || got[i].Z != want[i].Z {
+      return fmt.Errorf("Rally point %d read back differently.", i)
+    }
+  }
+
+  v.fenceLock.Lock()
+  v.rally = points
+  v.fenceLock.Unlock()
+  return nil
+}
+
+// DownloadRally reads the rally points from the vehicle.
+func (v *Vehicle) DownloadRally() ([]mission.RallyPoint, error) {
+  items, err := v.mission.Download(mavlink.MAV_MISSION_TYPE_RALLY)
+  if err != nil {
+    return nil, err
+  }
+
+  points, err := mission.RallyFromItems(items)
+  if err != nil {
+    return nil, err
+  }
+
+  v.fenceLock.Lock()
+  v.rally = points
+  v.fenceLock.Unlock()
+  return points, nil
+}
+
+// Rally points as last uploaded or read back.
+func (v *Vehicle) Rally() []mission.RallyPoint {
+  v.fenceLock.RLock()
+  defer v.fenceLock.RUnlock()
+  return v.rally
+}
@@ -18,43 +18,56 @@ import (
   "logger"
   "os"
   "io"
+  "errors"
   "fmt"
+  "strconv"
   "time"
-  "utils"
   "sync"
 
   "mavlink/parser"
+  "mission"
+  "params"
   "vehicle/api"
 )
 
-var sysId string
+const (
+  RC_INPUT_RATE = 5 // Hz, unless the client asks for another
+)
 
 type RCInput struct {
   Enabled bool
   Timeout uint
-  Channels [8]uint16
+  Rate uint // Hz, 0 for RC_INPUT_RATE
+  Message mavlink.Message // RC_CHANNELS_OVERRIDE or MANUAL_CONTROL
 }
 
 type Vehicle struct {
+  id            string
   address       *net.UDPAddr
   connection    *net.UDPConn
   mavlinkReader *mavlink.Decoder
   mavlinkWriter *mavlink.Encoder
+  linkLock      sync.Mutex
 
   api           *api.VehicleApi
   knownMsgs     map[string]mavlink.Message
-  unknownMsgs   map[uint8]*mavlink.Packet
+  unknownMsgs   map[uint32]*mavlink.Packet
+  msgsLock      sync.RWMutex
   missingParams []int
   paramsLock    sync.RWMutex
 
-  commandQueue  *utils.PQueue
-  syslogQueue   *utils.Deque
-
-  commandLast   int
-  commandLastInfo int
-  commandSync   sync.RWMutex
+  commands      *commandScheduler
+  jobs          *api.JobList
 
   rcInput       chan RCInput
+  offboard      *offboard
+  paramSets     *paramSets
+  paramCache    *paramCache
+
+  mission       *missionManager
+  fence         *mission.Fence
+  rally         []mission.RallyPoint
+  fenceLock     sync.RWMutex // fence and rally
 
   ParamsTimer   time.Time
 }
@@ -66,23 +79,25 @@ func checkError(err error) {
   }
 }
 
-func mavParseError(err error) {
+func (v *Vehicle) mavParseError(err error) {
   if err != nil {
-    logger.DroneLog(sysId, "Mavlink failed to parse:", err)
+    logger.DroneLog(v.id, "Mavlink failed to parse:", err)
   }
 }
 
 func NewVehicle(id string, writer io.Writer) *Vehicle {
-  sysId = id
-
   // var err error
-  vehicle := &Vehicle{}
+  vehicle := &Vehicle{id: id}
 
   vehicle.api = api.NewVehicleApi(id)
   vehicle.knownMsgs = make(map[string]mavlink.Message)
-  vehicle.unknownMsgs = make(map[uint8]*mavlink.Packet)
+  vehicle.unknownMsgs = make(map[uint32]*mavlink.Packet)
 
   vehicle.rcInput = make(chan RCInput)
+  vehicle.offboard = newOffboard(vehicle)
+  vehicle.paramSets = newParamSets()
+  vehicle.paramCache = newParamCache(vehicle, params.SharedCache())
+  vehicle.mission = newMissionManager(vehicle)
 
   vehicle.api.AddSubSystem("GPS")
   vehicle.api.AddSubSystem("Estimator")
@@ -93,10 +108,8 @@ func NewVehicle(id string, writer io.Writer) *Vehicle {
   vehicle.api.AddSubSystem("RangeFinder")
   vehicle.api.AddSubSystem("IMU")
 
-  // Commands are prioritized by their op number -- those with lower numbers
-  // like NAV commands get prioritized first.
-  vehicle.commandQueue = utils.NewPQueue(utils.MINPQ)
-  vehicle.syslogQueue = utils.NewCappedDeque(200)
+  vehicle.commands = newCommandScheduler(vehicle)
+  vehicle.jobs = api.NewJobList()
 
   // vehicle.address, err = net.ResolveUDPAddr("udp", address)
   // checkError(err)
@@ -104,7 +117,9 @@ func NewVehicle(id string, writer io.Writer) *Vehicle {
   // vehicle.connection, err = net.ListenUDP("udp", vehicle.address)
   // checkError(err)
 
-  // vehicle.mavlinkReader = mavlink.NewDecoder(io.Reader)
+  // Packets are handed to us by ProcessPacket, so the reader is only used to
+  // track link state (sequence, protocol version).
+  vehicle.mavlinkReader = mavlink.NewDecoder(nil)
   vehicle.mavlinkWriter = mavlink.NewEncoder(writer)
 
   // if remote == "" {
@@ -118,8 +133,6 @@ func NewVehicle(id string, writer io.Writer) *Vehicle {
   //   vehicle.mavlinkWriter = mavlink.NewEncoder(remoteConn)
   // }
 
-  vehicle.NullLastSuccessfulCmd()
-
   go vehicle.RCInputListener()
 
   // Check systems are online
@@ -136,9 +149,18 @@ func (v *Vehicle) GetParams() {
 }
 
 func (v *Vehicle) ProcessPacket(pack []byte) {
-  packet, err := mavlink.DecodeBytes(pack)
+  v.linkLock.Lock()
+  packet, err := v.mavlinkReader.DecodeBytes(pack)
+
+  // Talk back to the vehicle in the newest protocol version it has used.
+  if v.mavlinkReader.Version > v.mavlinkWriter.Version {
+    logger.DroneLog(v.id, "Switching link to MAVLink v" + strconv.Itoa(int(v.mavlinkReader.Version)))
+    v.mavlinkWriter.Version = v.mavlinkReader.Version
+  }
+  v.linkLock.Unlock()
+
   if err != nil {
-    logger.DroneLog(sysId, "Parser:", err)
+    logger.DroneLog(v.id, "Parser:", err)
   } else {
     v.processPacket(packet)
   }
@@ -158,7 +180,7 @@ func (v *Vehicle) Listen() {
   for {
     packet, err := v.mavlinkReader.Decode()
     if err != nil {
-      logger.DroneLog(sysId, "Parser:", err)
+      logger.DroneLog(v.id, "Parser:", err)
     } else {
       v.processPacket(packet)
     }
@@ -172,40 +194,86 @@ func (v *Vehicle) Close() {
 }
 
 func (v *Vehicle) sendMAVLink(m mavlink.Message) {
-  if err := v.mavlinkWriter.Encode(0, 0, m); err != nil {
-    logger.DroneLog(sysId, err)
+  if err := v.SendMessage(m); err != nil {
+    logger.DroneLog(v.id, err)
   }
 }
 
+// Sends any message to the vehicle, as is.
+func (v *Vehicle) SendMessage(m mavlink.Message) error {
+  v.linkLock.Lock()
+  defer v.linkLock.Unlock()
+  return v.mavlinkWriter.Encode(0, 0, m)
+}
+
+// Last message received called name (as in the dialect, ie HEARTBEAT), or nil.
+func (v *Vehicle) LastMessage(name string) mavlink.Message {
+  m, err := mavlink.NewMessageByName(name)
+  if err != nil {
+    return nil
+  }
+
+  v.msgsLock.RLock()
+  defer v.msgsLock.RUnlock()
+  return v.knownMsgs[m.MsgName()]
+}
+
+//
+// Hands the autopilot a new secret key via SETUP_SIGNING. From then on we sign
+// everything we send with it, and drop anything from the vehicle that isn't
+// signed with it. An all zero key turns signing back off. The key isn't saved
+// anywhere, so signing only lasts until the API restarts.
+//
+func (v *Vehicle) SetupSigning(key [32]byte) error {
+  v.linkLock.Lock()
+  defer v.linkLock.Unlock()
+
+  if v.mavlinkWriter.Version < mavlink.MAVLINK_V2 {
+    return fmt.Errorf("Signing requires a MAVLink v2 link.")
+  }
+
+  signing := mavlink.NewSigning(key, 0)
+
+  // Radios inject their own unsigned status messages into the link.
+  signing.AllowUnsigned = func(p *mavlink.Packet) bool {
+    return p.MsgID == mavlink.MSG_ID_RADIO_STATUS
+  }
+
+  // Sent with the old key (if any), the vehicle doesn't know the new one yet.
+  err := v.mavlinkWriter.Encode(0, 0, &mavlink.SetupSigning{
+    InitialTimestamp: signing.Timestamp(),
+    TargetSystem: v.api.GetSystemId(),
+    TargetComponent: 0,
+    SecretKey: key,
+  })
+  if err != nil {
+    return err
+  }
+
+  if key == [32]byte{} {
+    signing = nil
+    logger.DroneLog(v.id, "Link signing disabled")
+  } else {
+    logger.DroneLog(v.id, "Link signing enabled")
+  }
+
+  v.mavlinkWriter.Signing = signing
+  v.mavlinkReader.Signing = signing
+  return nil
+}
+
+func (v *Vehicle) SigningEnabled() bool {
+  v.linkLock.Lock()
+  defer v.linkLock.Unlock()
+  return v.mavlinkWriter.Signing != nil
+}
+
 func (v *Vehicle) sysOnlineHandler() {
   // Main system handler if the init was completed.
   // log.Println("Sys online handler")
   //  log.Println(v.api.GetParam("BAT_CAPACITY"))
 
-  // Check command Queue
-  if v.commandQueue.Size() > 0 {
-    cmdInt, _ := v.commandQueue.Head()
-    cmd := cmdInt.(*api.VehicleCommand)
-    v.commandSync.Lock()
-    v.commandLast = int(cmd.Command.Command)
-    v.commandLastInfo = int(cmd.Status)
-    v.commandSync.Unlock()
-    if cmd.Status == mavlink.MAV_RESULT_ACCEPTED {
-      // got a valid ack, dequeue and send next item
-      v.commandQueue.Pop()
-    } else if cmd.Status == mavlink.MAV_RESULT_DENIED ||
-      cmd.Status == mavlink.MAV_RESULT_UNSUPPORTED ||
-      cmd.Status == mavlink.MAV_RESULT_FAILED {
-      // Command is simply not supported. Throw it out and send next item.
-      v.commandQueue.Pop()
-    } else if cmd.TimesSent > 5 {
-      // We tried 5 times, but got no ack, so throw it out and send next item.
-      v.commandQueue.Pop()
-    } else {
-      v.sendMAVLink(cmd.Command)
-      cmd.TimesSent += 1
-    }
-  }
+  v.commands.tick(time.Now())
 }
 
 //
@@ -225,15 +293,19 @@ func (v *Vehicle) stateHandler() {
       if !caps {
         // Get caps
         v.sendMAVLink(v.api.RequestVehicleInfo())
-        logger.DroneLog(sysId, "Loading vehicle info...")
+        logger.DroneLog(v.id, "Loading vehicle info...")
       } else {
         if !v.api.ParamsInit() {
-          logger.DroneLog(sysId, "Loading params...")
-          v.GetParams()
-          v.ParamsTimer = time.Now()
+          // A cached copy is much quicker, if the vehicle still has the same params.
+          if !v.paramCache.restore() {
+            logger.DroneLog(v.id, "Loading params...")
+            v.GetParams()
+            v.ParamsTimer = time.Now()
+          }
         } else {
           if total, foundSet := v.api.CheckParams(); len(foundSet)-1 == int(total) || v.api.ParamForced() {
             // We're fully initialized!
+            v.paramCache.save()
             v.sysOnlineHandler()
           } else {
             if time.Now().Sub(v.ParamsTimer) > 10 * time.Second {
@@ -243,7 +315,7 @@ func (v *Vehicle) stateHandler() {
                   notFound = append(notFound, i)
                 }
               }
-              logger.DroneLog(sysId, "WARN Failed to fetch the following params: ", notFound, "Total:", total)
+              logger.DroneLog(v.id, "WARN Failed to fetch the following params: ", notFound, "Total:", total)
               v.paramsLock.Lock()
               v.missingParams = notFound
               v.paramsLock.Unlock()
@@ -262,7 +334,7 @@ func (v *Vehicle) stateHandler() {
               // wait a teensy bit to give the firmware time to receive
               time.Sleep(5 * time.Millisecond)
             }
-            logger.DroneLog(sysId, int((float32(foundCnt) / float32(int(total))) * 100), "Percent of params loaded...")
+            logger.DroneLog(v.id, int((float32(foundCnt) / float32(int(total))) * 100), "Percent of params loaded...")
           }
         }
       }
@@ -272,6 +344,7 @@ func (v *Vehicle) stateHandler() {
       // last live state. We only remove internal MAVLink information like params
       // and caps.
       v.api.Scrub()
+      v.paramCache.reset()
     }
 
     time.Sleep(500 * time.Millisecond)
@@ -292,177 +365,134 @@ func (v *Vehicle) processPacket(p *mavlink.Packet) {
     v.api.SetSystemId(p.SysID)
   }
 
-  switch p.MsgID {
-  case mavlink.MSG_ID_HEARTBEAT:
-    var m mavlink.Heartbeat
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromHeartbeat(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_SYS_STATUS:
-    var m mavlink.SysStatus
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromStatus(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_GPS_RAW_INT:
-    var m mavlink.GpsRawInt
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromGps(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  msg, err := mavlink.DecodeMessage(p)
+  if err == mavlink.ErrUnknownMsgID {
+    v.msgsLock.Lock()
+    v.unknownMsgs[p.MsgID] = p
+    v.msgsLock.Unlock()
+    return
+  } else if err != nil {
+    v.mavParseError(err)
+    return
+  }
+
+  v.msgsLock.Lock()
+  v.knownMsgs[msg.MsgName()] = msg
+  v.msgsLock.Unlock()
+
+  switch m := msg.(type) {
+  case *mavlink.Heartbeat:
+    prevMode := v.api.Mode()
+    v.api.UpdateFromHeartbeat(m)
+    if prevMode == "Offboard" && v.api.Mode() != "Offboard" {
+      v.offboard.halt(nil, "Vehicle left Offboard.")
+    }
+
+  case *mavlink.SysStatus:
+    v.api.UpdateFromStatus(m)
+
+  case *mavlink.GpsRawInt:
+    v.api.UpdateFromGps(m)
     v.api.UpdateSubSystem("GPS")
 
-  case mavlink.MSG_ID_ATTITUDE:
-    var m mavlink.Attitude
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromAttitude(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.Attitude:
+    v.api.UpdateFromAttitude(m)
     v.api.UpdateSubSystem("Estimator")
 
-  case mavlink.MSG_ID_LOCAL_POSITION_NED:
-    var m mavlink.LocalPositionNed
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromLocalPos(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.LocalPositionNed:
+    v.api.UpdateFromLocalPos(m)
     v.api.UpdateSubSystem("Estimator")
 
-  case mavlink.MSG_ID_GLOBAL_POSITION_INT:
-    var m mavlink.GlobalPositionInt
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromGlobalPos(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.GlobalPositionInt:
+    v.api.UpdateFromGlobalPos(m)
     v.api.UpdateSubSystem("Estimator")
 
-  case mavlink.MSG_ID_SERVO_OUTPUT_RAW:
-    var m mavlink.ServoOutputRaw
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromMotors(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.ServoOutputRaw:
+    v.api.UpdateFromMotors(m)
     v.api.UpdateSubSystem("Motors")
 
-  case mavlink.MSG_ID_RC_CHANNELS:
-    var m mavlink.RcChannels
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromInput(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.RcChannels:
+    v.api.UpdateFromInput(m)
     v.api.UpdateSubSystem("RadioControl")
 
-  case mavlink.MSG_ID_VFR_HUD:
-    var m mavlink.VfrHud
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromVfr(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_HIGHRES_IMU:
-    var m mavlink.HighresImu
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromSensors(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.VfrHud:
+    v.api.UpdateFromVfr(m)
+
+  case *mavlink.HighresImu:
+    v.api.UpdateFromSensors(m)
     v.api.UpdateSubSystem("IMU")
 
-  case mavlink.MSG_ID_ATTITUDE_TARGET:
-    var m mavlink.AttitudeTarget
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromAttitudeTarget(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.AttitudeTarget:
+    v.api.UpdateFromAttitudeTarget(m)
     v.api.UpdateSubSystem("Controller")
 
-  case mavlink.MSG_ID_POSITION_TARGET_LOCAL_NED:
-    var m mavlink.PositionTargetLocalNed
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromLocalTarget(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.PositionTargetLocalNed:
+    v.api.UpdateFromLocalTarget(m)
     v.api.UpdateSubSystem("Controller")
 
-  case mavlink.MSG_ID_POSITION_TARGET_GLOBAL_INT:
-    var m mavlink.PositionTargetGlobalInt
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromGlobalTarget(&m)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.PositionTargetGlobalInt:
+    v.api.UpdateFromGlobalTarget(m)
     v.api.UpdateSubSystem("Controller")
 
-  case mavlink.MSG_ID_HOME_POSITION:
-    var m mavlink.HomePosition
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromHome(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_EXTENDED_SYS_STATE:
-    var m mavlink.ExtendedSysState
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromExtSys(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_DISTANCE_SENSOR:
-    var m mavlink.DistanceSensor
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.HomePosition:
+    v.api.UpdateFromHome(m)
+
+  case *mavlink.ExtendedSysState:
+    v.api.UpdateFromExtSys(m)
+
+  case *mavlink.DistanceSensor:
     v.api.UpdateSubSystem("RangeFinder")
 
-  case mavlink.MSG_ID_OPTICAL_FLOW_RAD:
-    var m mavlink.OpticalFlowRad
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.knownMsgs[m.MsgName()] = &m
+  case *mavlink.OpticalFlowRad:
     v.api.UpdateSubSystem("OpticalFlow")
 
-  case mavlink.MSG_ID_COMMAND_ACK:
-    var m mavlink.CommandAck
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.commandQueue.RLock()
-    v.api.UpdateFromAck(&m, v.commandQueue)
-    v.commandQueue.RUnlock()
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_AUTOPILOT_VERSION:
-    var m mavlink.AutopilotVersion
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromAutopilotVersion(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_PARAM_VALUE:
-    var m mavlink.ParamValue
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.api.UpdateFromParam(&m)
-    v.knownMsgs[m.MsgName()] = &m
-
-  case mavlink.MSG_ID_STATUSTEXT:
-    var m mavlink.Statustext
-    err := m.Unpack(p)
-    mavParseError(err)
-    v.knownMsgs[m.MsgName()] = &m
-    logger.DroneLog(sysId, ">>>", string(m.Text[:]))
-    v.syslogQueue.Prepend(&api.VehicleLog{
+  case *mavlink.CommandAck:
+    v.commands.ack(m)
+    v.api.UpdateFromAck(m)
+
+  case *mavlink.AutopilotVersion:
+    v.api.UpdateFromAutopilotVersion(m)
+
+  case *mavlink.ParamValue:
+    name := api.ParamName(m.ParamId)
+    if v.paramCache.received(name, m) {
+      break
+    }
+    v.api.UpdateFromParam(m)
+    if val, err := v.api.GetParam(name); err == nil {
+      v.paramSets.echo(name, val)
+    }
+
+  case *mavlink.MissionCount, *mavlink.MissionRequest, *mavlink.MissionRequestInt,
+    *mavlink.MissionItem, *mavlink.MissionItemInt, *mavlink.MissionAck,
+    *mavlink.MissionCurrent:
+    v.mission.handle(m)
+
+  case *mavlink.Statustext:
+    logger.DroneLog(v.id, ">>>", string(m.Text[:]))
+    v.api.Events().Publish(api.EVENT_STATUSTEXT, &api.VehicleLog{
       Msg: string(m.Text[:]),
       Time: time.Now(),
       Level: uint(m.Severity),
     })
-
-  default:
-    v.unknownMsgs[p.MsgID] = p
   }
 }
 
-func (v *Vehicle) SetModeAndArm(updateMode, updateArm bool, mode string, armed bool) {
+func (v *Vehicle) SetModeAndArm(updateMode, updateArm bool, mode string, armed bool) *api.VehicleCommand {
+  cmd := api.NewVehicleCommand(v.packModeAndArm(updateMode, updateArm, mode, armed))
+  v.commands.push(cmd)
+  return cmd
+}
+
+// Puts the vehicle in Hold ahead of anything queued, dropping the command in
+// flight and queued navigation, for when whatever was flying it goes away.
+func (v *Vehicle) failsafeHold() *api.VehicleCommand {
+  cmd := api.NewVehicleCommand(v.packModeAndArm(true, false, "Hold", false))
+  v.commands.pushAs(cmd, COMMAND_EMERGENCY)
+  return cmd
+}
+
+func (v *Vehicle) packModeAndArm(updateMode, updateArm bool, mode string, armed bool) *mavlink.CommandLong {
 
   var mainMode uint
   var manualMode uint
@@ -501,6 +531,9 @@ func (v *Vehicle) SetModeAndArm(updateMode, updateArm bool, mode string, armed b
   case "Acro":
     mainMode |= mavlink.MAV_MODE_FLAG_MANUAL_INPUT_ENABLED
     manualMode = 5
+  case "Offboard":
+    mainMode |= mavlink.MAV_MODE_FLAG_GUIDED_ENABLED | mavlink.MAV_MODE_FLAG_STABILIZE_ENABLED
+    manualMode = 6
   case "RAttitude":
     mainMode |=
       mavlink.MAV_MODE_FLAG_MANUAL_INPUT_ENABLED | mavlink.MAV_MODE_FLAG_STABILIZE_ENABLED
@@ -543,17 +576,11 @@ func (v *Vehicle) SetModeAndArm(updateMode, updateArm bool, mode string, armed b
     autoMode = 4
   }
 
-  cmd := &api.VehicleCommand{
-    Status: 10, // Must be greater than 4 due to MAV_RESULT
-    TimesSent: 0,
-    Command: v.api.PackComandLong(mavlink.MAV_CMD_DO_SET_MODE,
-      [7]float32{float32(mainMode), float32(manualMode), float32(autoMode)}),
-  }
-
-  v.commandQueue.Push(cmd, mavlink.MAV_CMD_DO_SET_MODE)
+  return v.api.PackComandLong(mavlink.MAV_CMD_DO_SET_MODE,
+    [7]float32{float32(mainMode), float32(manualMode), float32(autoMode)})
 }
 
-func (v *Vehicle) SetHome(lat, lon, alt float32, relative bool) {
+func (v *Vehicle) SetHome(lat, lon, alt float32, relative bool) *api.VehicleCommand {
   var relParam float32
 
   if relative {
@@ -562,69 +589,85 @@ func (v *Vehicle) SetHome(lat, lon, alt float32, relative bool) {
     relParam = 0.0
   }
 
-  cmd := &api.VehicleCommand{
-    Status: 10, // Must be greater than 4 due to MAV_RESULT
-    TimesSent: 0,
-    Command: v.api.PackComandLong(mavlink.MAV_CMD_DO_SET_HOME,
-      [7]float32{relParam, 0.0, 0.0, 0.0, lat, lon, alt}),
-  }
+  return v.DoGenericCommand(mavlink.MAV_CMD_DO_SET_HOME,
+    [7]float32{relParam, 0.0, 0.0, 0.0, lat, lon, alt})
+}
 
-  v.commandQueue.Push(cmd, mavlink.MAV_CMD_DO_SET_HOME)
+// Queues a COMMAND_LONG, scheduled by its class (see commandClass). The
+// returned command resolves with its own ack.
+func (v *Vehicle) DoGenericCommand(op int, params [7]float32) *api.VehicleCommand {
+  cmd := api.NewVehicleCommand(v.api.PackComandLong(uint16(op), params))
+  v.commands.push(cmd)
+  return cmd
 }
 
-func (v *Vehicle) DoGenericCommand(op int, params [7]float32) {
-  cmd := &api.VehicleCommand{
-    Status: 10, // Must be greater than 4 due to MAV_RESULT
-    TimesSent: 0,
-    Command: v.api.PackComandLong(uint16(op), params),
-  }
+// Queues a command with a position in params 5-7. It goes as a COMMAND_INT in
+// frame when the vehicle takes them, so lat and lon keep their precision, and
+// otherwise as a COMMAND_LONG in whatever frame the command assumes.
+func (v *Vehicle) DoPositionCommand(op int, frame uint8, params [4]float32, lat, lon float64, alt float32) *api.VehicleCommand {
+  cmd := api.NewVehicleCommand(v.api.PackComandLong(uint16(op),
+    [7]float32{params[0], params[1], params[2], params[3], float32(lat), float32(lon), alt}))
+  cmd.Int = v.api.PackCommandInt(uint16(op), frame, params,
+    mission.EncodeCoord(frame, lat), mission.EncodeCoord(frame, lon), alt)
+
+  v.commands.push(cmd)
+  return cmd
+}
 
-  v.commandQueue.Push(cmd, op)
+// Takes cmd back if the vehicle hasn't acked it yet. If it was already sent
+// the vehicle may still act on it, but we stop resending it and ignore its ack.
+func (v *Vehicle) CancelCommand(cmd *api.VehicleCommand) bool {
+  return v.commands.cancel(cmd)
 }
 
+// Follows cmd as a job, which cancels it if asked to.
+func (v *Vehicle) CommandJob(cmd *api.VehicleCommand) *api.Job {
+  return v.jobs.AddCommand(cmd, func() bool {
+    return v.CancelCommand(cmd)
+  })
+}
+
+func (v *Vehicle) Jobs() *api.JobList {
+  return v.jobs
+}
+
+// STATUSTEXT messages still in the event history, oldest first.
 func (v *Vehicle) GetSysLog() []*api.VehicleLog {
   var log []*api.VehicleLog
-  for !v.syslogQueue.Empty() {
-    val := v.syslogQueue.Pop()
-    log = append(log, val.(*api.VehicleLog))
+  for _, e := range v.api.Events().Since(0) {
+    if e.Event == api.EVENT_STATUSTEXT {
+      log = append(log, e.Data.(*api.VehicleLog))
+    }
   }
   return log
 }
 
-func (v *Vehicle) GetLastSuccessfulCmd() (int, string, int) {
-  v.commandSync.RLock()
-  defer v.commandSync.RUnlock()
-
-  str := ""
-  switch (v.commandLastInfo) {
-  case mavlink.MAV_RESULT_ACCEPTED: str = "Command accepted."
-  case mavlink.MAV_RESULT_FAILED: str = "Command was received, but failed."
-  case mavlink.MAV_RESULT_UNSUPPORTED: str = "Command is not supported."
-  case mavlink.MAV_RESULT_DENIED: str = "Command was rejected by the vehicle."
-  case mavlink.MAV_RESULT_TEMPORARILY_REJECTED: str = "Command was rejected by the vehicle, but is supported."
-  case 10: str = "Command timed out."
-  default: str = "Command failed to be received."
-  }
+func (v *Vehicle) Telem() map[string]interface{} {
+  return v.api.GetVehicleTelem()
+}
 
-  return v.commandLast, str, v.commandLastInfo
+func (v *Vehicle) TelemTopics(topics []string) map[string]interface{} {
+  return v.api.GetTelemTopics(topics)
 }
 
-func (v *Vehicle) NullLastSuccessfulCmd() {
-  v.commandSync.Lock()
-  defer v.commandSync.Unlock()
-  v.commandLast = 10
-  v.commandLastInfo = -1
+func (v *Vehicle) WatchTelem(w *api.TelemWatcher) {
+  v.api.AddWatcher(w)
 }
 
-func (v *Vehicle) Telem() map[string]interface{} {
-  return v.api.GetVehicleTelem()
+func (v *Vehicle) UnwatchTelem(w *api.TelemWatcher) {
+  v.api.RemoveWatcher(w)
 }
 
-func (v *Vehicle) GetParam(name string) (float32, error) {
+func (v *Vehicle) GetParam(name string) (api.ParamValue, error) {
   return v.api.GetParam(name)
 }
 
-func (v *Vehicle) GetParamByIndex(id uint) (float32, error) {
+// Name of the param at index id, once it has loaded.
+func (v *Vehicle) ParamNameAt(id uint) (string, error) {
+  return v.api.ParamNameAt(id)
+}
+
+func (v *Vehicle) GetParamByIndex(id uint) (api.ParamValue, error) {
   attempts := 0
   if val, err := v.api.GetParamIndex(id); err != nil {
     return val, nil
@@ -638,21 +681,24 @@ func (v *Vehicle) GetParamByIndex(id uint) (float32, error) {
     time.Sleep(30 * time.Millisecond)
     attempts++
     if attempts > 10 {
-      return 0.0, fmt.Errorf("Could not retrieve param.")
+      return api.ParamValue{}, fmt.Errorf("Could not retrieve param.")
     }
   }
 }
 
-func (v *Vehicle) SetParam(name string, value float32) error {
-  v.sendMAVLink(v.api.SetParam(name, value))
-  time.Sleep(250 * time.Millisecond)
-  if val, err := v.api.GetParam(name); err != nil {
-    return err
-  } else if val != value {
-    return fmt.Errorf("Param found, but failed to update")
-  } else {
-    return nil
+//
+// Sets a param the vehicle has, as the type it has it in, if that type can
+// hold value and the metadata says it is one the vehicle will take. Waits
+// for the vehicle to echo it, see ParamBatch.
+//
+func (v *Vehicle) SetParam(name string, value float64) error {
+  b := v.SetParams([]ParamChange{{name, value}})
+  <-b.Done()
+
+  if res := b.Results()[0]; res.Status != PARAM_OK {
+    return errors.New(res.Error)
   }
+  return nil
 }
 
 func (v *Vehicle) RefreshParams() {
@@ -662,27 +708,56 @@ func (v *Vehicle) RefreshParams() {
   v.api.ResetParams()
 }
 
+// Reloads the params as a job, done once they are all back or given up on.
+func (v *Vehicle) RefreshParamsJob() *api.Job {
+  v.RefreshParams()
+
+  return v.jobs.Add("RefreshParams", func() (string, interface{}) {
+    total, params := v.api.AllParams()
+    missing := len(v.MissingParams())
+
+    result := map[string]interface{}{"Loaded": len(params), "Total": total}
+    if total > 0 && uint(len(params) + missing) >= total {
+      return api.JOB_DONE, result
+    }
+    return api.JOB_RUNNING, result
+  }, nil)
+}
+
 func (v *Vehicle) MissingParams() []int {
   v.paramsLock.RLock()
   defer v.paramsLock.RUnlock()
   return v.missingParams
 }
 
-func (v *Vehicle) GetAllParams() (uint, uint, map[string]float32) {
+func (v *Vehicle) GetAllParams() (uint, uint, map[string]api.ParamValue) {
   total, chunk := v.api.AllParams()
   totalFound := int(total) - len(v.missingParams)
   return uint(totalFound), total, chunk
 }
 
-func (v *Vehicle) SendRCOverride(vals [8]uint16, enabled bool, timestamp uint) {
-  v.rcInput <- RCInput{enabled, timestamp, vals}
+func (v *Vehicle) SendRCOverride(vals [8]uint16, enabled bool, timestamp, rate uint) {
+  v.sendInput(&mavlink.RcChannelsOverride{
+    vals[0], vals[1], vals[2], vals[3],
+    vals[4], vals[5], vals[6], vals[7],
+    0, 0,
+  }, enabled, timestamp, rate)
+}
+
+// Virtual joystick, axes in [-1000, 1000], or math.MaxInt16 if unused.
+func (v *Vehicle) SendManualControl(x, y, z, r int16, buttons uint16, enabled bool, timestamp, rate uint) {
+  v.sendInput(&mavlink.ManualControl{
+    X: x, Y: y, Z: z, R: r,
+    Buttons: buttons,
+    Target: v.api.GetSystemId(),
+  }, enabled, timestamp, rate)
+}
+
+func (v *Vehicle) sendInput(m mavlink.Message, enabled bool, timestamp, rate uint) {
+  v.rcInput <- RCInput{enabled, timestamp, rate, m}
 
   if enabled {
-    v.sendMAVLink(&mavlink.RcChannelsOverride{
-      vals[0], vals[1], vals[2], vals[3],
-      vals[4], vals[5], vals[6], vals[7],
-      0, 0,
-    })
+    v.sendMAVLink(m)
   }
 }
 
@@ -694,37 +769,86 @@ func (v *Vehicle) GetGlobal() map[string]float32 {
   return v.api.GetGlobal()
 }
 
+// Latitude and longitude at full precision, from the last GLOBAL_POSITION_INT.
+func (v *Vehicle) GetLatLon() (float64, float64) {
+  if m, ok := v.LastMessage("GLOBAL_POSITION_INT").(*mavlink.GlobalPositionInt); ok {
+    return float64(m.Lat) / 1e7, float64(m.Lon) / 1e7
+  }
+
+  global := v.GetGlobal()
+  return float64(global["Latitude"]), float64(global["Longitude"])
+}
+
 func (v *Vehicle) GetMASLAlt() float32 {
   return v.api.GetMASLAlt()
 }
 
+// Repeats the last input, radio or joystick, until it is disabled or the
+// client hasn't sent one for its timeout.
 func (v *Vehicle) RCInputListener() {
   enabled := false
-  data := [8]uint16{}
+  var data mavlink.Message
   lastReceived := time.Now()
   var rcTimeOut uint
+  var rate uint = RC_INPUT_RATE
+  ticker := time.NewTicker(time.Second / time.Duration(rate))
   for {
     select {
     case rc := <-v.rcInput:
       enabled = rc.Enabled
-      data = rc.Channels
+      data = rc.Message
       rcTimeOut = rc.Timeout
       lastReceived = time.Now()
-    default:
-      if enabled {
-        v.sendMAVLink(&mavlink.RcChannelsOverride{
-          data[0], data[1], data[2], data[3],
-          data[4], data[5], data[6], data[7],
-          0, 0,
-        })
 
-        curr := time.Now()
+      if rc.Rate == 0 {
+        rc.Rate = RC_INPUT_RATE
+      }
+      if rc.Rate != rate {
+        rate = rc.Rate
+        ticker.Stop()
+        ticker = time.NewTicker(time.Second / time.Duration(rate))
+      }
+    case curr := <-ticker.C:
+      if enabled {
+        v.sendMAVLink(data)
 
         if (rcTimeOut > 0) && (curr.Sub(lastReceived) > time.Duration(rcTimeOut) * time.Millisecond) {
           enabled = false
         }
       }
     }
-    time.Sleep(200 * time.Millisecond)
   }
 }
+
+func (v *Vehicle) UploadMission(items []*api.MissionItem) error {
+  ints := make([]*mavlink.MissionItemInt, len(items))
+  for i, item := range items {
+    ints[i] = item.Int()
+  }
+  return v.mission.Upload(mavlink.MAV_MISSION_TYPE_MISSION, ints)
+}
+
+func (v *Vehicle) DownloadMission() (*api.Mission, error) {
+  ints, err := v.mission.Download(mavlink.MAV_MISSION_TYPE_MISSION)
+  if err != nil {
+    return nil, err
+  }
+
+  items := make([]*api.MissionItem, len(ints))
+  for i, item := range ints {
+    items[i] = api.MissionItemFromInt(item)
+  }
+  return &api.Mission{Current: v.mission.Current(), Items: items}, nil
+}
+
+func (v *Vehicle) ClearMission() error {
+  return v.mission.Clear(mavlink.MAV_MISSION_TYPE_MISSION)
+}
+
+func (v *Vehicle) SetMissionCurrent(seq uint16) error {
+  return v.mission.SetCurrent(seq)
+}
+
+func (v *Vehicle) Events() *api.EventLog {
+  return v.api.Events()
+}